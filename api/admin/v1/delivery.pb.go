@@ -0,0 +1,393 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: api/admin/v1/delivery.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/google/gnostic/openapiv3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渠道
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 接收方
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 开始时间
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	// 结束时间
+	EndTime int64 `protobuf:"varint,4,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	// 页码
+	Page int32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页条数
+	PageSize      int32 `protobuf:"varint,6,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesRequest) Reset() {
+	*x = ListDeliveriesRequest{}
+	mi := &file_api_admin_v1_delivery_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_delivery_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_delivery_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListDeliveriesRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ListDeliveriesRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ListDeliveriesRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ListDeliveriesRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ListDeliveriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type DeliveryInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 记录 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 渠道
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 接收方（脱敏）
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 逻辑模板名
+	Template string `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	// 供应商
+	Provider string `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 供应商消息 ID
+	ProviderMessageId string `protobuf:"bytes,6,opt,name=provider_message_id,proto3" json:"provider_message_id,omitempty"`
+	// 状态
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// 错误信息
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// 回执时间
+	ReportedAt int64 `protobuf:"varint,9,opt,name=reported_at,proto3" json:"reported_at,omitempty"`
+	// 创建时间
+	CreatedAt int64 `protobuf:"varint,10,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// 供应商请求 ID
+	ProviderRequestId string `protobuf:"bytes,11,opt,name=provider_request_id,proto3" json:"provider_request_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeliveryInfo) Reset() {
+	*x = DeliveryInfo{}
+	mi := &file_api_admin_v1_delivery_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryInfo) ProtoMessage() {}
+
+func (x *DeliveryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_delivery_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryInfo.ProtoReflect.Descriptor instead.
+func (*DeliveryInfo) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_delivery_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeliveryInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeliveryInfo) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *DeliveryInfo) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *DeliveryInfo) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *DeliveryInfo) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DeliveryInfo) GetProviderMessageId() string {
+	if x != nil {
+		return x.ProviderMessageId
+	}
+	return ""
+}
+
+func (x *DeliveryInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeliveryInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeliveryInfo) GetReportedAt() int64 {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return 0
+}
+
+func (x *DeliveryInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *DeliveryInfo) GetProviderRequestId() string {
+	if x != nil {
+		return x.ProviderRequestId
+	}
+	return ""
+}
+
+type ListDeliveriesReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 投递记录
+	List []*DeliveryInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 总数
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesReply) Reset() {
+	*x = ListDeliveriesReply{}
+	mi := &file_api_admin_v1_delivery_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesReply) ProtoMessage() {}
+
+func (x *ListDeliveriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_delivery_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesReply.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesReply) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_delivery_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDeliveriesReply) GetList() []*DeliveryInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListDeliveriesReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_api_admin_v1_delivery_proto protoreflect.FileDescriptor
+
+const file_api_admin_v1_delivery_proto_rawDesc = "" +
+	"\n" +
+	"\x1bapi/admin/v1/delivery.proto\x12\fapi.admin.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1copenapi/v3/annotations.proto\"\x88\x04\n" +
+	"\x15ListDeliveriesRequest\x12]\n" +
+	"\achannel\x18\x01 \x01(\tBC\xfaB\x10r\x0eR\x00R\x03smsR\x05email\xbaG-\x92\x02*渠道：sms/email，为空时查询全部R\achannel\x12T\n" +
+	"\breceiver\x18\x02 \x01(\tB8\xfaB\x05r\x03\x18\xff\x01\xbaG-\x92\x02*接收方手机号或邮箱，精确匹配R\breceiver\x12Q\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03B1\xfaB\x04\"\x02(\x00\xbaG'\x92\x02$开始时间戳（含），单位秒R\n" +
+	"start_time\x12P\n" +
+	"\bend_time\x18\x04 \x01(\x03B4\xfaB\x04\"\x02(\x00\xbaG*\x92\x02'结束时间戳（不含），单位秒R\bend_time\x12A\n" +
+	"\x04page\x18\x05 \x01(\x05B-\xfaB\x04\x1a\x02(\x00\xbaG#\x92\x02 页码，从 1 开始，默认 1R\x04page\x12R\n" +
+	"\tpage_size\x18\x06 \x01(\x05B4\xfaB\x06\x1a\x04\x18d(\x00\xbaG(\x92\x02%每页条数，默认 20，最大 100R\tpage_size\"\x8e\a\n" +
+	"\fDeliveryInfo\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\x03B\x0f\xbaG\f\x92\x02\t记录 IDR\x02id\x122\n" +
+	"\achannel\x18\x02 \x01(\tB\x18\xbaG\x15\x92\x02\x12渠道：sms/emailR\achannel\x12I\n" +
+	"\breceiver\x18\x03 \x01(\tB-\xbaG*\x92\x02'接收方（脱敏），如 138****5678R\breceiver\x12A\n" +
+	"\btemplate\x18\x04 \x01(\tB%\xbaG\"\x92\x02\x1f逻辑模板名，如 otp_loginR\btemplate\x12A\n" +
+	"\bprovider\x18\x05 \x01(\tB%\xbaG\"\x92\x02\x1f供应商：aliyun/tencent/smtpR\bprovider\x12z\n" +
+	"\x13provider_message_id\x18\x06 \x01(\tBH\xbaGE\x92\x02B供应商消息 ID，如阿里云 BizId，用于匹配状态回执R\x13provider_message_id\x12|\n" +
+	"\x06status\x18\a \x01(\tBd\xbaGa\x92\x02^状态：sent=已受理，failed=提交失败，delivered=已送达，undelivered=送达失败R\x06status\x12@\n" +
+	"\x05error\x18\b \x01(\tB*\xbaG'\x92\x02$提交失败或回执失败的原因R\x05error\x12]\n" +
+	"\vreported_at\x18\t \x01(\x03B;\xbaG8\x92\x025回执时间戳，单位秒，未收到回执时为 0R\vreported_at\x12A\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03B!\xbaG\x1e\x92\x02\x1b发送时间戳，单位秒R\n" +
+	"created_at\x12z\n" +
+	"\x13provider_request_id\x18\v \x01(\tBH\xbaGE\x92\x02B供应商请求 ID（RequestId），用于向供应商排查问题R\x13provider_request_id\"\xb0\x01\n" +
+	"\x13ListDeliveriesReply\x12`\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.api.admin.v1.DeliveryInfoB0\xbaG-\x92\x02*投递记录列表，按发送时间倒序R\x04list\x127\n" +
+	"\x05total\x18\x02 \x01(\x03B!\xbaG\x1e\x92\x02\x1b符合条件的记录总数R\x05total2\x91\x02\n" +
+	"\bDelivery\x12\x84\x02\n" +
+	"\x0eListDeliveries\x12#.api.admin.v1.ListDeliveriesRequest\x1a!.api.admin.v1.ListDeliveriesReply\"\xa9\x01\xbaG\x8c\x01\x12\x12查询投递记录\x1av按接收方（手机号或邮箱）及时间范围查询短信/邮件投递记录及回执状态，仅管理员可用\x82\xd3\xe4\x93\x02\x13\x12\x11/admin/deliveriesBO\n" +
+	"\fapi.admin.v1P\x01Z=github.com/sober-studio/bubble-boot-go-kratos/api/admin/v1;v1b\x06proto3"
+
+var (
+	file_api_admin_v1_delivery_proto_rawDescOnce sync.Once
+	file_api_admin_v1_delivery_proto_rawDescData []byte
+)
+
+func file_api_admin_v1_delivery_proto_rawDescGZIP() []byte {
+	file_api_admin_v1_delivery_proto_rawDescOnce.Do(func() {
+		file_api_admin_v1_delivery_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_admin_v1_delivery_proto_rawDesc), len(file_api_admin_v1_delivery_proto_rawDesc)))
+	})
+	return file_api_admin_v1_delivery_proto_rawDescData
+}
+
+var file_api_admin_v1_delivery_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_admin_v1_delivery_proto_goTypes = []any{
+	(*ListDeliveriesRequest)(nil), // 0: api.admin.v1.ListDeliveriesRequest
+	(*DeliveryInfo)(nil),          // 1: api.admin.v1.DeliveryInfo
+	(*ListDeliveriesReply)(nil),   // 2: api.admin.v1.ListDeliveriesReply
+}
+var file_api_admin_v1_delivery_proto_depIdxs = []int32{
+	1, // 0: api.admin.v1.ListDeliveriesReply.list:type_name -> api.admin.v1.DeliveryInfo
+	0, // 1: api.admin.v1.Delivery.ListDeliveries:input_type -> api.admin.v1.ListDeliveriesRequest
+	2, // 2: api.admin.v1.Delivery.ListDeliveries:output_type -> api.admin.v1.ListDeliveriesReply
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_admin_v1_delivery_proto_init() }
+func file_api_admin_v1_delivery_proto_init() {
+	if File_api_admin_v1_delivery_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_admin_v1_delivery_proto_rawDesc), len(file_api_admin_v1_delivery_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_admin_v1_delivery_proto_goTypes,
+		DependencyIndexes: file_api_admin_v1_delivery_proto_depIdxs,
+		MessageInfos:      file_api_admin_v1_delivery_proto_msgTypes,
+	}.Build()
+	File_api_admin_v1_delivery_proto = out.File
+	file_api_admin_v1_delivery_proto_goTypes = nil
+	file_api_admin_v1_delivery_proto_depIdxs = nil
+}
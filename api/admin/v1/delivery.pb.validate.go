@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/admin/v1/delivery.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListDeliveriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeliveriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeliveriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeliveriesRequestMultiError, or nil if none found.
+func (m *ListDeliveriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeliveriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListDeliveriesRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := ListDeliveriesRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [ sms email]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReceiver()) > 255 {
+		err := ListDeliveriesRequestValidationError{
+			field:  "Receiver",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() < 0 {
+		err := ListDeliveriesRequestValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := ListDeliveriesRequestValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListDeliveriesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListDeliveriesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListDeliveriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeliveriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeliveriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeliveriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeliveriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeliveriesRequestMultiError) AllErrors() []error { return m }
+
+// ListDeliveriesRequestValidationError is the validation error returned by
+// ListDeliveriesRequest.Validate if the designated constraints aren't met.
+type ListDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeliveriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeliveriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeliveriesRequestValidationError) ErrorName() string {
+	return "ListDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeliveriesRequestValidationError{}
+
+var _ListDeliveriesRequest_Channel_InLookup = map[string]struct{}{
+	"":      {},
+	"sms":   {},
+	"email": {},
+}
+
+// Validate checks the field values on DeliveryInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeliveryInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeliveryInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeliveryInfoMultiError, or
+// nil if none found.
+func (m *DeliveryInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeliveryInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Channel
+
+	// no validation rules for Receiver
+
+	// no validation rules for Template
+
+	// no validation rules for Provider
+
+	// no validation rules for ProviderMessageId
+
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	// no validation rules for ReportedAt
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for ProviderRequestId
+
+	if len(errors) > 0 {
+		return DeliveryInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeliveryInfoMultiError is an error wrapping multiple validation errors
+// returned by DeliveryInfo.ValidateAll() if the designated constraints aren't met.
+type DeliveryInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeliveryInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeliveryInfoMultiError) AllErrors() []error { return m }
+
+// DeliveryInfoValidationError is the validation error returned by
+// DeliveryInfo.Validate if the designated constraints aren't met.
+type DeliveryInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeliveryInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeliveryInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeliveryInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeliveryInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeliveryInfoValidationError) ErrorName() string { return "DeliveryInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeliveryInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeliveryInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeliveryInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeliveryInfoValidationError{}
+
+// Validate checks the field values on ListDeliveriesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeliveriesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeliveriesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeliveriesReplyMultiError, or nil if none found.
+func (m *ListDeliveriesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeliveriesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeliveriesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeliveriesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeliveriesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListDeliveriesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeliveriesReplyMultiError is an error wrapping multiple validation
+// errors returned by ListDeliveriesReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeliveriesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeliveriesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeliveriesReplyMultiError) AllErrors() []error { return m }
+
+// ListDeliveriesReplyValidationError is the validation error returned by
+// ListDeliveriesReply.Validate if the designated constraints aren't met.
+type ListDeliveriesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeliveriesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeliveriesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeliveriesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeliveriesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeliveriesReplyValidationError) ErrorName() string {
+	return "ListDeliveriesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeliveriesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeliveriesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeliveriesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeliveriesReplyValidationError{}
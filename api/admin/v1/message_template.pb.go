@@ -0,0 +1,994 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: api/admin/v1/message_template.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/google/gnostic/openapiv3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TemplateContent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渠道
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 逻辑模板名
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 语言
+	Locale string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	// 短信供应商
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 标题
+	Subject string `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	// 正文
+	Body string `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	// 纯文本正文
+	TextBody string `protobuf:"bytes,7,opt,name=text_body,proto3" json:"text_body,omitempty"`
+	// 供应商模板编号
+	VendorCode    string `protobuf:"bytes,8,opt,name=vendor_code,proto3" json:"vendor_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateContent) Reset() {
+	*x = TemplateContent{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateContent) ProtoMessage() {}
+
+func (x *TemplateContent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateContent.ProtoReflect.Descriptor instead.
+func (*TemplateContent) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TemplateContent) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetTextBody() string {
+	if x != nil {
+		return x.TextBody
+	}
+	return ""
+}
+
+func (x *TemplateContent) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+type TemplateInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模板 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 模板内容
+	Content *TemplateContent `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// 版本号
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// 创建时间
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt     int64 `protobuf:"varint,5,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateInfo) Reset() {
+	*x = TemplateInfo{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateInfo) ProtoMessage() {}
+
+func (x *TemplateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateInfo.ProtoReflect.Descriptor instead.
+func (*TemplateInfo) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TemplateInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TemplateInfo) GetContent() *TemplateContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *TemplateInfo) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TemplateInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TemplateInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type ListTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渠道
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 逻辑模板名
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 页码
+	Page int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页条数
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTemplatesRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTemplatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListTemplatesReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模板列表
+	List []*TemplateInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 总数
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesReply) Reset() {
+	*x = ListTemplatesReply{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesReply) ProtoMessage() {}
+
+func (x *ListTemplatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesReply.ProtoReflect.Descriptor instead.
+func (*ListTemplatesReply) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTemplatesReply) GetList() []*TemplateInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListTemplatesReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模板内容
+	Content       *TemplateContent `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTemplateRequest) GetContent() *TemplateContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UpdateTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模板 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 标题
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// 正文
+	Body string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// 纯文本正文
+	TextBody string `protobuf:"bytes,4,opt,name=text_body,proto3" json:"text_body,omitempty"`
+	// 供应商模板编号
+	VendorCode string `protobuf:"bytes,5,opt,name=vendor_code,proto3" json:"vendor_code,omitempty"`
+	// 版本号
+	Version       int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTemplateRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetTextBody() string {
+	if x != nil {
+		return x.TextBody
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetVendorCode() string {
+	if x != nil {
+		return x.VendorCode
+	}
+	return ""
+}
+
+func (x *UpdateTemplateRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模板 ID
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTemplateReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateReply) Reset() {
+	*x = DeleteTemplateReply{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateReply) ProtoMessage() {}
+
+func (x *DeleteTemplateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateReply.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateReply) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{7}
+}
+
+type PreviewTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模板内容
+	Content *TemplateContent `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// 示例参数
+	Params        map[string]string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewTemplateRequest) Reset() {
+	*x = PreviewTemplateRequest{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewTemplateRequest) ProtoMessage() {}
+
+func (x *PreviewTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewTemplateRequest.ProtoReflect.Descriptor instead.
+func (*PreviewTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PreviewTemplateRequest) GetContent() *TemplateContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *PreviewTemplateRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type RenderTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渠道
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 逻辑模板名
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 语言
+	Locales []string `protobuf:"bytes,3,rep,name=locales,proto3" json:"locales,omitempty"`
+	// 示例参数
+	Params        map[string]string `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTemplateRequest) Reset() {
+	*x = RenderTemplateRequest{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplateRequest) ProtoMessage() {}
+
+func (x *RenderTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplateRequest.ProtoReflect.Descriptor instead.
+func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RenderTemplateRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *RenderTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenderTemplateRequest) GetLocales() []string {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+func (x *RenderTemplateRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type VendorTemplateCode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 供应商
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// 模板编号
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// 来源
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorTemplateCode) Reset() {
+	*x = VendorTemplateCode{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorTemplateCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorTemplateCode) ProtoMessage() {}
+
+func (x *VendorTemplateCode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorTemplateCode.ProtoReflect.Descriptor instead.
+func (*VendorTemplateCode) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VendorTemplateCode) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *VendorTemplateCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VendorTemplateCode) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type RenderTemplateReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 实际使用的语言
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	// 来源
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// 标题
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// HTML 正文
+	Html string `protobuf:"bytes,4,opt,name=html,proto3" json:"html,omitempty"`
+	// 纯文本正文
+	Text string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	// 短信模板编号
+	VendorCodes   []*VendorTemplateCode `protobuf:"bytes,6,rep,name=vendor_codes,proto3" json:"vendor_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTemplateReply) Reset() {
+	*x = RenderTemplateReply{}
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTemplateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplateReply) ProtoMessage() {}
+
+func (x *RenderTemplateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_v1_message_template_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplateReply.ProtoReflect.Descriptor instead.
+func (*RenderTemplateReply) Descriptor() ([]byte, []int) {
+	return file_api_admin_v1_message_template_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RenderTemplateReply) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *RenderTemplateReply) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RenderTemplateReply) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RenderTemplateReply) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *RenderTemplateReply) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RenderTemplateReply) GetVendorCodes() []*VendorTemplateCode {
+	if x != nil {
+		return x.VendorCodes
+	}
+	return nil
+}
+
+var File_api_admin_v1_message_template_proto protoreflect.FileDescriptor
+
+const file_api_admin_v1_message_template_proto_rawDesc = "" +
+	"\n" +
+	"#api/admin/v1/message_template.proto\x12\fapi.admin.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1copenapi/v3/annotations.proto\"\xd5\a\n" +
+	"\x0fTemplateContent\x12C\n" +
+	"\achannel\x18\x01 \x01(\tB)\xfaB\x0er\fR\x03smsR\x05email\xbaG\x15\x92\x02\x12渠道：sms/emailR\achannel\x12v\n" +
+	"\x04name\x18\x02 \x01(\tBb\xfaB\x06r\x04\x10\x01\x18d\xbaGV\x92\x02S逻辑模板名，与发送时使用的模板名一致，如 bind_email、otp_loginR\x04name\x12\x94\x01\n" +
+	"\x06locale\x18\x03 \x01(\tB|\xfaB\x04r\x02\x18#\xbaGr\x92\x02o语言：邮件为语言标签，如 zh-CN；短信为空（大陆号码）或 intl（国际/港澳台号码）R\x06locale\x12|\n" +
+	"\bprovider\x18\x04 \x01(\tB`\xfaB\x04r\x02\x182\xbaGV\x92\x02S短信供应商：aliyun/tencent，为空时对所有供应商生效；邮件忽略R\bprovider\x12|\n" +
+	"\asubject\x18\x05 \x01(\tBb\xfaB\x05r\x03\x18\xff\x01\xbaGW\x92\x02T邮件标题模板，支持 {{.参数名}}，为空时使用配置文件中的标题R\asubject\x12\x96\x01\n" +
+	"\x04body\x18\x06 \x01(\tB\x81\x01\xfaB\x06r\x04\x18\x80\x80\x04\xbaGu\x92\x02r正文模板：邮件为 HTML 正文（必填）；短信为展示用正文，实际内容以供应商模板为准R\x04body\x12T\n" +
+	"\ttext_body\x18\a \x01(\tB6\xfaB\x06r\x04\x18\x80\x80\x04\xbaG*\x92\x02'邮件纯文本正文模板，可为空R\ttext_body\x12\x82\x01\n" +
+	"\vvendor_code\x18\b \x01(\tB`\xfaB\x04r\x02\x18d\xbaGV\x92\x02S短信供应商模板编号（必填），如阿里云 SMS_123456789；邮件忽略R\vvendor_code\"\xd7\x02\n" +
+	"\fTemplateInfo\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\x03B\x0f\xbaG\f\x92\x02\t模板 IDR\x02id\x12K\n" +
+	"\acontent\x18\x02 \x01(\v2\x1d.api.admin.v1.TemplateContentB\x12\xbaG\x0f\x92\x02\f模板内容R\acontent\x12S\n" +
+	"\aversion\x18\x03 \x01(\x03B9\xbaG6\x92\x023版本号，每次修改递增，修改时需携带R\aversion\x12A\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03B!\xbaG\x1e\x92\x02\x1b创建时间戳，单位秒R\n" +
+	"created_at\x12A\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03B!\xbaG\x1e\x92\x02\x1b更新时间戳，单位秒R\n" +
+	"updated_at\"\xcd\x02\n" +
+	"\x14ListTemplatesRequest\x12]\n" +
+	"\achannel\x18\x01 \x01(\tBC\xfaB\x10r\x0eR\x00R\x03smsR\x05email\xbaG-\x92\x02*渠道：sms/email，为空时查询全部R\achannel\x12?\n" +
+	"\x04name\x18\x02 \x01(\tB+\xfaB\x04r\x02\x18d\xbaG!\x92\x02\x1e逻辑模板名，精确匹配R\x04name\x12A\n" +
+	"\x04page\x18\x03 \x01(\x05B-\xfaB\x04\x1a\x02(\x00\xbaG#\x92\x02 页码，从 1 开始，默认 1R\x04page\x12R\n" +
+	"\tpage_size\x18\x04 \x01(\x05B4\xfaB\x06\x1a\x04\x18d(\x00\xbaG(\x92\x02%每页条数，默认 20，最大 100R\tpage_size\"\xb5\x01\n" +
+	"\x12ListTemplatesReply\x12f\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.api.admin.v1.TemplateInfoB6\xbaG3\x92\x020模板列表，按渠道、名称、语言排序R\x04list\x127\n" +
+	"\x05total\x18\x02 \x01(\x03B!\xbaG\x1e\x92\x02\x1b符合条件的模板总数R\x05total\"l\n" +
+	"\x15CreateTemplateRequest\x12S\n" +
+	"\acontent\x18\x01 \x01(\v2\x1d.api.admin.v1.TemplateContentB\x1a\xfaB\x05\x8a\x01\x02\x10\x01\xbaG\x0f\x92\x02\f模板内容R\acontent\"\x92\x03\n" +
+	"\x15UpdateTemplateRequest\x12&\n" +
+	"\x02id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t模板 IDR\x02id\x12:\n" +
+	"\asubject\x18\x02 \x01(\tB \xfaB\x05r\x03\x18\xff\x01\xbaG\x15\x92\x02\x12邮件标题模板R\asubject\x12/\n" +
+	"\x04body\x18\x03 \x01(\tB\x1b\xfaB\x06r\x04\x18\x80\x80\x04\xbaG\x0f\x92\x02\f正文模板R\x04body\x12H\n" +
+	"\ttext_body\x18\x04 \x01(\tB*\xfaB\x06r\x04\x18\x80\x80\x04\xbaG\x1e\x92\x02\x1b邮件纯文本正文模板R\ttext_body\x12J\n" +
+	"\vvendor_code\x18\x05 \x01(\tB(\xfaB\x04r\x02\x18d\xbaG\x1e\x92\x02\x1b短信供应商模板编号R\vvendor_code\x12N\n" +
+	"\aversion\x18\x06 \x01(\x03B4\xfaB\x04\"\x02 \x00\xbaG*\x92\x02'修改前的版本号，用于乐观锁R\aversion\"?\n" +
+	"\x15DeleteTemplateRequest\x12&\n" +
+	"\x02id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t模板 IDR\x02id\"\x15\n" +
+	"\x13DeleteTemplateReply\"\xab\x02\n" +
+	"\x16PreviewTemplateRequest\x12_\n" +
+	"\acontent\x18\x01 \x01(\v2\x1d.api.admin.v1.TemplateContentB&\xfaB\x05\x8a\x01\x02\x10\x01\xbaG\x1b\x92\x02\x18待预览的模板内容R\acontent\x12u\n" +
+	"\x06params\x18\x02 \x03(\v20.api.admin.v1.PreviewTemplateRequest.ParamsEntryB+\xbaG(\x92\x02%示例参数，如 {\"code\": \"123456\"}R\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc1\x03\n" +
+	"\x15RenderTemplateRequest\x12C\n" +
+	"\achannel\x18\x01 \x01(\tB)\xfaB\x0er\fR\x03smsR\x05email\xbaG\x15\x92\x02\x12渠道：sms/emailR\achannel\x122\n" +
+	"\x04name\x18\x02 \x01(\tB\x1e\xfaB\x06r\x04\x10\x01\x18d\xbaG\x12\x92\x02\x0f逻辑模板名R\x04name\x12\x96\x01\n" +
+	"\alocales\x18\x03 \x03(\tB|\xfaB\x05\x92\x01\x02\x10\n" +
+	"\xbaGq\x92\x02n偏好语言，按优先级排列，如 [\"en-US\", \"zh\"]；短信传 [\"intl\"] 查询国际号码使用的模板R\alocales\x12[\n" +
+	"\x06params\x18\x04 \x03(\v2/.api.admin.v1.RenderTemplateRequest.ParamsEntryB\x12\xbaG\x0f\x92\x02\f示例参数R\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcf\x01\n" +
+	"\x12VendorTemplateCode\x121\n" +
+	"\bprovider\x18\x01 \x01(\tB\x15\xbaG\x12\x92\x02\x0f短信供应商R\bprovider\x12/\n" +
+	"\x04code\x18\x02 \x01(\tB\x1b\xbaG\x18\x92\x02\x15供应商模板编号R\x04code\x12U\n" +
+	"\x06source\x18\x03 \x01(\tB=\xbaG:\x92\x027来源：database=数据库模板，config=配置文件R\x06source\"\xaf\x04\n" +
+	"\x13RenderTemplateReply\x123\n" +
+	"\x06locale\x18\x01 \x01(\tB\x1b\xbaG\x18\x92\x02\x15实际使用的语言R\x06locale\x12\x85\x01\n" +
+	"\x06source\x18\x02 \x01(\tBm\xbaGj\x92\x02g模板来源：database=数据库模板，embedded=内嵌模板，config=配置文件；预览时为空R\x06source\x128\n" +
+	"\asubject\x18\x03 \x01(\tB\x1e\xbaG\x1b\x92\x02\x18渲染后的邮件标题R\asubject\x128\n" +
+	"\x04html\x18\x04 \x01(\tB$\xbaG!\x92\x02\x1e渲染后的邮件 HTML 正文R\x04html\x12P\n" +
+	"\x04text\x18\x05 \x01(\tB<\xbaG9\x92\x026渲染后的纯文本正文，短信为展示用正文R\x04text\x12\x94\x01\n" +
+	"\fvendor_codes\x18\x06 \x03(\v2 .api.admin.v1.VendorTemplateCodeBN\xbaGK\x92\x02H短信：各供应商使用的模板编号，按供应商优先级排列R\fvendor_codes2\xba\v\n" +
+	"\x0fMessageTemplate\x12\xc5\x01\n" +
+	"\rListTemplates\x12\".api.admin.v1.ListTemplatesRequest\x1a .api.admin.v1.ListTemplatesReply\"n\xbaGS\x12\f查询模板\x1aC查询数据库中维护的短信/邮件模板，仅管理员可用\x82\xd3\xe4\x93\x02\x12\x12\x10/admin/templates\x12\x8d\x02\n" +
+	"\x0eCreateTemplate\x12#.api.admin.v1.CreateTemplateRequest\x1a\x1a.api.admin.v1.TemplateInfo\"\xb9\x01\xbaG\x9a\x01\x12\f新增模板\x1a\x89\x01新增短信/邮件模板，保存后各实例在 10 秒内生效；数据库中未维护的模板使用内嵌模板或配置文件兜底\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/admin/templates\x12\xdb\x01\n" +
+	"\x0eUpdateTemplate\x12#.api.admin.v1.UpdateTemplateRequest\x1a\x1a.api.admin.v1.TemplateInfo\"\x87\x01\xbaGd\x12\f修改模板\x1aT修改模板内容，需携带修改前的版本号，版本不一致时返回冲突\x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/admin/templates/{id}\x12\xcc\x01\n" +
+	"\x0eDeleteTemplate\x12#.api.admin.v1.DeleteTemplateRequest\x1a!.api.admin.v1.DeleteTemplateReply\"r\xbaGR\x12\f删除模板\x1aB删除后恢复使用内嵌模板或配置文件中的模板编号\x82\xd3\xe4\x93\x02\x17*\x15/admin/templates/{id}\x12\xea\x01\n" +
+	"\x0fPreviewTemplate\x12$.api.admin.v1.PreviewTemplateRequest\x1a!.api.admin.v1.RenderTemplateReply\"\x8d\x01\xbaGg\x12\f预览模板\x1aW使用示例参数渲染未保存的模板内容，用于编辑时检查语法及效果\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/admin/templates/preview\x12\xb4\x02\n" +
+	"\x0eRenderTemplate\x12#.api.admin.v1.RenderTemplateRequest\x1a!.api.admin.v1.RenderTemplateReply\"\xd9\x01\xbaG\xb3\x01\x12\f渲染模板\x1a\xa2\x01按实际发送时的查找规则渲染模板：邮件按语言回退，数据库模板优先、内嵌模板兜底；短信返回各供应商使用的模板编号\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/templates/renderBO\n" +
+	"\fapi.admin.v1P\x01Z=github.com/sober-studio/bubble-boot-go-kratos/api/admin/v1;v1b\x06proto3"
+
+var (
+	file_api_admin_v1_message_template_proto_rawDescOnce sync.Once
+	file_api_admin_v1_message_template_proto_rawDescData []byte
+)
+
+func file_api_admin_v1_message_template_proto_rawDescGZIP() []byte {
+	file_api_admin_v1_message_template_proto_rawDescOnce.Do(func() {
+		file_api_admin_v1_message_template_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_admin_v1_message_template_proto_rawDesc), len(file_api_admin_v1_message_template_proto_rawDesc)))
+	})
+	return file_api_admin_v1_message_template_proto_rawDescData
+}
+
+var file_api_admin_v1_message_template_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_admin_v1_message_template_proto_goTypes = []any{
+	(*TemplateContent)(nil),        // 0: api.admin.v1.TemplateContent
+	(*TemplateInfo)(nil),           // 1: api.admin.v1.TemplateInfo
+	(*ListTemplatesRequest)(nil),   // 2: api.admin.v1.ListTemplatesRequest
+	(*ListTemplatesReply)(nil),     // 3: api.admin.v1.ListTemplatesReply
+	(*CreateTemplateRequest)(nil),  // 4: api.admin.v1.CreateTemplateRequest
+	(*UpdateTemplateRequest)(nil),  // 5: api.admin.v1.UpdateTemplateRequest
+	(*DeleteTemplateRequest)(nil),  // 6: api.admin.v1.DeleteTemplateRequest
+	(*DeleteTemplateReply)(nil),    // 7: api.admin.v1.DeleteTemplateReply
+	(*PreviewTemplateRequest)(nil), // 8: api.admin.v1.PreviewTemplateRequest
+	(*RenderTemplateRequest)(nil),  // 9: api.admin.v1.RenderTemplateRequest
+	(*VendorTemplateCode)(nil),     // 10: api.admin.v1.VendorTemplateCode
+	(*RenderTemplateReply)(nil),    // 11: api.admin.v1.RenderTemplateReply
+	nil,                            // 12: api.admin.v1.PreviewTemplateRequest.ParamsEntry
+	nil,                            // 13: api.admin.v1.RenderTemplateRequest.ParamsEntry
+}
+var file_api_admin_v1_message_template_proto_depIdxs = []int32{
+	0,  // 0: api.admin.v1.TemplateInfo.content:type_name -> api.admin.v1.TemplateContent
+	1,  // 1: api.admin.v1.ListTemplatesReply.list:type_name -> api.admin.v1.TemplateInfo
+	0,  // 2: api.admin.v1.CreateTemplateRequest.content:type_name -> api.admin.v1.TemplateContent
+	0,  // 3: api.admin.v1.PreviewTemplateRequest.content:type_name -> api.admin.v1.TemplateContent
+	12, // 4: api.admin.v1.PreviewTemplateRequest.params:type_name -> api.admin.v1.PreviewTemplateRequest.ParamsEntry
+	13, // 5: api.admin.v1.RenderTemplateRequest.params:type_name -> api.admin.v1.RenderTemplateRequest.ParamsEntry
+	10, // 6: api.admin.v1.RenderTemplateReply.vendor_codes:type_name -> api.admin.v1.VendorTemplateCode
+	2,  // 7: api.admin.v1.MessageTemplate.ListTemplates:input_type -> api.admin.v1.ListTemplatesRequest
+	4,  // 8: api.admin.v1.MessageTemplate.CreateTemplate:input_type -> api.admin.v1.CreateTemplateRequest
+	5,  // 9: api.admin.v1.MessageTemplate.UpdateTemplate:input_type -> api.admin.v1.UpdateTemplateRequest
+	6,  // 10: api.admin.v1.MessageTemplate.DeleteTemplate:input_type -> api.admin.v1.DeleteTemplateRequest
+	8,  // 11: api.admin.v1.MessageTemplate.PreviewTemplate:input_type -> api.admin.v1.PreviewTemplateRequest
+	9,  // 12: api.admin.v1.MessageTemplate.RenderTemplate:input_type -> api.admin.v1.RenderTemplateRequest
+	3,  // 13: api.admin.v1.MessageTemplate.ListTemplates:output_type -> api.admin.v1.ListTemplatesReply
+	1,  // 14: api.admin.v1.MessageTemplate.CreateTemplate:output_type -> api.admin.v1.TemplateInfo
+	1,  // 15: api.admin.v1.MessageTemplate.UpdateTemplate:output_type -> api.admin.v1.TemplateInfo
+	7,  // 16: api.admin.v1.MessageTemplate.DeleteTemplate:output_type -> api.admin.v1.DeleteTemplateReply
+	11, // 17: api.admin.v1.MessageTemplate.PreviewTemplate:output_type -> api.admin.v1.RenderTemplateReply
+	11, // 18: api.admin.v1.MessageTemplate.RenderTemplate:output_type -> api.admin.v1.RenderTemplateReply
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_admin_v1_message_template_proto_init() }
+func file_api_admin_v1_message_template_proto_init() {
+	if File_api_admin_v1_message_template_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_admin_v1_message_template_proto_rawDesc), len(file_api_admin_v1_message_template_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_admin_v1_message_template_proto_goTypes,
+		DependencyIndexes: file_api_admin_v1_message_template_proto_depIdxs,
+		MessageInfos:      file_api_admin_v1_message_template_proto_msgTypes,
+	}.Build()
+	File_api_admin_v1_message_template_proto = out.File
+	file_api_admin_v1_message_template_proto_goTypes = nil
+	file_api_admin_v1_message_template_proto_depIdxs = nil
+}
@@ -0,0 +1,1720 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/admin/v1/message_template.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on TemplateContent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateContent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TemplateContentMultiError, or nil if none found.
+func (m *TemplateContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _TemplateContent_Channel_InLookup[m.GetChannel()]; !ok {
+		err := TemplateContentValidationError{
+			field:  "Channel",
+			reason: "value must be in list [sms email]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := TemplateContentValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLocale()) > 35 {
+		err := TemplateContentValidationError{
+			field:  "Locale",
+			reason: "value length must be at most 35 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProvider()) > 50 {
+		err := TemplateContentValidationError{
+			field:  "Provider",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSubject()) > 255 {
+		err := TemplateContentValidationError{
+			field:  "Subject",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBody()) > 65536 {
+		err := TemplateContentValidationError{
+			field:  "Body",
+			reason: "value length must be at most 65536 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTextBody()) > 65536 {
+		err := TemplateContentValidationError{
+			field:  "TextBody",
+			reason: "value length must be at most 65536 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetVendorCode()) > 100 {
+		err := TemplateContentValidationError{
+			field:  "VendorCode",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TemplateContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateContentMultiError is an error wrapping multiple validation errors
+// returned by TemplateContent.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateContentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateContentMultiError) AllErrors() []error { return m }
+
+// TemplateContentValidationError is the validation error returned by
+// TemplateContent.Validate if the designated constraints aren't met.
+type TemplateContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateContentValidationError) ErrorName() string { return "TemplateContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateContentValidationError{}
+
+var _TemplateContent_Channel_InLookup = map[string]struct{}{
+	"sms":   {},
+	"email": {},
+}
+
+// Validate checks the field values on TemplateInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TemplateInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TemplateInfoMultiError, or
+// nil if none found.
+func (m *TemplateInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateInfoValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateInfoValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateInfoValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return TemplateInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateInfoMultiError is an error wrapping multiple validation errors
+// returned by TemplateInfo.ValidateAll() if the designated constraints aren't met.
+type TemplateInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateInfoMultiError) AllErrors() []error { return m }
+
+// TemplateInfoValidationError is the validation error returned by
+// TemplateInfo.Validate if the designated constraints aren't met.
+type TemplateInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateInfoValidationError) ErrorName() string { return "TemplateInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TemplateInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateInfoValidationError{}
+
+// Validate checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplatesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplatesRequestMultiError, or nil if none found.
+func (m *ListTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListTemplatesRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := ListTemplatesRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [ sms email]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 100 {
+		err := ListTemplatesRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListTemplatesRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListTemplatesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplatesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTemplatesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// ListTemplatesRequestValidationError is the validation error returned by
+// ListTemplatesRequest.Validate if the designated constraints aren't met.
+type ListTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesRequestValidationError) ErrorName() string {
+	return "ListTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesRequestValidationError{}
+
+var _ListTemplatesRequest_Channel_InLookup = map[string]struct{}{
+	"":      {},
+	"sms":   {},
+	"email": {},
+}
+
+// Validate checks the field values on ListTemplatesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTemplatesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTemplatesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTemplatesReplyMultiError, or nil if none found.
+func (m *ListTemplatesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTemplatesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTemplatesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTemplatesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTemplatesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListTemplatesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTemplatesReplyMultiError is an error wrapping multiple validation errors
+// returned by ListTemplatesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListTemplatesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTemplatesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTemplatesReplyMultiError) AllErrors() []error { return m }
+
+// ListTemplatesReplyValidationError is the validation error returned by
+// ListTemplatesReply.Validate if the designated constraints aren't met.
+type ListTemplatesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTemplatesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTemplatesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTemplatesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTemplatesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTemplatesReplyValidationError) ErrorName() string {
+	return "ListTemplatesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTemplatesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTemplatesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTemplatesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTemplatesReplyValidationError{}
+
+// Validate checks the field values on CreateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTemplateRequestMultiError, or nil if none found.
+func (m *CreateTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetContent() == nil {
+		err := CreateTemplateRequestValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTemplateRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTemplateRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTemplateRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateTemplateRequestValidationError is the validation error returned by
+// CreateTemplateRequest.Validate if the designated constraints aren't met.
+type CreateTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTemplateRequestValidationError) ErrorName() string {
+	return "CreateTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTemplateRequestValidationError{}
+
+// Validate checks the field values on UpdateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTemplateRequestMultiError, or nil if none found.
+func (m *UpdateTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateTemplateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSubject()) > 255 {
+		err := UpdateTemplateRequestValidationError{
+			field:  "Subject",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBody()) > 65536 {
+		err := UpdateTemplateRequestValidationError{
+			field:  "Body",
+			reason: "value length must be at most 65536 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTextBody()) > 65536 {
+		err := UpdateTemplateRequestValidationError{
+			field:  "TextBody",
+			reason: "value length must be at most 65536 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetVendorCode()) > 100 {
+		err := UpdateTemplateRequestValidationError{
+			field:  "VendorCode",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() <= 0 {
+		err := UpdateTemplateRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTemplateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTemplateRequestValidationError is the validation error returned by
+// UpdateTemplateRequest.Validate if the designated constraints aren't met.
+type UpdateTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTemplateRequestValidationError) ErrorName() string {
+	return "UpdateTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTemplateRequestMultiError, or nil if none found.
+func (m *DeleteTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteTemplateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTemplateRequestValidationError is the validation error returned by
+// DeleteTemplateRequest.Validate if the designated constraints aren't met.
+type DeleteTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTemplateRequestValidationError) ErrorName() string {
+	return "DeleteTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTemplateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTemplateReplyMultiError, or nil if none found.
+func (m *DeleteTemplateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTemplateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTemplateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTemplateReplyMultiError is an error wrapping multiple validation
+// errors returned by DeleteTemplateReply.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTemplateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTemplateReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTemplateReplyMultiError) AllErrors() []error { return m }
+
+// DeleteTemplateReplyValidationError is the validation error returned by
+// DeleteTemplateReply.Validate if the designated constraints aren't met.
+type DeleteTemplateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTemplateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTemplateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTemplateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTemplateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTemplateReplyValidationError) ErrorName() string {
+	return "DeleteTemplateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTemplateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTemplateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTemplateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTemplateReplyValidationError{}
+
+// Validate checks the field values on PreviewTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewTemplateRequestMultiError, or nil if none found.
+func (m *PreviewTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetContent() == nil {
+		err := PreviewTemplateRequestValidationError{
+			field:  "Content",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetContent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreviewTemplateRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreviewTemplateRequestValidationError{
+					field:  "Content",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewTemplateRequestValidationError{
+				field:  "Content",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Params
+
+	if len(errors) > 0 {
+		return PreviewTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by PreviewTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewTemplateRequestMultiError) AllErrors() []error { return m }
+
+// PreviewTemplateRequestValidationError is the validation error returned by
+// PreviewTemplateRequest.Validate if the designated constraints aren't met.
+type PreviewTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewTemplateRequestValidationError) ErrorName() string {
+	return "PreviewTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewTemplateRequestValidationError{}
+
+// Validate checks the field values on RenderTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenderTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenderTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenderTemplateRequestMultiError, or nil if none found.
+func (m *RenderTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenderTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _RenderTemplateRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := RenderTemplateRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [sms email]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := RenderTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLocales()) > 10 {
+		err := RenderTemplateRequestValidationError{
+			field:  "Locales",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Params
+
+	if len(errors) > 0 {
+		return RenderTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenderTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by RenderTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenderTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenderTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenderTemplateRequestMultiError) AllErrors() []error { return m }
+
+// RenderTemplateRequestValidationError is the validation error returned by
+// RenderTemplateRequest.Validate if the designated constraints aren't met.
+type RenderTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenderTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenderTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenderTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenderTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenderTemplateRequestValidationError) ErrorName() string {
+	return "RenderTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenderTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenderTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenderTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenderTemplateRequestValidationError{}
+
+var _RenderTemplateRequest_Channel_InLookup = map[string]struct{}{
+	"sms":   {},
+	"email": {},
+}
+
+// Validate checks the field values on VendorTemplateCode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VendorTemplateCode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VendorTemplateCode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VendorTemplateCodeMultiError, or nil if none found.
+func (m *VendorTemplateCode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VendorTemplateCode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Provider
+
+	// no validation rules for Code
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return VendorTemplateCodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// VendorTemplateCodeMultiError is an error wrapping multiple validation errors
+// returned by VendorTemplateCode.ValidateAll() if the designated constraints
+// aren't met.
+type VendorTemplateCodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VendorTemplateCodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VendorTemplateCodeMultiError) AllErrors() []error { return m }
+
+// VendorTemplateCodeValidationError is the validation error returned by
+// VendorTemplateCode.Validate if the designated constraints aren't met.
+type VendorTemplateCodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VendorTemplateCodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VendorTemplateCodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VendorTemplateCodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VendorTemplateCodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VendorTemplateCodeValidationError) ErrorName() string {
+	return "VendorTemplateCodeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VendorTemplateCodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVendorTemplateCode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VendorTemplateCodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VendorTemplateCodeValidationError{}
+
+// Validate checks the field values on RenderTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenderTemplateReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenderTemplateReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenderTemplateReplyMultiError, or nil if none found.
+func (m *RenderTemplateReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenderTemplateReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Locale
+
+	// no validation rules for Source
+
+	// no validation rules for Subject
+
+	// no validation rules for Html
+
+	// no validation rules for Text
+
+	for idx, item := range m.GetVendorCodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RenderTemplateReplyValidationError{
+						field:  fmt.Sprintf("VendorCodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RenderTemplateReplyValidationError{
+						field:  fmt.Sprintf("VendorCodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RenderTemplateReplyValidationError{
+					field:  fmt.Sprintf("VendorCodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RenderTemplateReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenderTemplateReplyMultiError is an error wrapping multiple validation
+// errors returned by RenderTemplateReply.ValidateAll() if the designated
+// constraints aren't met.
+type RenderTemplateReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenderTemplateReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenderTemplateReplyMultiError) AllErrors() []error { return m }
+
+// RenderTemplateReplyValidationError is the validation error returned by
+// RenderTemplateReply.Validate if the designated constraints aren't met.
+type RenderTemplateReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenderTemplateReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenderTemplateReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenderTemplateReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenderTemplateReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenderTemplateReplyValidationError) ErrorName() string {
+	return "RenderTemplateReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenderTemplateReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenderTemplateReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenderTemplateReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenderTemplateReplyValidationError{}
@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: admin/v1/message_template.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MessageTemplate_ListTemplates_FullMethodName   = "/api.admin.v1.MessageTemplate/ListTemplates"
+	MessageTemplate_CreateTemplate_FullMethodName  = "/api.admin.v1.MessageTemplate/CreateTemplate"
+	MessageTemplate_UpdateTemplate_FullMethodName  = "/api.admin.v1.MessageTemplate/UpdateTemplate"
+	MessageTemplate_DeleteTemplate_FullMethodName  = "/api.admin.v1.MessageTemplate/DeleteTemplate"
+	MessageTemplate_PreviewTemplate_FullMethodName = "/api.admin.v1.MessageTemplate/PreviewTemplate"
+	MessageTemplate_RenderTemplate_FullMethodName  = "/api.admin.v1.MessageTemplate/RenderTemplate"
+)
+
+// MessageTemplateClient is the client API for MessageTemplate service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MessageTemplateClient interface {
+	// 查询短信/邮件模板
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesReply, error)
+	// 新增模板
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*TemplateInfo, error)
+	// 修改模板
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*TemplateInfo, error)
+	// 删除模板
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateReply, error)
+	// 预览模板
+	PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateReply, error)
+	// 渲染模板
+	RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateReply, error)
+}
+
+type messageTemplateClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMessageTemplateClient(cc grpc.ClientConnInterface) MessageTemplateClient {
+	return &messageTemplateClient{cc}
+}
+
+func (c *messageTemplateClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesReply)
+	err := c.cc.Invoke(ctx, MessageTemplate_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageTemplateClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*TemplateInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateInfo)
+	err := c.cc.Invoke(ctx, MessageTemplate_CreateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageTemplateClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*TemplateInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateInfo)
+	err := c.cc.Invoke(ctx, MessageTemplate_UpdateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageTemplateClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTemplateReply)
+	err := c.cc.Invoke(ctx, MessageTemplate_DeleteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageTemplateClient) PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderTemplateReply)
+	err := c.cc.Invoke(ctx, MessageTemplate_PreviewTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageTemplateClient) RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderTemplateReply)
+	err := c.cc.Invoke(ctx, MessageTemplate_RenderTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MessageTemplateServer is the server API for MessageTemplate service.
+// All implementations must embed UnimplementedMessageTemplateServer
+// for forward compatibility.
+type MessageTemplateServer interface {
+	// 查询短信/邮件模板
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesReply, error)
+	// 新增模板
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*TemplateInfo, error)
+	// 修改模板
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*TemplateInfo, error)
+	// 删除模板
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateReply, error)
+	// 预览模板
+	PreviewTemplate(context.Context, *PreviewTemplateRequest) (*RenderTemplateReply, error)
+	// 渲染模板
+	RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateReply, error)
+	mustEmbedUnimplementedMessageTemplateServer()
+}
+
+// UnimplementedMessageTemplateServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMessageTemplateServer struct{}
+
+func (UnimplementedMessageTemplateServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedMessageTemplateServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*TemplateInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedMessageTemplateServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*TemplateInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedMessageTemplateServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedMessageTemplateServer) PreviewTemplate(context.Context, *PreviewTemplateRequest) (*RenderTemplateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewTemplate not implemented")
+}
+func (UnimplementedMessageTemplateServer) RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderTemplate not implemented")
+}
+func (UnimplementedMessageTemplateServer) mustEmbedUnimplementedMessageTemplateServer() {}
+func (UnimplementedMessageTemplateServer) testEmbeddedByValue()                         {}
+
+// UnsafeMessageTemplateServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MessageTemplateServer will
+// result in compilation errors.
+type UnsafeMessageTemplateServer interface {
+	mustEmbedUnimplementedMessageTemplateServer()
+}
+
+func RegisterMessageTemplateServer(s grpc.ServiceRegistrar, srv MessageTemplateServer) {
+	// If the following call panics, it indicates UnimplementedMessageTemplateServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MessageTemplate_ServiceDesc, srv)
+}
+
+func _MessageTemplate_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageTemplateServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageTemplate_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageTemplateServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageTemplate_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageTemplateServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageTemplate_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageTemplateServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageTemplate_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageTemplateServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageTemplate_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageTemplateServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageTemplate_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageTemplateServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageTemplate_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageTemplateServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageTemplate_PreviewTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageTemplateServer).PreviewTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageTemplate_PreviewTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageTemplateServer).PreviewTemplate(ctx, req.(*PreviewTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageTemplate_RenderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageTemplateServer).RenderTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageTemplate_RenderTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageTemplateServer).RenderTemplate(ctx, req.(*RenderTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MessageTemplate_ServiceDesc is the grpc.ServiceDesc for MessageTemplate service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MessageTemplate_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.admin.v1.MessageTemplate",
+	HandlerType: (*MessageTemplateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTemplates",
+			Handler:    _MessageTemplate_ListTemplates_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _MessageTemplate_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _MessageTemplate_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _MessageTemplate_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "PreviewTemplate",
+			Handler:    _MessageTemplate_PreviewTemplate_Handler,
+		},
+		{
+			MethodName: "RenderTemplate",
+			Handler:    _MessageTemplate_RenderTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin/v1/message_template.proto",
+}
@@ -0,0 +1,1075 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: api/chat/v1/chat.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/google/gnostic/openapiv3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MessageInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 会话 ID
+	ConversationId string `protobuf:"bytes,2,opt,name=conversation_id,proto3" json:"conversation_id,omitempty"`
+	// 发送者
+	SenderId int64 `protobuf:"varint,3,opt,name=sender_id,proto3" json:"sender_id,omitempty"`
+	// 消息类型
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// 内容，文本以外的类型为 JSON
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// 发送时间
+	CreatedAt int64 `protobuf:"varint,6,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// 状态
+	Status int32 `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	// 编辑时间
+	EditedAt int64 `protobuf:"varint,8,opt,name=edited_at,proto3" json:"edited_at,omitempty"`
+	// 撤回时间
+	RecalledAt    int64 `protobuf:"varint,9,opt,name=recalled_at,proto3" json:"recalled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageInfo) Reset() {
+	*x = MessageInfo{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageInfo) ProtoMessage() {}
+
+func (x *MessageInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageInfo.ProtoReflect.Descriptor instead.
+func (*MessageInfo) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *MessageInfo) GetSenderId() int64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MessageInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MessageInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetEditedAt() int64 {
+	if x != nil {
+		return x.EditedAt
+	}
+	return 0
+}
+
+func (x *MessageInfo) GetRecalledAt() int64 {
+	if x != nil {
+		return x.RecalledAt
+	}
+	return 0
+}
+
+type ConversationInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 会话 ID
+	ConversationId string `protobuf:"bytes,1,opt,name=conversation_id,proto3" json:"conversation_id,omitempty"`
+	// 对方用户 ID
+	PeerId int64 `protobuf:"varint,2,opt,name=peer_id,proto3" json:"peer_id,omitempty"`
+	// 最后一条消息
+	LastMessage *MessageInfo `protobuf:"bytes,3,opt,name=last_message,proto3" json:"last_message,omitempty"`
+	// 未读数
+	UnreadCount int32 `protobuf:"varint,4,opt,name=unread_count,proto3" json:"unread_count,omitempty"`
+	// 已读位置
+	LastReadMessageId int64 `protobuf:"varint,5,opt,name=last_read_message_id,proto3" json:"last_read_message_id,omitempty"`
+	// 群组 ID
+	GroupId       int64 `protobuf:"varint,6,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationInfo) Reset() {
+	*x = ConversationInfo{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationInfo) ProtoMessage() {}
+
+func (x *ConversationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationInfo.ProtoReflect.Descriptor instead.
+func (*ConversationInfo) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConversationInfo) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ConversationInfo) GetPeerId() int64 {
+	if x != nil {
+		return x.PeerId
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetLastMessage() *MessageInfo {
+	if x != nil {
+		return x.LastMessage
+	}
+	return nil
+}
+
+func (x *ConversationInfo) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetLastReadMessageId() int64 {
+	if x != nil {
+		return x.LastReadMessageId
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type ListConversationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 页码
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页条数
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsRequest) Reset() {
+	*x = ListConversationsRequest{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsRequest) ProtoMessage() {}
+
+func (x *ListConversationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsRequest.ProtoReflect.Descriptor instead.
+func (*ListConversationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListConversationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListConversationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListConversationsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 会话列表
+	List []*ConversationInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 总数
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConversationsReply) Reset() {
+	*x = ListConversationsReply{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConversationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConversationsReply) ProtoMessage() {}
+
+func (x *ListConversationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConversationsReply.ProtoReflect.Descriptor instead.
+func (*ListConversationsReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListConversationsReply) GetList() []*ConversationInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListConversationsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 会话 ID
+	ConversationId string `protobuf:"bytes,1,opt,name=conversation_id,proto3" json:"conversation_id,omitempty"`
+	// 游标
+	BeforeId int64 `protobuf:"varint,2,opt,name=before_id,proto3" json:"before_id,omitempty"`
+	// 条数
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessagesRequest) Reset() {
+	*x = ListMessagesRequest{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesRequest) ProtoMessage() {}
+
+func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMessagesRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListMessagesRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *ListMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMessagesReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息列表
+	List []*MessageInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 下一页游标
+	NextBeforeId  int64 `protobuf:"varint,2,opt,name=next_before_id,proto3" json:"next_before_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessagesReply) Reset() {
+	*x = ListMessagesReply{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessagesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessagesReply) ProtoMessage() {}
+
+func (x *ListMessagesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessagesReply.ProtoReflect.Descriptor instead.
+func (*ListMessagesReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMessagesReply) GetList() []*MessageInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListMessagesReply) GetNextBeforeId() int64 {
+	if x != nil {
+		return x.NextBeforeId
+	}
+	return 0
+}
+
+type MarkConversationReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 会话 ID
+	ConversationId string `protobuf:"bytes,1,opt,name=conversation_id,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MarkConversationReadRequest) Reset() {
+	*x = MarkConversationReadRequest{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkConversationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationReadRequest) ProtoMessage() {}
+
+func (x *MarkConversationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkConversationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkConversationReadRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarkConversationReadRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type MarkConversationReadReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkConversationReadReply) Reset() {
+	*x = MarkConversationReadReply{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkConversationReadReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationReadReply) ProtoMessage() {}
+
+func (x *MarkConversationReadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkConversationReadReply.ProtoReflect.Descriptor instead.
+func (*MarkConversationReadReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{7}
+}
+
+type GetMessageReceiptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息 ID
+	MessageId     int64 `protobuf:"varint,1,opt,name=message_id,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageReceiptRequest) Reset() {
+	*x = GetMessageReceiptRequest{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReceiptRequest) ProtoMessage() {}
+
+func (x *GetMessageReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMessageReceiptRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type GetMessageReceiptReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 已读数
+	ReadCount int32 `protobuf:"varint,1,opt,name=read_count,proto3" json:"read_count,omitempty"`
+	// 未读数
+	UnreadCount   int32 `protobuf:"varint,2,opt,name=unread_count,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageReceiptReply) Reset() {
+	*x = GetMessageReceiptReply{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageReceiptReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReceiptReply) ProtoMessage() {}
+
+func (x *GetMessageReceiptReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReceiptReply.ProtoReflect.Descriptor instead.
+func (*GetMessageReceiptReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMessageReceiptReply) GetReadCount() int32 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+func (x *GetMessageReceiptReply) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type RecallMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息 ID
+	MessageId     int64 `protobuf:"varint,1,opt,name=message_id,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecallMessageRequest) Reset() {
+	*x = RecallMessageRequest{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallMessageRequest) ProtoMessage() {}
+
+func (x *RecallMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallMessageRequest.ProtoReflect.Descriptor instead.
+func (*RecallMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RecallMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type RecallMessageReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecallMessageReply) Reset() {
+	*x = RecallMessageReply{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecallMessageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecallMessageReply) ProtoMessage() {}
+
+func (x *RecallMessageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecallMessageReply.ProtoReflect.Descriptor instead.
+func (*RecallMessageReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{11}
+}
+
+type EditMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息 ID
+	MessageId int64 `protobuf:"varint,1,opt,name=message_id,proto3" json:"message_id,omitempty"`
+	// 新内容
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageRequest) Reset() {
+	*x = EditMessageRequest{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageRequest) ProtoMessage() {}
+
+func (x *EditMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageRequest.ProtoReflect.Descriptor instead.
+func (*EditMessageRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EditMessageRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *EditMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type MessageEditInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 编辑前的内容
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// 编辑时间
+	EditedAt      int64 `protobuf:"varint,2,opt,name=edited_at,proto3" json:"edited_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageEditInfo) Reset() {
+	*x = MessageEditInfo{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageEditInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEditInfo) ProtoMessage() {}
+
+func (x *MessageEditInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEditInfo.ProtoReflect.Descriptor instead.
+func (*MessageEditInfo) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MessageEditInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MessageEditInfo) GetEditedAt() int64 {
+	if x != nil {
+		return x.EditedAt
+	}
+	return 0
+}
+
+type ListMessageEditsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息 ID
+	MessageId     int64 `protobuf:"varint,1,opt,name=message_id,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageEditsRequest) Reset() {
+	*x = ListMessageEditsRequest{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageEditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageEditsRequest) ProtoMessage() {}
+
+func (x *ListMessageEditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageEditsRequest.ProtoReflect.Descriptor instead.
+func (*ListMessageEditsRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListMessageEditsRequest) GetMessageId() int64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type ListMessageEditsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 编辑历史
+	List          []*MessageEditInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageEditsReply) Reset() {
+	*x = ListMessageEditsReply{}
+	mi := &file_api_chat_v1_chat_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageEditsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageEditsReply) ProtoMessage() {}
+
+func (x *ListMessageEditsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageEditsReply.ProtoReflect.Descriptor instead.
+func (*ListMessageEditsReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMessageEditsReply) GetList() []*MessageEditInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_api_chat_v1_chat_proto protoreflect.FileDescriptor
+
+const file_api_chat_v1_chat_proto_rawDesc = "" +
+	"\n" +
+	"\x16api/chat/v1/chat.proto\x12\vapi.chat.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1copenapi/v3/annotations.proto\"\x8f\a\n" +
+	"\vMessageInfo\x121\n" +
+	"\x02id\x18\x01 \x01(\x03B!\xbaG\x1e\x92\x02\x1b消息 ID，按时间递增R\x02id\x129\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tB\x0f\xbaG\f\x92\x02\t会话 IDR\x0fconversation_id\x126\n" +
+	"\tsender_id\x18\x03 \x01(\x03B\x18\xbaG\x15\x92\x02\x12发送者用户 IDR\tsender_id\x12L\n" +
+	"\x04type\x18\x04 \x01(\tB8\xbaG5\x92\x022消息类型：text/image/file/voice/location/cardR\x04type\x12\xfb\x01\n" +
+	"\acontent\x18\x05 \x01(\tB\xe0\x01\xbaG\xdc\x01\x92\x02\xd8\x01消息内容：text 为文本；image/file/voice 为附件 JSON，url、thumbnail_url 为访问地址（私有文件为临时签名URL）；location 为经纬度、名称、地址 JSON；card 为自定义 JSON 对象R\acontent\x12A\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03B!\xbaG\x1e\x92\x02\x1b发送时间戳，单位秒R\n" +
+	"created_at\x12\x84\x01\n" +
+	"\x06status\x18\a \x01(\x05Bl\xbaGi\x92\x02f单聊消息状态：0 未读，1 已读；群聊固定为 0，已读情况见消息已读情况接口R\x06status\x12V\n" +
+	"\tedited_at\x18\b \x01(\x03B8\xbaG5\x92\x022最后编辑时间戳，单位秒，未编辑为 0R\tedited_at\x12l\n" +
+	"\vrecalled_at\x18\t \x01(\x03BJ\xbaGG\x92\x02D撤回时间戳，单位秒，未撤回为 0；撤回后内容为空R\vrecalled_at\"\xac\x04\n" +
+	"\x10ConversationInfo\x12\x82\x01\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tBX\xbaGU\x92\x02R会话 ID，单聊为 p_{较小用户ID}_{较大用户ID}，群聊为 g_{群组ID}R\x0fconversation_id\x125\n" +
+	"\apeer_id\x18\x02 \x01(\x03B\x1b\xbaG\x18\x92\x02\x15单聊对方用户 IDR\apeer_id\x12V\n" +
+	"\flast_message\x18\x03 \x01(\v2\x18.api.chat.v1.MessageInfoB\x18\xbaG\x15\x92\x02\x12最后一条消息R\flast_message\x12o\n" +
+	"\funread_count\x18\x04 \x01(\x05BK\xbaGH\x92\x02E未读消息数，群聊为已读位置之后他人发送的消息数R\funread_count\x12O\n" +
+	"\x14last_read_message_id\x18\x05 \x01(\x03B\x1b\xbaG\x18\x92\x02\x15已读到的消息 IDR\x14last_read_message_id\x12B\n" +
+	"\bgroup_id\x18\x06 \x01(\x03B&\xbaG#\x92\x02 群聊的群组 ID，单聊为 0R\bgroup_id\"\xb1\x01\n" +
+	"\x18ListConversationsRequest\x12A\n" +
+	"\x04page\x18\x01 \x01(\x05B-\xfaB\x04\x1a\x02(\x00\xbaG#\x92\x02 页码，从 1 开始，默认 1R\x04page\x12R\n" +
+	"\tpage_size\x18\x02 \x01(\x05B4\xfaB\x06\x1a\x04\x18d(\x00\xbaG(\x92\x02%每页条数，默认 20，最大 100R\tpage_size\"\x89\x01\n" +
+	"\x16ListConversationsReply\x12E\n" +
+	"\x04list\x18\x01 \x03(\v2\x1d.api.chat.v1.ConversationInfoB\x12\xbaG\x0f\x92\x02\f会话列表R\x04list\x12(\n" +
+	"\x05total\x18\x02 \x01(\x03B\x12\xbaG\x0f\x92\x02\f会话总数R\x05total\"\x8f\x02\n" +
+	"\x13ListMessagesRequest\x12B\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tB\x18\xfaB\x06r\x04\x10\x01\x18@\xbaG\f\x92\x02\t会话 IDR\x0fconversation_id\x12n\n" +
+	"\tbefore_id\x18\x02 \x01(\x03BP\xfaB\x04\"\x02(\x00\xbaGF\x92\x02C只返回 ID 小于该值的消息，不传时从最新一条开始R\tbefore_id\x12D\n" +
+	"\x05limit\x18\x03 \x01(\x05B.\xfaB\x06\x1a\x04\x18d(\x00\xbaG\"\x92\x02\x1f条数，默认 20，最大 100R\x05limit\"\xbb\x01\n" +
+	"\x11ListMessagesReply\x12P\n" +
+	"\x04list\x18\x01 \x03(\v2\x18.api.chat.v1.MessageInfoB\"\xbaG\x1f\x92\x02\x1c消息列表，按 ID 倒序R\x04list\x12T\n" +
+	"\x0enext_before_id\x18\x02 \x01(\x03B,\xbaG)\x92\x02&下一页游标，没有更多时为 0R\x0enext_before_id\"a\n" +
+	"\x1bMarkConversationReadRequest\x12B\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tB\x18\xfaB\x06r\x04\x10\x01\x18@\xbaG\f\x92\x02\t会话 IDR\x0fconversation_id\"\x1b\n" +
+	"\x19MarkConversationReadReply\"R\n" +
+	"\x18GetMessageReceiptRequest\x126\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t消息 IDR\n" +
+	"message_id\"\x84\x01\n" +
+	"\x16GetMessageReceiptReply\x122\n" +
+	"\n" +
+	"read_count\x18\x01 \x01(\x05B\x12\xbaG\x0f\x92\x02\f已读人数R\n" +
+	"read_count\x126\n" +
+	"\funread_count\x18\x02 \x01(\x05B\x12\xbaG\x0f\x92\x02\f未读人数R\funread_count\"N\n" +
+	"\x14RecallMessageRequest\x126\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t消息 IDR\n" +
+	"message_id\"\x14\n" +
+	"\x12RecallMessageReply\"\xa6\x01\n" +
+	"\x12EditMessageRequest\x126\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t消息 IDR\n" +
+	"message_id\x12X\n" +
+	"\acontent\x18\x02 \x01(\tB>\xfaB\br\x06\x10\x01\x18\xa0\x9c\x01\xbaG0\x92\x02-新的消息内容，只能编辑文本消息R\acontent\"\x86\x01\n" +
+	"\x0fMessageEditInfo\x122\n" +
+	"\acontent\x18\x01 \x01(\tB\x18\xbaG\x15\x92\x02\x12编辑前的内容R\acontent\x12?\n" +
+	"\tedited_at\x18\x02 \x01(\x03B!\xbaG\x1e\x92\x02\x1b编辑时间戳，单位秒R\tedited_at\"Q\n" +
+	"\x17ListMessageEditsRequest\x126\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t消息 IDR\n" +
+	"message_id\"u\n" +
+	"\x15ListMessageEditsReply\x12\\\n" +
+	"\x04list\x18\x01 \x03(\v2\x1c.api.chat.v1.MessageEditInfoB*\xbaG'\x92\x02$编辑历史，按编辑时间倒序R\x04list2\xa8\r\n" +
+	"\x04Chat\x12\xd7\x01\n" +
+	"\x11ListConversations\x12%.api.chat.v1.ListConversationsRequest\x1a#.api.chat.v1.ListConversationsReply\"v\xbaGX\x12\f会话列表\x1aH按最后一条消息时间倒序，附带最后一条消息及未读数\x82\xd3\xe4\x93\x02\x15\x12\x13/chat/conversations\x12\xfb\x01\n" +
+	"\fListMessages\x12 .api.chat.v1.ListMessagesRequest\x1a\x1e.api.chat.v1.ListMessagesReply\"\xa8\x01\xbaGo\x12\f历史消息\x1a_按消息 ID 倒序分页，首次不传 before_id，之后传上一页返回的 next_before_id\x82\xd3\xe4\x93\x020\x12./chat/conversations/{conversation_id}/messages\x12\xa6\x02\n" +
+	"\x14MarkConversationRead\x12(.api.chat.v1.MarkConversationReadRequest\x1a&.api.chat.v1.MarkConversationReadReply\"\xbb\x01\xbaG\x82\x01\x12\x12标记会话已读\x1al清零未读数，已读位置更新到会话的最后一条消息，并向消息发送者推送已读回执\x82\xd3\xe4\x93\x02/:\x01*\"*/chat/conversations/{conversation_id}/read\x12\xf7\x01\n" +
+	"\x11GetMessageReceipt\x12%.api.chat.v1.GetMessageReceiptRequest\x1a#.api.chat.v1.GetMessageReceiptReply\"\x95\x01\xbaGg\x12\x12消息已读情况\x1aQ单聊为对方是否已读，群聊为除发送者外已读、未读的成员数\x82\xd3\xe4\x93\x02%\x12#/chat/messages/{message_id}/receipt\x12\xea\x01\n" +
+	"\rRecallMessage\x12!.api.chat.v1.RecallMessageRequest\x1a\x1f.api.chat.v1.RecallMessageReply\"\x94\x01\xbaGd\x12\f撤回消息\x1aT发送者在可撤回时间内撤回，撤回后内容清空并通知会话参与者\x82\xd3\xe4\x93\x02':\x01*\"\"/chat/messages/{message_id}/recall\x12\xe1\x01\n" +
+	"\vEditMessage\x12\x1f.api.chat.v1.EditMessageRequest\x1a\x18.api.chat.v1.MessageInfo\"\x96\x01\xbaGm\x12\f编辑消息\x1a]发送者在可编辑时间内修改，编辑前的内容记入历史并通知会话参与者\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/chat/messages/{message_id}\x12\xd3\x01\n" +
+	"\x10ListMessageEdits\x12$.api.chat.v1.ListMessageEditsRequest\x1a\".api.chat.v1.ListMessageEditsReply\"u\xbaGI\x12\x12消息编辑历史\x1a3按编辑时间倒序，每条为编辑前的内容\x82\xd3\xe4\x93\x02#\x12!/chat/messages/{message_id}/editsBM\n" +
+	"\vapi.chat.v1P\x01Z<github.com/sober-studio/bubble-boot-go-kratos/api/chat/v1;v1b\x06proto3"
+
+var (
+	file_api_chat_v1_chat_proto_rawDescOnce sync.Once
+	file_api_chat_v1_chat_proto_rawDescData []byte
+)
+
+func file_api_chat_v1_chat_proto_rawDescGZIP() []byte {
+	file_api_chat_v1_chat_proto_rawDescOnce.Do(func() {
+		file_api_chat_v1_chat_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_chat_v1_chat_proto_rawDesc), len(file_api_chat_v1_chat_proto_rawDesc)))
+	})
+	return file_api_chat_v1_chat_proto_rawDescData
+}
+
+var file_api_chat_v1_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_api_chat_v1_chat_proto_goTypes = []any{
+	(*MessageInfo)(nil),                 // 0: api.chat.v1.MessageInfo
+	(*ConversationInfo)(nil),            // 1: api.chat.v1.ConversationInfo
+	(*ListConversationsRequest)(nil),    // 2: api.chat.v1.ListConversationsRequest
+	(*ListConversationsReply)(nil),      // 3: api.chat.v1.ListConversationsReply
+	(*ListMessagesRequest)(nil),         // 4: api.chat.v1.ListMessagesRequest
+	(*ListMessagesReply)(nil),           // 5: api.chat.v1.ListMessagesReply
+	(*MarkConversationReadRequest)(nil), // 6: api.chat.v1.MarkConversationReadRequest
+	(*MarkConversationReadReply)(nil),   // 7: api.chat.v1.MarkConversationReadReply
+	(*GetMessageReceiptRequest)(nil),    // 8: api.chat.v1.GetMessageReceiptRequest
+	(*GetMessageReceiptReply)(nil),      // 9: api.chat.v1.GetMessageReceiptReply
+	(*RecallMessageRequest)(nil),        // 10: api.chat.v1.RecallMessageRequest
+	(*RecallMessageReply)(nil),          // 11: api.chat.v1.RecallMessageReply
+	(*EditMessageRequest)(nil),          // 12: api.chat.v1.EditMessageRequest
+	(*MessageEditInfo)(nil),             // 13: api.chat.v1.MessageEditInfo
+	(*ListMessageEditsRequest)(nil),     // 14: api.chat.v1.ListMessageEditsRequest
+	(*ListMessageEditsReply)(nil),       // 15: api.chat.v1.ListMessageEditsReply
+}
+var file_api_chat_v1_chat_proto_depIdxs = []int32{
+	0,  // 0: api.chat.v1.ConversationInfo.last_message:type_name -> api.chat.v1.MessageInfo
+	1,  // 1: api.chat.v1.ListConversationsReply.list:type_name -> api.chat.v1.ConversationInfo
+	0,  // 2: api.chat.v1.ListMessagesReply.list:type_name -> api.chat.v1.MessageInfo
+	13, // 3: api.chat.v1.ListMessageEditsReply.list:type_name -> api.chat.v1.MessageEditInfo
+	2,  // 4: api.chat.v1.Chat.ListConversations:input_type -> api.chat.v1.ListConversationsRequest
+	4,  // 5: api.chat.v1.Chat.ListMessages:input_type -> api.chat.v1.ListMessagesRequest
+	6,  // 6: api.chat.v1.Chat.MarkConversationRead:input_type -> api.chat.v1.MarkConversationReadRequest
+	8,  // 7: api.chat.v1.Chat.GetMessageReceipt:input_type -> api.chat.v1.GetMessageReceiptRequest
+	10, // 8: api.chat.v1.Chat.RecallMessage:input_type -> api.chat.v1.RecallMessageRequest
+	12, // 9: api.chat.v1.Chat.EditMessage:input_type -> api.chat.v1.EditMessageRequest
+	14, // 10: api.chat.v1.Chat.ListMessageEdits:input_type -> api.chat.v1.ListMessageEditsRequest
+	3,  // 11: api.chat.v1.Chat.ListConversations:output_type -> api.chat.v1.ListConversationsReply
+	5,  // 12: api.chat.v1.Chat.ListMessages:output_type -> api.chat.v1.ListMessagesReply
+	7,  // 13: api.chat.v1.Chat.MarkConversationRead:output_type -> api.chat.v1.MarkConversationReadReply
+	9,  // 14: api.chat.v1.Chat.GetMessageReceipt:output_type -> api.chat.v1.GetMessageReceiptReply
+	11, // 15: api.chat.v1.Chat.RecallMessage:output_type -> api.chat.v1.RecallMessageReply
+	0,  // 16: api.chat.v1.Chat.EditMessage:output_type -> api.chat.v1.MessageInfo
+	15, // 17: api.chat.v1.Chat.ListMessageEdits:output_type -> api.chat.v1.ListMessageEditsReply
+	11, // [11:18] is the sub-list for method output_type
+	4,  // [4:11] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_chat_v1_chat_proto_init() }
+func file_api_chat_v1_chat_proto_init() {
+	if File_api_chat_v1_chat_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_chat_v1_chat_proto_rawDesc), len(file_api_chat_v1_chat_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_chat_v1_chat_proto_goTypes,
+		DependencyIndexes: file_api_chat_v1_chat_proto_depIdxs,
+		MessageInfos:      file_api_chat_v1_chat_proto_msgTypes,
+	}.Build()
+	File_api_chat_v1_chat_proto = out.File
+	file_api_chat_v1_chat_proto_goTypes = nil
+	file_api_chat_v1_chat_proto_depIdxs = nil
+}
@@ -0,0 +1,1954 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/chat/v1/chat.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on MessageInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MessageInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MessageInfoMultiError, or
+// nil if none found.
+func (m *MessageInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ConversationId
+
+	// no validation rules for SenderId
+
+	// no validation rules for Type
+
+	// no validation rules for Content
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Status
+
+	// no validation rules for EditedAt
+
+	// no validation rules for RecalledAt
+
+	if len(errors) > 0 {
+		return MessageInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageInfoMultiError is an error wrapping multiple validation errors
+// returned by MessageInfo.ValidateAll() if the designated constraints aren't met.
+type MessageInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageInfoMultiError) AllErrors() []error { return m }
+
+// MessageInfoValidationError is the validation error returned by
+// MessageInfo.Validate if the designated constraints aren't met.
+type MessageInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageInfoValidationError) ErrorName() string { return "MessageInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageInfoValidationError{}
+
+// Validate checks the field values on ConversationInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConversationInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConversationInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConversationInfoMultiError, or nil if none found.
+func (m *ConversationInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConversationInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConversationId
+
+	// no validation rules for PeerId
+
+	if all {
+		switch v := interface{}(m.GetLastMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConversationInfoValidationError{
+					field:  "LastMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConversationInfoValidationError{
+					field:  "LastMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConversationInfoValidationError{
+				field:  "LastMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UnreadCount
+
+	// no validation rules for LastReadMessageId
+
+	// no validation rules for GroupId
+
+	if len(errors) > 0 {
+		return ConversationInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConversationInfoMultiError is an error wrapping multiple validation errors
+// returned by ConversationInfo.ValidateAll() if the designated constraints
+// aren't met.
+type ConversationInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConversationInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConversationInfoMultiError) AllErrors() []error { return m }
+
+// ConversationInfoValidationError is the validation error returned by
+// ConversationInfo.Validate if the designated constraints aren't met.
+type ConversationInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConversationInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConversationInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConversationInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConversationInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConversationInfoValidationError) ErrorName() string { return "ConversationInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConversationInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConversationInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConversationInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConversationInfoValidationError{}
+
+// Validate checks the field values on ListConversationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConversationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConversationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConversationsRequestMultiError, or nil if none found.
+func (m *ListConversationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConversationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := ListConversationsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListConversationsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListConversationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConversationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListConversationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListConversationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConversationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConversationsRequestMultiError) AllErrors() []error { return m }
+
+// ListConversationsRequestValidationError is the validation error returned by
+// ListConversationsRequest.Validate if the designated constraints aren't met.
+type ListConversationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConversationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConversationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConversationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConversationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConversationsRequestValidationError) ErrorName() string {
+	return "ListConversationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConversationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConversationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConversationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConversationsRequestValidationError{}
+
+// Validate checks the field values on ListConversationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConversationsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConversationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConversationsReplyMultiError, or nil if none found.
+func (m *ListConversationsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConversationsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConversationsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConversationsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConversationsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListConversationsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConversationsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListConversationsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListConversationsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConversationsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConversationsReplyMultiError) AllErrors() []error { return m }
+
+// ListConversationsReplyValidationError is the validation error returned by
+// ListConversationsReply.Validate if the designated constraints aren't met.
+type ListConversationsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConversationsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConversationsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConversationsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConversationsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConversationsReplyValidationError) ErrorName() string {
+	return "ListConversationsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConversationsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConversationsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConversationsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConversationsReplyValidationError{}
+
+// Validate checks the field values on ListMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessagesRequestMultiError, or nil if none found.
+func (m *ListMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetConversationId()); l < 1 || l > 64 {
+		err := ListMessagesRequestValidationError{
+			field:  "ConversationId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBeforeId() < 0 {
+		err := ListMessagesRequestValidationError{
+			field:  "BeforeId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListMessagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListMessagesRequestValidationError is the validation error returned by
+// ListMessagesRequest.Validate if the designated constraints aren't met.
+type ListMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessagesRequestValidationError) ErrorName() string {
+	return "ListMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessagesRequestValidationError{}
+
+// Validate checks the field values on ListMessagesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListMessagesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessagesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessagesReplyMultiError, or nil if none found.
+func (m *ListMessagesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessagesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMessagesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMessagesReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMessagesReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextBeforeId
+
+	if len(errors) > 0 {
+		return ListMessagesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessagesReplyMultiError is an error wrapping multiple validation errors
+// returned by ListMessagesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ListMessagesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessagesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessagesReplyMultiError) AllErrors() []error { return m }
+
+// ListMessagesReplyValidationError is the validation error returned by
+// ListMessagesReply.Validate if the designated constraints aren't met.
+type ListMessagesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessagesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessagesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessagesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessagesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessagesReplyValidationError) ErrorName() string {
+	return "ListMessagesReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessagesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessagesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessagesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessagesReplyValidationError{}
+
+// Validate checks the field values on MarkConversationReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkConversationReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkConversationReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkConversationReadRequestMultiError, or nil if none found.
+func (m *MarkConversationReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkConversationReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetConversationId()); l < 1 || l > 64 {
+		err := MarkConversationReadRequestValidationError{
+			field:  "ConversationId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MarkConversationReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkConversationReadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkConversationReadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MarkConversationReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkConversationReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkConversationReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkConversationReadRequestValidationError is the validation error returned
+// by MarkConversationReadRequest.Validate if the designated constraints
+// aren't met.
+type MarkConversationReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkConversationReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkConversationReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkConversationReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkConversationReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkConversationReadRequestValidationError) ErrorName() string {
+	return "MarkConversationReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkConversationReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkConversationReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkConversationReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkConversationReadRequestValidationError{}
+
+// Validate checks the field values on MarkConversationReadReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkConversationReadReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkConversationReadReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkConversationReadReplyMultiError, or nil if none found.
+func (m *MarkConversationReadReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkConversationReadReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MarkConversationReadReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkConversationReadReplyMultiError is an error wrapping multiple validation
+// errors returned by MarkConversationReadReply.ValidateAll() if the
+// designated constraints aren't met.
+type MarkConversationReadReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkConversationReadReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkConversationReadReplyMultiError) AllErrors() []error { return m }
+
+// MarkConversationReadReplyValidationError is the validation error returned by
+// MarkConversationReadReply.Validate if the designated constraints aren't met.
+type MarkConversationReadReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkConversationReadReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkConversationReadReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkConversationReadReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkConversationReadReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkConversationReadReplyValidationError) ErrorName() string {
+	return "MarkConversationReadReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkConversationReadReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkConversationReadReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkConversationReadReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkConversationReadReplyValidationError{}
+
+// Validate checks the field values on GetMessageReceiptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageReceiptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageReceiptRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageReceiptRequestMultiError, or nil if none found.
+func (m *GetMessageReceiptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageReceiptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMessageId() <= 0 {
+		err := GetMessageReceiptRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetMessageReceiptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessageReceiptRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMessageReceiptRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetMessageReceiptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageReceiptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageReceiptRequestMultiError) AllErrors() []error { return m }
+
+// GetMessageReceiptRequestValidationError is the validation error returned by
+// GetMessageReceiptRequest.Validate if the designated constraints aren't met.
+type GetMessageReceiptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageReceiptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageReceiptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageReceiptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageReceiptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageReceiptRequestValidationError) ErrorName() string {
+	return "GetMessageReceiptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageReceiptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageReceiptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageReceiptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageReceiptRequestValidationError{}
+
+// Validate checks the field values on GetMessageReceiptReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageReceiptReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageReceiptReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageReceiptReplyMultiError, or nil if none found.
+func (m *GetMessageReceiptReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageReceiptReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReadCount
+
+	// no validation rules for UnreadCount
+
+	if len(errors) > 0 {
+		return GetMessageReceiptReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessageReceiptReplyMultiError is an error wrapping multiple validation
+// errors returned by GetMessageReceiptReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetMessageReceiptReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageReceiptReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageReceiptReplyMultiError) AllErrors() []error { return m }
+
+// GetMessageReceiptReplyValidationError is the validation error returned by
+// GetMessageReceiptReply.Validate if the designated constraints aren't met.
+type GetMessageReceiptReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageReceiptReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageReceiptReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageReceiptReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageReceiptReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageReceiptReplyValidationError) ErrorName() string {
+	return "GetMessageReceiptReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageReceiptReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageReceiptReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageReceiptReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageReceiptReplyValidationError{}
+
+// Validate checks the field values on RecallMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecallMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecallMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecallMessageRequestMultiError, or nil if none found.
+func (m *RecallMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecallMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMessageId() <= 0 {
+		err := RecallMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RecallMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecallMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by RecallMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecallMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecallMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecallMessageRequestMultiError) AllErrors() []error { return m }
+
+// RecallMessageRequestValidationError is the validation error returned by
+// RecallMessageRequest.Validate if the designated constraints aren't met.
+type RecallMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecallMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecallMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecallMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecallMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecallMessageRequestValidationError) ErrorName() string {
+	return "RecallMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecallMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecallMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecallMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecallMessageRequestValidationError{}
+
+// Validate checks the field values on RecallMessageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecallMessageReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecallMessageReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecallMessageReplyMultiError, or nil if none found.
+func (m *RecallMessageReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecallMessageReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RecallMessageReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecallMessageReplyMultiError is an error wrapping multiple validation errors
+// returned by RecallMessageReply.ValidateAll() if the designated constraints
+// aren't met.
+type RecallMessageReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecallMessageReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecallMessageReplyMultiError) AllErrors() []error { return m }
+
+// RecallMessageReplyValidationError is the validation error returned by
+// RecallMessageReply.Validate if the designated constraints aren't met.
+type RecallMessageReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecallMessageReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecallMessageReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecallMessageReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecallMessageReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecallMessageReplyValidationError) ErrorName() string {
+	return "RecallMessageReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecallMessageReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecallMessageReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecallMessageReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecallMessageReplyValidationError{}
+
+// Validate checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditMessageRequestMultiError, or nil if none found.
+func (m *EditMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMessageId() <= 0 {
+		err := EditMessageRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 20000 {
+		err := EditMessageRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 20000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EditMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by EditMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditMessageRequestMultiError) AllErrors() []error { return m }
+
+// EditMessageRequestValidationError is the validation error returned by
+// EditMessageRequest.Validate if the designated constraints aren't met.
+type EditMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditMessageRequestValidationError) ErrorName() string {
+	return "EditMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditMessageRequestValidationError{}
+
+// Validate checks the field values on MessageEditInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MessageEditInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageEditInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MessageEditInfoMultiError, or nil if none found.
+func (m *MessageEditInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageEditInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Content
+
+	// no validation rules for EditedAt
+
+	if len(errors) > 0 {
+		return MessageEditInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageEditInfoMultiError is an error wrapping multiple validation errors
+// returned by MessageEditInfo.ValidateAll() if the designated constraints
+// aren't met.
+type MessageEditInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageEditInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageEditInfoMultiError) AllErrors() []error { return m }
+
+// MessageEditInfoValidationError is the validation error returned by
+// MessageEditInfo.Validate if the designated constraints aren't met.
+type MessageEditInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageEditInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageEditInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageEditInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageEditInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageEditInfoValidationError) ErrorName() string { return "MessageEditInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageEditInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageEditInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageEditInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageEditInfoValidationError{}
+
+// Validate checks the field values on ListMessageEditsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessageEditsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessageEditsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessageEditsRequestMultiError, or nil if none found.
+func (m *ListMessageEditsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessageEditsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMessageId() <= 0 {
+		err := ListMessageEditsRequestValidationError{
+			field:  "MessageId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListMessageEditsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessageEditsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMessageEditsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMessageEditsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessageEditsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessageEditsRequestMultiError) AllErrors() []error { return m }
+
+// ListMessageEditsRequestValidationError is the validation error returned by
+// ListMessageEditsRequest.Validate if the designated constraints aren't met.
+type ListMessageEditsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessageEditsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessageEditsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessageEditsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessageEditsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessageEditsRequestValidationError) ErrorName() string {
+	return "ListMessageEditsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessageEditsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessageEditsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessageEditsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessageEditsRequestValidationError{}
+
+// Validate checks the field values on ListMessageEditsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessageEditsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessageEditsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessageEditsReplyMultiError, or nil if none found.
+func (m *ListMessageEditsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessageEditsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMessageEditsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMessageEditsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMessageEditsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMessageEditsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessageEditsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListMessageEditsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListMessageEditsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessageEditsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessageEditsReplyMultiError) AllErrors() []error { return m }
+
+// ListMessageEditsReplyValidationError is the validation error returned by
+// ListMessageEditsReply.Validate if the designated constraints aren't met.
+type ListMessageEditsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessageEditsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessageEditsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessageEditsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessageEditsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessageEditsReplyValidationError) ErrorName() string {
+	return "ListMessageEditsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessageEditsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessageEditsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessageEditsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessageEditsReplyValidationError{}
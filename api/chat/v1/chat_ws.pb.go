@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: api/chat/v1/chat_ws.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// chat 动作：发送单聊消息
+type SendChatRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者用户 ID
+	ToUid int64 `protobuf:"varint,1,opt,name=to_uid,proto3" json:"to_uid,omitempty"`
+	// 消息类型：text/image/file/voice/location/card，省略时为 text
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// 消息内容，文本以外的类型为 JSON
+	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatRequest) Reset() {
+	*x = SendChatRequest{}
+	mi := &file_api_chat_v1_chat_ws_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatRequest) ProtoMessage() {}
+
+func (x *SendChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_ws_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatRequest.ProtoReflect.Descriptor instead.
+func (*SendChatRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_ws_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendChatRequest) GetToUid() int64 {
+	if x != nil {
+		return x.ToUid
+	}
+	return 0
+}
+
+func (x *SendChatRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SendChatRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// group_chat 动作：发送群聊消息
+type SendGroupChatRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	GroupId int64 `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	// 消息类型，同 SendChatRequest
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// 消息内容
+	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendGroupChatRequest) Reset() {
+	*x = SendGroupChatRequest{}
+	mi := &file_api_chat_v1_chat_ws_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendGroupChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendGroupChatRequest) ProtoMessage() {}
+
+func (x *SendGroupChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_ws_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendGroupChatRequest.ProtoReflect.Descriptor instead.
+func (*SendGroupChatRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_ws_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendGroupChatRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SendGroupChatRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SendGroupChatRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// chat、group_chat 动作的响应
+type SendChatReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 持久化后的消息 ID
+	MsgId int64 `protobuf:"varint,1,opt,name=msg_id,proto3" json:"msg_id,omitempty"`
+	// 会话 ID
+	ConversationId string `protobuf:"bytes,2,opt,name=conversation_id,proto3" json:"conversation_id,omitempty"`
+	// 发送时间戳（秒）
+	CreatedAt     int64 `protobuf:"varint,3,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatReply) Reset() {
+	*x = SendChatReply{}
+	mi := &file_api_chat_v1_chat_ws_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatReply) ProtoMessage() {}
+
+func (x *SendChatReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_chat_ws_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatReply.ProtoReflect.Descriptor instead.
+func (*SendChatReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_chat_ws_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendChatReply) GetMsgId() int64 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *SendChatReply) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SendChatReply) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+var File_api_chat_v1_chat_ws_proto protoreflect.FileDescriptor
+
+const file_api_chat_v1_chat_ws_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/chat/v1/chat_ws.proto\x12\vapi.chat.v1\x1a\x17validate/validate.proto\"m\n" +
+	"\x0fSendChatRequest\x12\x1f\n" +
+	"\x06to_uid\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06to_uid\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
+	"\acontent\x18\x03 \x01(\tB\v\xfaB\br\x06\x10\x01\x18\xa0\x9c\x01R\acontent\"v\n" +
+	"\x14SendGroupChatRequest\x12#\n" +
+	"\bgroup_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bgroup_id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
+	"\acontent\x18\x03 \x01(\tB\v\xfaB\br\x06\x10\x01\x18\xa0\x9c\x01R\acontent\"q\n" +
+	"\rSendChatReply\x12\x16\n" +
+	"\x06msg_id\x18\x01 \x01(\x03R\x06msg_id\x12(\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0fconversation_id\x12\x1e\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\x03R\n" +
+	"created_atBM\n" +
+	"\vapi.chat.v1P\x01Z<github.com/sober-studio/bubble-boot-go-kratos/api/chat/v1;v1b\x06proto3"
+
+var (
+	file_api_chat_v1_chat_ws_proto_rawDescOnce sync.Once
+	file_api_chat_v1_chat_ws_proto_rawDescData []byte
+)
+
+func file_api_chat_v1_chat_ws_proto_rawDescGZIP() []byte {
+	file_api_chat_v1_chat_ws_proto_rawDescOnce.Do(func() {
+		file_api_chat_v1_chat_ws_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_chat_v1_chat_ws_proto_rawDesc), len(file_api_chat_v1_chat_ws_proto_rawDesc)))
+	})
+	return file_api_chat_v1_chat_ws_proto_rawDescData
+}
+
+var file_api_chat_v1_chat_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_chat_v1_chat_ws_proto_goTypes = []any{
+	(*SendChatRequest)(nil),      // 0: api.chat.v1.SendChatRequest
+	(*SendGroupChatRequest)(nil), // 1: api.chat.v1.SendGroupChatRequest
+	(*SendChatReply)(nil),        // 2: api.chat.v1.SendChatReply
+}
+var file_api_chat_v1_chat_ws_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_chat_v1_chat_ws_proto_init() }
+func file_api_chat_v1_chat_ws_proto_init() {
+	if File_api_chat_v1_chat_ws_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_chat_v1_chat_ws_proto_rawDesc), len(file_api_chat_v1_chat_ws_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_chat_v1_chat_ws_proto_goTypes,
+		DependencyIndexes: file_api_chat_v1_chat_ws_proto_depIdxs,
+		MessageInfos:      file_api_chat_v1_chat_ws_proto_msgTypes,
+	}.Build()
+	File_api_chat_v1_chat_ws_proto = out.File
+	file_api_chat_v1_chat_ws_proto_goTypes = nil
+	file_api_chat_v1_chat_ws_proto_depIdxs = nil
+}
@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/chat/v1/chat_ws.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SendChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SendChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendChatRequestMultiError, or nil if none found.
+func (m *SendChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetToUid() <= 0 {
+		err := SendChatRequestValidationError{
+			field:  "ToUid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Type
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 20000 {
+		err := SendChatRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 20000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SendChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendChatRequestMultiError is an error wrapping multiple validation errors
+// returned by SendChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SendChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendChatRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendChatRequestMultiError) AllErrors() []error { return m }
+
+// SendChatRequestValidationError is the validation error returned by
+// SendChatRequest.Validate if the designated constraints aren't met.
+type SendChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendChatRequestValidationError) ErrorName() string { return "SendChatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendChatRequestValidationError{}
+
+// Validate checks the field values on SendGroupChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendGroupChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendGroupChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendGroupChatRequestMultiError, or nil if none found.
+func (m *SendGroupChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendGroupChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := SendGroupChatRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Type
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 1 || l > 20000 {
+		err := SendGroupChatRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 1 and 20000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SendGroupChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendGroupChatRequestMultiError is an error wrapping multiple validation
+// errors returned by SendGroupChatRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SendGroupChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendGroupChatRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendGroupChatRequestMultiError) AllErrors() []error { return m }
+
+// SendGroupChatRequestValidationError is the validation error returned by
+// SendGroupChatRequest.Validate if the designated constraints aren't met.
+type SendGroupChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendGroupChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendGroupChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendGroupChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendGroupChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendGroupChatRequestValidationError) ErrorName() string {
+	return "SendGroupChatRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendGroupChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendGroupChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendGroupChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendGroupChatRequestValidationError{}
+
+// Validate checks the field values on SendChatReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendChatReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendChatReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SendChatReplyMultiError, or
+// nil if none found.
+func (m *SendChatReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendChatReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MsgId
+
+	// no validation rules for ConversationId
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return SendChatReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendChatReplyMultiError is an error wrapping multiple validation errors
+// returned by SendChatReply.ValidateAll() if the designated constraints
+// aren't met.
+type SendChatReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendChatReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendChatReplyMultiError) AllErrors() []error { return m }
+
+// SendChatReplyValidationError is the validation error returned by
+// SendChatReply.Validate if the designated constraints aren't met.
+type SendChatReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendChatReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendChatReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendChatReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendChatReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendChatReplyValidationError) ErrorName() string { return "SendChatReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendChatReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendChatReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendChatReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendChatReplyValidationError{}
@@ -0,0 +1,1087 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: api/chat/v1/group.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/google/gnostic/openapiv3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GroupInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 群名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 群主
+	OwnerId int64 `protobuf:"varint,3,opt,name=owner_id,proto3" json:"owner_id,omitempty"`
+	// 成员数
+	MemberCount int32 `protobuf:"varint,4,opt,name=member_count,proto3" json:"member_count,omitempty"`
+	// 成员上限
+	MaxMembers int32 `protobuf:"varint,5,opt,name=max_members,proto3" json:"max_members,omitempty"`
+	// 会话 ID
+	ConversationId string `protobuf:"bytes,6,opt,name=conversation_id,proto3" json:"conversation_id,omitempty"`
+	// 创建时间
+	CreatedAt     int64 `protobuf:"varint,7,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupInfo) Reset() {
+	*x = GroupInfo{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInfo) ProtoMessage() {}
+
+func (x *GroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInfo.ProtoReflect.Descriptor instead.
+func (*GroupInfo) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GroupInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupInfo) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *GroupInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GroupMemberInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户 ID
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// 角色
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// 禁言截止时间
+	MutedUntil int64 `protobuf:"varint,3,opt,name=muted_until,proto3" json:"muted_until,omitempty"`
+	// 入群时间
+	JoinedAt      int64 `protobuf:"varint,4,opt,name=joined_at,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberInfo) Reset() {
+	*x = GroupMemberInfo{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberInfo) ProtoMessage() {}
+
+func (x *GroupMemberInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberInfo.ProtoReflect.Descriptor instead.
+func (*GroupMemberInfo) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GroupMemberInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GroupMemberInfo) GetMutedUntil() int64 {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return 0
+}
+
+func (x *GroupMemberInfo) GetJoinedAt() int64 {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return 0
+}
+
+type CreateGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群名称
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 初始成员
+	MemberIds     []int64 `protobuf:"varint,2,rep,packed,name=member_ids,proto3" json:"member_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetMemberIds() []int64 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type GetGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	GroupId       int64 `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type DisbandGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	GroupId       int64 `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisbandGroupRequest) Reset() {
+	*x = DisbandGroupRequest{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisbandGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisbandGroupRequest) ProtoMessage() {}
+
+func (x *DisbandGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisbandGroupRequest.ProtoReflect.Descriptor instead.
+func (*DisbandGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DisbandGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type DisbandGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisbandGroupReply) Reset() {
+	*x = DisbandGroupReply{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisbandGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisbandGroupReply) ProtoMessage() {}
+
+func (x *DisbandGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisbandGroupReply.ProtoReflect.Descriptor instead.
+func (*DisbandGroupReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{5}
+}
+
+type ListGroupMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	GroupId       int64 `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersRequest) Reset() {
+	*x = ListGroupMembersRequest{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListGroupMembersRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type ListGroupMembersReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成员列表
+	List          []*GroupMemberInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersReply) Reset() {
+	*x = ListGroupMembersReply{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersReply) ProtoMessage() {}
+
+func (x *ListGroupMembersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersReply.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListGroupMembersReply) GetList() []*GroupMemberInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type InviteGroupMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	GroupId int64 `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	// 被邀请的用户
+	UserIds       []int64 `protobuf:"varint,2,rep,packed,name=user_ids,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteGroupMembersRequest) Reset() {
+	*x = InviteGroupMembersRequest{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteGroupMembersRequest) ProtoMessage() {}
+
+func (x *InviteGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*InviteGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InviteGroupMembersRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *InviteGroupMembersRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type InviteGroupMembersReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 实际加入的用户
+	AddedUserIds  []int64 `protobuf:"varint,1,rep,packed,name=added_user_ids,proto3" json:"added_user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteGroupMembersReply) Reset() {
+	*x = InviteGroupMembersReply{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteGroupMembersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteGroupMembersReply) ProtoMessage() {}
+
+func (x *InviteGroupMembersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteGroupMembersReply.ProtoReflect.Descriptor instead.
+func (*InviteGroupMembersReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InviteGroupMembersReply) GetAddedUserIds() []int64 {
+	if x != nil {
+		return x.AddedUserIds
+	}
+	return nil
+}
+
+type KickGroupMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	GroupId int64 `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	// 用户 ID
+	UserId        int64 `protobuf:"varint,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickGroupMemberRequest) Reset() {
+	*x = KickGroupMemberRequest{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickGroupMemberRequest) ProtoMessage() {}
+
+func (x *KickGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*KickGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *KickGroupMemberRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *KickGroupMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type KickGroupMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickGroupMemberReply) Reset() {
+	*x = KickGroupMemberReply{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickGroupMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickGroupMemberReply) ProtoMessage() {}
+
+func (x *KickGroupMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickGroupMemberReply.ProtoReflect.Descriptor instead.
+func (*KickGroupMemberReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{11}
+}
+
+type LeaveGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	GroupId       int64 `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGroupRequest) Reset() {
+	*x = LeaveGroupRequest{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupRequest) ProtoMessage() {}
+
+func (x *LeaveGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupRequest.ProtoReflect.Descriptor instead.
+func (*LeaveGroupRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LeaveGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type LeaveGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveGroupReply) Reset() {
+	*x = LeaveGroupReply{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveGroupReply) ProtoMessage() {}
+
+func (x *LeaveGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveGroupReply.ProtoReflect.Descriptor instead.
+func (*LeaveGroupReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{13}
+}
+
+type SetGroupMemberRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	GroupId int64 `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	// 用户 ID
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// 角色
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupMemberRoleRequest) Reset() {
+	*x = SetGroupMemberRoleRequest{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetGroupMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetGroupMemberRoleRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SetGroupMemberRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetGroupMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type SetGroupMemberRoleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupMemberRoleReply) Reset() {
+	*x = SetGroupMemberRoleReply{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupMemberRoleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupMemberRoleReply) ProtoMessage() {}
+
+func (x *SetGroupMemberRoleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupMemberRoleReply.ProtoReflect.Descriptor instead.
+func (*SetGroupMemberRoleReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{15}
+}
+
+type MuteGroupMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 群组 ID
+	GroupId int64 `protobuf:"varint,1,opt,name=group_id,proto3" json:"group_id,omitempty"`
+	// 用户 ID
+	UserId int64 `protobuf:"varint,2,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// 禁言时长
+	Duration      int64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteGroupMemberRequest) Reset() {
+	*x = MuteGroupMemberRequest{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteGroupMemberRequest) ProtoMessage() {}
+
+func (x *MuteGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*MuteGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MuteGroupMemberRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *MuteGroupMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MuteGroupMemberRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type MuteGroupMemberReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteGroupMemberReply) Reset() {
+	*x = MuteGroupMemberReply{}
+	mi := &file_api_chat_v1_group_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteGroupMemberReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteGroupMemberReply) ProtoMessage() {}
+
+func (x *MuteGroupMemberReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_chat_v1_group_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteGroupMemberReply.ProtoReflect.Descriptor instead.
+func (*MuteGroupMemberReply) Descriptor() ([]byte, []int) {
+	return file_api_chat_v1_group_proto_rawDescGZIP(), []int{17}
+}
+
+var File_api_chat_v1_group_proto protoreflect.FileDescriptor
+
+const file_api_chat_v1_group_proto_rawDesc = "" +
+	"\n" +
+	"\x17api/chat/v1/group.proto\x12\vapi.chat.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1copenapi/v3/annotations.proto\"\x82\x03\n" +
+	"\tGroupInfo\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\x03B\x0f\xbaG\f\x92\x02\t群组 IDR\x02id\x12#\n" +
+	"\x04name\x18\x02 \x01(\tB\x0f\xbaG\f\x92\x02\t群名称R\x04name\x121\n" +
+	"\bowner_id\x18\x03 \x01(\x03B\x15\xbaG\x12\x92\x02\x0f群主用户 IDR\bowner_id\x123\n" +
+	"\fmember_count\x18\x04 \x01(\x05B\x0f\xbaG\f\x92\x02\t成员数R\fmember_count\x124\n" +
+	"\vmax_members\x18\x05 \x01(\x05B\x12\xbaG\x0f\x92\x02\f成员上限R\vmax_members\x12N\n" +
+	"\x0fconversation_id\x18\x06 \x01(\tB$\xbaG!\x92\x02\x1e群聊会话 ID，g_{群组ID}R\x0fconversation_id\x12A\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03B!\xbaG\x1e\x92\x02\x1b创建时间戳，单位秒R\n" +
+	"created_at\"\x96\x02\n" +
+	"\x0fGroupMemberInfo\x12/\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x15\xbaG\x12\x92\x02\x0f成员用户 IDR\auser_id\x125\n" +
+	"\x04role\x18\x02 \x01(\tB!\xbaG\x1e\x92\x02\x1b角色：owner/admin/memberR\x04role\x12Z\n" +
+	"\vmuted_until\x18\x03 \x01(\x03B8\xbaG5\x92\x022禁言截止时间戳，单位秒，未禁言为 0R\vmuted_until\x12?\n" +
+	"\tjoined_at\x18\x04 \x01(\x03B!\xbaG\x1e\x92\x02\x1b入群时间戳，单位秒R\tjoined_at\"\x9f\x01\n" +
+	"\x12CreateGroupRequest\x12=\n" +
+	"\x04name\x18\x01 \x01(\tB)\xfaB\x06r\x04\x10\x01\x18@\xbaG\x1d\x92\x02\x1a群名称，1-64 个字符R\x04name\x12J\n" +
+	"\n" +
+	"member_ids\x18\x02 \x03(\x03B*\xbaG'\x92\x02$初始成员用户 ID，不含群主R\n" +
+	"member_ids\"E\n" +
+	"\x0fGetGroupRequest\x122\n" +
+	"\bgroup_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t群组 IDR\bgroup_id\"I\n" +
+	"\x13DisbandGroupRequest\x122\n" +
+	"\bgroup_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t群组 IDR\bgroup_id\"\x13\n" +
+	"\x11DisbandGroupReply\"M\n" +
+	"\x17ListGroupMembersRequest\x122\n" +
+	"\bgroup_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t群组 IDR\bgroup_id\"]\n" +
+	"\x15ListGroupMembersReply\x12D\n" +
+	"\x04list\x18\x01 \x03(\v2\x1c.api.chat.v1.GroupMemberInfoB\x12\xbaG\x0f\x92\x02\f成员列表R\x04list\"\x92\x01\n" +
+	"\x19InviteGroupMembersRequest\x122\n" +
+	"\bgroup_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t群组 IDR\bgroup_id\x12A\n" +
+	"\buser_ids\x18\x02 \x03(\x03B%\xfaB\a\x92\x01\x04\b\x01\x10d\xbaG\x18\x92\x02\x15被邀请的用户 IDR\buser_ids\"\x85\x01\n" +
+	"\x17InviteGroupMembersReply\x12j\n" +
+	"\x0eadded_user_ids\x18\x01 \x03(\x03BB\xbaG?\x92\x02<实际加入的用户 ID，不含原本已在群中的用户R\x0eadded_user_ids\"\x8a\x01\n" +
+	"\x16KickGroupMemberRequest\x122\n" +
+	"\bgroup_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t群组 IDR\bgroup_id\x12<\n" +
+	"\auser_id\x18\x02 \x01(\x03B\"\xfaB\x04\"\x02 \x00\xbaG\x18\x92\x02\x15被移出的用户 IDR\auser_id\"\x16\n" +
+	"\x14KickGroupMemberReply\"G\n" +
+	"\x11LeaveGroupRequest\x122\n" +
+	"\bgroup_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t群组 IDR\bgroup_id\"\x11\n" +
+	"\x0fLeaveGroupReply\"\xcc\x01\n" +
+	"\x19SetGroupMemberRoleRequest\x122\n" +
+	"\bgroup_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t群组 IDR\bgroup_id\x126\n" +
+	"\auser_id\x18\x02 \x01(\x03B\x1c\xfaB\x04\"\x02 \x00\xbaG\x12\x92\x02\x0f成员用户 IDR\auser_id\x12C\n" +
+	"\x04role\x18\x03 \x01(\tB/\xfaB\x11r\x0fR\x05adminR\x06member\xbaG\x18\x92\x02\x15角色：admin/memberR\x04role\"\x19\n" +
+	"\x17SetGroupMemberRoleReply\"\xf3\x01\n" +
+	"\x16MuteGroupMemberRequest\x122\n" +
+	"\bgroup_id\x18\x01 \x01(\x03B\x16\xfaB\x04\"\x02 \x00\xbaG\f\x92\x02\t群组 IDR\bgroup_id\x126\n" +
+	"\auser_id\x18\x02 \x01(\x03B\x1c\xfaB\x04\"\x02 \x00\xbaG\x12\x92\x02\x0f成员用户 IDR\auser_id\x12m\n" +
+	"\bduration\x18\x03 \x01(\x03BQ\xfaB\t\"\a\x18\x80\x9a\x9e\x01(\x00\xbaGB\x92\x02?禁言时长，单位秒，0 表示解除禁言，最长 30 天R\bduration\"\x16\n" +
+	"\x14MuteGroupMemberReply2\xba\x0e\n" +
+	"\x05Group\x12\xab\x01\n" +
+	"\vCreateGroup\x12\x1f.api.chat.v1.CreateGroupRequest\x1a\x16.api.chat.v1.GroupInfo\"c\xbaGI\x12\f创建群组\x1a9当前用户成为群主，被邀请的用户直接入群\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/chat/groups\x12\x89\x01\n" +
+	"\bGetGroup\x12\x1c.api.chat.v1.GetGroupRequest\x1a\x16.api.chat.v1.GroupInfo\"G\xbaG%\x12\f群组信息\x1a\x15仅群成员可查看\x82\xd3\xe4\x93\x02\x19\x12\x17/chat/groups/{group_id}\x12\xc3\x01\n" +
+	"\fDisbandGroup\x12 .api.chat.v1.DisbandGroupRequest\x1a\x1e.api.chat.v1.DisbandGroupReply\"q\xbaGO\x12\f解散群组\x1a?仅群主可解散，解散后成员的群聊会话一并删除\x82\xd3\xe4\x93\x02\x19*\x17/chat/groups/{group_id}\x12\xc5\x01\n" +
+	"\x10ListGroupMembers\x12$.api.chat.v1.ListGroupMembersRequest\x1a\".api.chat.v1.ListGroupMembersReply\"g\xbaG=\x12\f成员列表\x1a-按入群时间排序，仅群成员可查看\x82\xd3\xe4\x93\x02!\x12\x1f/chat/groups/{group_id}/members\x12\xf9\x01\n" +
+	"\x12InviteGroupMembers\x12&.api.chat.v1.InviteGroupMembersRequest\x1a$.api.chat.v1.InviteGroupMembersReply\"\x94\x01\xbaGg\x12\f邀请入群\x1aW群成员均可邀请，已在群中的用户忽略，超出成员上限时整体失败\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/chat/groups/{group_id}/members\x12\xdc\x01\n" +
+	"\x0fKickGroupMember\x12#.api.chat.v1.KickGroupMemberRequest\x1a!.api.chat.v1.KickGroupMemberReply\"\x80\x01\xbaGL\x12\f移出成员\x1a<群主可移出管理员和成员，管理员可移出成员\x82\xd3\xe4\x93\x02+*)/chat/groups/{group_id}/members/{user_id}\x12\xa8\x01\n" +
+	"\n" +
+	"LeaveGroup\x12\x1e.api.chat.v1.LeaveGroupRequest\x1a\x1c.api.chat.v1.LeaveGroupReply\"\\\xbaG1\x12\f退出群组\x1a!群主不能退出，只能解散\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/chat/groups/{group_id}/leave\x12\xda\x01\n" +
+	"\x12SetGroupMemberRole\x12&.api.chat.v1.SetGroupMemberRoleRequest\x1a$.api.chat.v1.SetGroupMemberRoleReply\"v\xbaG:\x12\x12设置成员角色\x1a$仅群主可设置或取消管理员\x82\xd3\xe4\x93\x023:\x01*\x1a./chat/groups/{group_id}/members/{user_id}/role\x12\x85\x02\n" +
+	"\x0fMuteGroupMember\x12#.api.chat.v1.MuteGroupMemberRequest\x1a!.api.chat.v1.MuteGroupMemberReply\"\xa9\x01\xbaGm\x12\f禁言成员\x1a]群主可禁言管理员和成员，管理员可禁言成员，duration 为 0 时解除禁言\x82\xd3\xe4\x93\x023:\x01*\x1a./chat/groups/{group_id}/members/{user_id}/muteBM\n" +
+	"\vapi.chat.v1P\x01Z<github.com/sober-studio/bubble-boot-go-kratos/api/chat/v1;v1b\x06proto3"
+
+var (
+	file_api_chat_v1_group_proto_rawDescOnce sync.Once
+	file_api_chat_v1_group_proto_rawDescData []byte
+)
+
+func file_api_chat_v1_group_proto_rawDescGZIP() []byte {
+	file_api_chat_v1_group_proto_rawDescOnce.Do(func() {
+		file_api_chat_v1_group_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_chat_v1_group_proto_rawDesc), len(file_api_chat_v1_group_proto_rawDesc)))
+	})
+	return file_api_chat_v1_group_proto_rawDescData
+}
+
+var file_api_chat_v1_group_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_api_chat_v1_group_proto_goTypes = []any{
+	(*GroupInfo)(nil),                 // 0: api.chat.v1.GroupInfo
+	(*GroupMemberInfo)(nil),           // 1: api.chat.v1.GroupMemberInfo
+	(*CreateGroupRequest)(nil),        // 2: api.chat.v1.CreateGroupRequest
+	(*GetGroupRequest)(nil),           // 3: api.chat.v1.GetGroupRequest
+	(*DisbandGroupRequest)(nil),       // 4: api.chat.v1.DisbandGroupRequest
+	(*DisbandGroupReply)(nil),         // 5: api.chat.v1.DisbandGroupReply
+	(*ListGroupMembersRequest)(nil),   // 6: api.chat.v1.ListGroupMembersRequest
+	(*ListGroupMembersReply)(nil),     // 7: api.chat.v1.ListGroupMembersReply
+	(*InviteGroupMembersRequest)(nil), // 8: api.chat.v1.InviteGroupMembersRequest
+	(*InviteGroupMembersReply)(nil),   // 9: api.chat.v1.InviteGroupMembersReply
+	(*KickGroupMemberRequest)(nil),    // 10: api.chat.v1.KickGroupMemberRequest
+	(*KickGroupMemberReply)(nil),      // 11: api.chat.v1.KickGroupMemberReply
+	(*LeaveGroupRequest)(nil),         // 12: api.chat.v1.LeaveGroupRequest
+	(*LeaveGroupReply)(nil),           // 13: api.chat.v1.LeaveGroupReply
+	(*SetGroupMemberRoleRequest)(nil), // 14: api.chat.v1.SetGroupMemberRoleRequest
+	(*SetGroupMemberRoleReply)(nil),   // 15: api.chat.v1.SetGroupMemberRoleReply
+	(*MuteGroupMemberRequest)(nil),    // 16: api.chat.v1.MuteGroupMemberRequest
+	(*MuteGroupMemberReply)(nil),      // 17: api.chat.v1.MuteGroupMemberReply
+}
+var file_api_chat_v1_group_proto_depIdxs = []int32{
+	1,  // 0: api.chat.v1.ListGroupMembersReply.list:type_name -> api.chat.v1.GroupMemberInfo
+	2,  // 1: api.chat.v1.Group.CreateGroup:input_type -> api.chat.v1.CreateGroupRequest
+	3,  // 2: api.chat.v1.Group.GetGroup:input_type -> api.chat.v1.GetGroupRequest
+	4,  // 3: api.chat.v1.Group.DisbandGroup:input_type -> api.chat.v1.DisbandGroupRequest
+	6,  // 4: api.chat.v1.Group.ListGroupMembers:input_type -> api.chat.v1.ListGroupMembersRequest
+	8,  // 5: api.chat.v1.Group.InviteGroupMembers:input_type -> api.chat.v1.InviteGroupMembersRequest
+	10, // 6: api.chat.v1.Group.KickGroupMember:input_type -> api.chat.v1.KickGroupMemberRequest
+	12, // 7: api.chat.v1.Group.LeaveGroup:input_type -> api.chat.v1.LeaveGroupRequest
+	14, // 8: api.chat.v1.Group.SetGroupMemberRole:input_type -> api.chat.v1.SetGroupMemberRoleRequest
+	16, // 9: api.chat.v1.Group.MuteGroupMember:input_type -> api.chat.v1.MuteGroupMemberRequest
+	0,  // 10: api.chat.v1.Group.CreateGroup:output_type -> api.chat.v1.GroupInfo
+	0,  // 11: api.chat.v1.Group.GetGroup:output_type -> api.chat.v1.GroupInfo
+	5,  // 12: api.chat.v1.Group.DisbandGroup:output_type -> api.chat.v1.DisbandGroupReply
+	7,  // 13: api.chat.v1.Group.ListGroupMembers:output_type -> api.chat.v1.ListGroupMembersReply
+	9,  // 14: api.chat.v1.Group.InviteGroupMembers:output_type -> api.chat.v1.InviteGroupMembersReply
+	11, // 15: api.chat.v1.Group.KickGroupMember:output_type -> api.chat.v1.KickGroupMemberReply
+	13, // 16: api.chat.v1.Group.LeaveGroup:output_type -> api.chat.v1.LeaveGroupReply
+	15, // 17: api.chat.v1.Group.SetGroupMemberRole:output_type -> api.chat.v1.SetGroupMemberRoleReply
+	17, // 18: api.chat.v1.Group.MuteGroupMember:output_type -> api.chat.v1.MuteGroupMemberReply
+	10, // [10:19] is the sub-list for method output_type
+	1,  // [1:10] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_chat_v1_group_proto_init() }
+func file_api_chat_v1_group_proto_init() {
+	if File_api_chat_v1_group_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_chat_v1_group_proto_rawDesc), len(file_api_chat_v1_group_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_chat_v1_group_proto_goTypes,
+		DependencyIndexes: file_api_chat_v1_group_proto_depIdxs,
+		MessageInfos:      file_api_chat_v1_group_proto_msgTypes,
+	}.Build()
+	File_api_chat_v1_group_proto = out.File
+	file_api_chat_v1_group_proto_goTypes = nil
+	file_api_chat_v1_group_proto_depIdxs = nil
+}
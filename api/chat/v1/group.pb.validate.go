@@ -0,0 +1,2089 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/chat/v1/group.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GroupInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GroupInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GroupInfoMultiError, or nil
+// if none found.
+func (m *GroupInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerId
+
+	// no validation rules for MemberCount
+
+	// no validation rules for MaxMembers
+
+	// no validation rules for ConversationId
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return GroupInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupInfoMultiError is an error wrapping multiple validation errors returned
+// by GroupInfo.ValidateAll() if the designated constraints aren't met.
+type GroupInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupInfoMultiError) AllErrors() []error { return m }
+
+// GroupInfoValidationError is the validation error returned by
+// GroupInfo.Validate if the designated constraints aren't met.
+type GroupInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupInfoValidationError) ErrorName() string { return "GroupInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupInfoValidationError{}
+
+// Validate checks the field values on GroupMemberInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GroupMemberInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupMemberInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GroupMemberInfoMultiError, or nil if none found.
+func (m *GroupMemberInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupMemberInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	// no validation rules for MutedUntil
+
+	// no validation rules for JoinedAt
+
+	if len(errors) > 0 {
+		return GroupMemberInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupMemberInfoMultiError is an error wrapping multiple validation errors
+// returned by GroupMemberInfo.ValidateAll() if the designated constraints
+// aren't met.
+type GroupMemberInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupMemberInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupMemberInfoMultiError) AllErrors() []error { return m }
+
+// GroupMemberInfoValidationError is the validation error returned by
+// GroupMemberInfo.Validate if the designated constraints aren't met.
+type GroupMemberInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupMemberInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupMemberInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupMemberInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupMemberInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupMemberInfoValidationError) ErrorName() string { return "GroupMemberInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupMemberInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupMemberInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupMemberInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupMemberInfoValidationError{}
+
+// Validate checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGroupRequestMultiError, or nil if none found.
+func (m *CreateGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		err := CreateGroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateGroupRequestValidationError is the validation error returned by
+// CreateGroupRequest.Validate if the designated constraints aren't met.
+type CreateGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGroupRequestValidationError) ErrorName() string {
+	return "CreateGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGroupRequestValidationError{}
+
+// Validate checks the field values on GetGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetGroupRequestMultiError, or nil if none found.
+func (m *GetGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := GetGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by GetGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetGroupRequestMultiError) AllErrors() []error { return m }
+
+// GetGroupRequestValidationError is the validation error returned by
+// GetGroupRequest.Validate if the designated constraints aren't met.
+type GetGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGroupRequestValidationError) ErrorName() string { return "GetGroupRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGroupRequestValidationError{}
+
+// Validate checks the field values on DisbandGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisbandGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisbandGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisbandGroupRequestMultiError, or nil if none found.
+func (m *DisbandGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisbandGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := DisbandGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DisbandGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisbandGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by DisbandGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisbandGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisbandGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisbandGroupRequestMultiError) AllErrors() []error { return m }
+
+// DisbandGroupRequestValidationError is the validation error returned by
+// DisbandGroupRequest.Validate if the designated constraints aren't met.
+type DisbandGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisbandGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisbandGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisbandGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisbandGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisbandGroupRequestValidationError) ErrorName() string {
+	return "DisbandGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisbandGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisbandGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisbandGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisbandGroupRequestValidationError{}
+
+// Validate checks the field values on DisbandGroupReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DisbandGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisbandGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisbandGroupReplyMultiError, or nil if none found.
+func (m *DisbandGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisbandGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisbandGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisbandGroupReplyMultiError is an error wrapping multiple validation errors
+// returned by DisbandGroupReply.ValidateAll() if the designated constraints
+// aren't met.
+type DisbandGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisbandGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisbandGroupReplyMultiError) AllErrors() []error { return m }
+
+// DisbandGroupReplyValidationError is the validation error returned by
+// DisbandGroupReply.Validate if the designated constraints aren't met.
+type DisbandGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisbandGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisbandGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisbandGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisbandGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisbandGroupReplyValidationError) ErrorName() string {
+	return "DisbandGroupReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisbandGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisbandGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisbandGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisbandGroupReplyValidationError{}
+
+// Validate checks the field values on ListGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGroupMembersRequestMultiError, or nil if none found.
+func (m *ListGroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := ListGroupMembersRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListGroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGroupMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListGroupMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListGroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGroupMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListGroupMembersRequestValidationError is the validation error returned by
+// ListGroupMembersRequest.Validate if the designated constraints aren't met.
+type ListGroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGroupMembersRequestValidationError) ErrorName() string {
+	return "ListGroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGroupMembersRequestValidationError{}
+
+// Validate checks the field values on ListGroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListGroupMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListGroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListGroupMembersReplyMultiError, or nil if none found.
+func (m *ListGroupMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListGroupMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListGroupMembersReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListGroupMembersReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListGroupMembersReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListGroupMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListGroupMembersReplyMultiError is an error wrapping multiple validation
+// errors returned by ListGroupMembersReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListGroupMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListGroupMembersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListGroupMembersReplyMultiError) AllErrors() []error { return m }
+
+// ListGroupMembersReplyValidationError is the validation error returned by
+// ListGroupMembersReply.Validate if the designated constraints aren't met.
+type ListGroupMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGroupMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGroupMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGroupMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGroupMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGroupMembersReplyValidationError) ErrorName() string {
+	return "ListGroupMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGroupMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGroupMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGroupMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGroupMembersReplyValidationError{}
+
+// Validate checks the field values on InviteGroupMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteGroupMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteGroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteGroupMembersRequestMultiError, or nil if none found.
+func (m *InviteGroupMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteGroupMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := InviteGroupMembersRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetUserIds()); l < 1 || l > 100 {
+		err := InviteGroupMembersRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InviteGroupMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteGroupMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by InviteGroupMembersRequest.ValidateAll() if the
+// designated constraints aren't met.
+type InviteGroupMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteGroupMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteGroupMembersRequestMultiError) AllErrors() []error { return m }
+
+// InviteGroupMembersRequestValidationError is the validation error returned by
+// InviteGroupMembersRequest.Validate if the designated constraints aren't met.
+type InviteGroupMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteGroupMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteGroupMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteGroupMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteGroupMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteGroupMembersRequestValidationError) ErrorName() string {
+	return "InviteGroupMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteGroupMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteGroupMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteGroupMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteGroupMembersRequestValidationError{}
+
+// Validate checks the field values on InviteGroupMembersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteGroupMembersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteGroupMembersReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteGroupMembersReplyMultiError, or nil if none found.
+func (m *InviteGroupMembersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteGroupMembersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InviteGroupMembersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteGroupMembersReplyMultiError is an error wrapping multiple validation
+// errors returned by InviteGroupMembersReply.ValidateAll() if the designated
+// constraints aren't met.
+type InviteGroupMembersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteGroupMembersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteGroupMembersReplyMultiError) AllErrors() []error { return m }
+
+// InviteGroupMembersReplyValidationError is the validation error returned by
+// InviteGroupMembersReply.Validate if the designated constraints aren't met.
+type InviteGroupMembersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteGroupMembersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteGroupMembersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteGroupMembersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteGroupMembersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteGroupMembersReplyValidationError) ErrorName() string {
+	return "InviteGroupMembersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteGroupMembersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteGroupMembersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteGroupMembersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteGroupMembersReplyValidationError{}
+
+// Validate checks the field values on KickGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KickGroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KickGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KickGroupMemberRequestMultiError, or nil if none found.
+func (m *KickGroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KickGroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := KickGroupMemberRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := KickGroupMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KickGroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// KickGroupMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by KickGroupMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type KickGroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KickGroupMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KickGroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// KickGroupMemberRequestValidationError is the validation error returned by
+// KickGroupMemberRequest.Validate if the designated constraints aren't met.
+type KickGroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KickGroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KickGroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KickGroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KickGroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KickGroupMemberRequestValidationError) ErrorName() string {
+	return "KickGroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KickGroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKickGroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KickGroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KickGroupMemberRequestValidationError{}
+
+// Validate checks the field values on KickGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KickGroupMemberReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KickGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KickGroupMemberReplyMultiError, or nil if none found.
+func (m *KickGroupMemberReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KickGroupMemberReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return KickGroupMemberReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// KickGroupMemberReplyMultiError is an error wrapping multiple validation
+// errors returned by KickGroupMemberReply.ValidateAll() if the designated
+// constraints aren't met.
+type KickGroupMemberReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KickGroupMemberReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KickGroupMemberReplyMultiError) AllErrors() []error { return m }
+
+// KickGroupMemberReplyValidationError is the validation error returned by
+// KickGroupMemberReply.Validate if the designated constraints aren't met.
+type KickGroupMemberReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KickGroupMemberReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KickGroupMemberReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KickGroupMemberReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KickGroupMemberReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KickGroupMemberReplyValidationError) ErrorName() string {
+	return "KickGroupMemberReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KickGroupMemberReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKickGroupMemberReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KickGroupMemberReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KickGroupMemberReplyValidationError{}
+
+// Validate checks the field values on LeaveGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeaveGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveGroupRequestMultiError, or nil if none found.
+func (m *LeaveGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := LeaveGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LeaveGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by LeaveGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveGroupRequestMultiError) AllErrors() []error { return m }
+
+// LeaveGroupRequestValidationError is the validation error returned by
+// LeaveGroupRequest.Validate if the designated constraints aren't met.
+type LeaveGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveGroupRequestValidationError) ErrorName() string {
+	return "LeaveGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeaveGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveGroupRequestValidationError{}
+
+// Validate checks the field values on LeaveGroupReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LeaveGroupReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeaveGroupReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeaveGroupReplyMultiError, or nil if none found.
+func (m *LeaveGroupReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeaveGroupReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LeaveGroupReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeaveGroupReplyMultiError is an error wrapping multiple validation errors
+// returned by LeaveGroupReply.ValidateAll() if the designated constraints
+// aren't met.
+type LeaveGroupReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeaveGroupReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeaveGroupReplyMultiError) AllErrors() []error { return m }
+
+// LeaveGroupReplyValidationError is the validation error returned by
+// LeaveGroupReply.Validate if the designated constraints aren't met.
+type LeaveGroupReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeaveGroupReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeaveGroupReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeaveGroupReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeaveGroupReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeaveGroupReplyValidationError) ErrorName() string { return "LeaveGroupReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LeaveGroupReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeaveGroupReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeaveGroupReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeaveGroupReplyValidationError{}
+
+// Validate checks the field values on SetGroupMemberRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetGroupMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetGroupMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetGroupMemberRoleRequestMultiError, or nil if none found.
+func (m *SetGroupMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetGroupMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := SetGroupMemberRoleRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := SetGroupMemberRoleRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetGroupMemberRoleRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := SetGroupMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [admin member]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetGroupMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetGroupMemberRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by SetGroupMemberRoleRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetGroupMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetGroupMemberRoleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetGroupMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// SetGroupMemberRoleRequestValidationError is the validation error returned by
+// SetGroupMemberRoleRequest.Validate if the designated constraints aren't met.
+type SetGroupMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGroupMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGroupMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGroupMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGroupMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGroupMemberRoleRequestValidationError) ErrorName() string {
+	return "SetGroupMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGroupMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGroupMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGroupMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGroupMemberRoleRequestValidationError{}
+
+var _SetGroupMemberRoleRequest_Role_InLookup = map[string]struct{}{
+	"admin":  {},
+	"member": {},
+}
+
+// Validate checks the field values on SetGroupMemberRoleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetGroupMemberRoleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetGroupMemberRoleReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetGroupMemberRoleReplyMultiError, or nil if none found.
+func (m *SetGroupMemberRoleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetGroupMemberRoleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SetGroupMemberRoleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetGroupMemberRoleReplyMultiError is an error wrapping multiple validation
+// errors returned by SetGroupMemberRoleReply.ValidateAll() if the designated
+// constraints aren't met.
+type SetGroupMemberRoleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetGroupMemberRoleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetGroupMemberRoleReplyMultiError) AllErrors() []error { return m }
+
+// SetGroupMemberRoleReplyValidationError is the validation error returned by
+// SetGroupMemberRoleReply.Validate if the designated constraints aren't met.
+type SetGroupMemberRoleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGroupMemberRoleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGroupMemberRoleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGroupMemberRoleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGroupMemberRoleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGroupMemberRoleReplyValidationError) ErrorName() string {
+	return "SetGroupMemberRoleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGroupMemberRoleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGroupMemberRoleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGroupMemberRoleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGroupMemberRoleReplyValidationError{}
+
+// Validate checks the field values on MuteGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MuteGroupMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteGroupMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MuteGroupMemberRequestMultiError, or nil if none found.
+func (m *MuteGroupMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteGroupMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := MuteGroupMemberRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := MuteGroupMemberRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDuration(); val < 0 || val > 2592000 {
+		err := MuteGroupMemberRequestValidationError{
+			field:  "Duration",
+			reason: "value must be inside range [0, 2592000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MuteGroupMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteGroupMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by MuteGroupMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MuteGroupMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteGroupMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteGroupMemberRequestMultiError) AllErrors() []error { return m }
+
+// MuteGroupMemberRequestValidationError is the validation error returned by
+// MuteGroupMemberRequest.Validate if the designated constraints aren't met.
+type MuteGroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteGroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteGroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteGroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteGroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteGroupMemberRequestValidationError) ErrorName() string {
+	return "MuteGroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MuteGroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteGroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteGroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteGroupMemberRequestValidationError{}
+
+// Validate checks the field values on MuteGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MuteGroupMemberReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MuteGroupMemberReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MuteGroupMemberReplyMultiError, or nil if none found.
+func (m *MuteGroupMemberReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MuteGroupMemberReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MuteGroupMemberReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MuteGroupMemberReplyMultiError is an error wrapping multiple validation
+// errors returned by MuteGroupMemberReply.ValidateAll() if the designated
+// constraints aren't met.
+type MuteGroupMemberReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MuteGroupMemberReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MuteGroupMemberReplyMultiError) AllErrors() []error { return m }
+
+// MuteGroupMemberReplyValidationError is the validation error returned by
+// MuteGroupMemberReply.Validate if the designated constraints aren't met.
+type MuteGroupMemberReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteGroupMemberReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteGroupMemberReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteGroupMemberReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteGroupMemberReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteGroupMemberReplyValidationError) ErrorName() string {
+	return "MuteGroupMemberReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MuteGroupMemberReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteGroupMemberReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteGroupMemberReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteGroupMemberReplyValidationError{}
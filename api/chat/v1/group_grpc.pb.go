@@ -0,0 +1,443 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: chat/v1/group.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Group_CreateGroup_FullMethodName        = "/api.chat.v1.Group/CreateGroup"
+	Group_GetGroup_FullMethodName           = "/api.chat.v1.Group/GetGroup"
+	Group_DisbandGroup_FullMethodName       = "/api.chat.v1.Group/DisbandGroup"
+	Group_ListGroupMembers_FullMethodName   = "/api.chat.v1.Group/ListGroupMembers"
+	Group_InviteGroupMembers_FullMethodName = "/api.chat.v1.Group/InviteGroupMembers"
+	Group_KickGroupMember_FullMethodName    = "/api.chat.v1.Group/KickGroupMember"
+	Group_LeaveGroup_FullMethodName         = "/api.chat.v1.Group/LeaveGroup"
+	Group_SetGroupMemberRole_FullMethodName = "/api.chat.v1.Group/SetGroupMemberRole"
+	Group_MuteGroupMember_FullMethodName    = "/api.chat.v1.Group/MuteGroupMember"
+)
+
+// GroupClient is the client API for Group service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroupClient interface {
+	// 创建群组
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*GroupInfo, error)
+	// 群组信息
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GroupInfo, error)
+	// 解散群组
+	DisbandGroup(ctx context.Context, in *DisbandGroupRequest, opts ...grpc.CallOption) (*DisbandGroupReply, error)
+	// 成员列表
+	ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersReply, error)
+	// 邀请入群
+	InviteGroupMembers(ctx context.Context, in *InviteGroupMembersRequest, opts ...grpc.CallOption) (*InviteGroupMembersReply, error)
+	// 移出成员
+	KickGroupMember(ctx context.Context, in *KickGroupMemberRequest, opts ...grpc.CallOption) (*KickGroupMemberReply, error)
+	// 退出群组
+	LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupReply, error)
+	// 设置成员角色
+	SetGroupMemberRole(ctx context.Context, in *SetGroupMemberRoleRequest, opts ...grpc.CallOption) (*SetGroupMemberRoleReply, error)
+	// 禁言成员
+	MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...grpc.CallOption) (*MuteGroupMemberReply, error)
+}
+
+type groupClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupClient(cc grpc.ClientConnInterface) GroupClient {
+	return &groupClient{cc}
+}
+
+func (c *groupClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*GroupInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupInfo)
+	err := c.cc.Invoke(ctx, Group_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GroupInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GroupInfo)
+	err := c.cc.Invoke(ctx, Group_GetGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) DisbandGroup(ctx context.Context, in *DisbandGroupRequest, opts ...grpc.CallOption) (*DisbandGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisbandGroupReply)
+	err := c.cc.Invoke(ctx, Group_DisbandGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupMembersReply)
+	err := c.cc.Invoke(ctx, Group_ListGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) InviteGroupMembers(ctx context.Context, in *InviteGroupMembersRequest, opts ...grpc.CallOption) (*InviteGroupMembersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteGroupMembersReply)
+	err := c.cc.Invoke(ctx, Group_InviteGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) KickGroupMember(ctx context.Context, in *KickGroupMemberRequest, opts ...grpc.CallOption) (*KickGroupMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickGroupMemberReply)
+	err := c.cc.Invoke(ctx, Group_KickGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...grpc.CallOption) (*LeaveGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveGroupReply)
+	err := c.cc.Invoke(ctx, Group_LeaveGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) SetGroupMemberRole(ctx context.Context, in *SetGroupMemberRoleRequest, opts ...grpc.CallOption) (*SetGroupMemberRoleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupMemberRoleReply)
+	err := c.cc.Invoke(ctx, Group_SetGroupMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...grpc.CallOption) (*MuteGroupMemberReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteGroupMemberReply)
+	err := c.cc.Invoke(ctx, Group_MuteGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupServer is the server API for Group service.
+// All implementations must embed UnimplementedGroupServer
+// for forward compatibility.
+type GroupServer interface {
+	// 创建群组
+	CreateGroup(context.Context, *CreateGroupRequest) (*GroupInfo, error)
+	// 群组信息
+	GetGroup(context.Context, *GetGroupRequest) (*GroupInfo, error)
+	// 解散群组
+	DisbandGroup(context.Context, *DisbandGroupRequest) (*DisbandGroupReply, error)
+	// 成员列表
+	ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersReply, error)
+	// 邀请入群
+	InviteGroupMembers(context.Context, *InviteGroupMembersRequest) (*InviteGroupMembersReply, error)
+	// 移出成员
+	KickGroupMember(context.Context, *KickGroupMemberRequest) (*KickGroupMemberReply, error)
+	// 退出群组
+	LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupReply, error)
+	// 设置成员角色
+	SetGroupMemberRole(context.Context, *SetGroupMemberRoleRequest) (*SetGroupMemberRoleReply, error)
+	// 禁言成员
+	MuteGroupMember(context.Context, *MuteGroupMemberRequest) (*MuteGroupMemberReply, error)
+	mustEmbedUnimplementedGroupServer()
+}
+
+// UnimplementedGroupServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroupServer struct{}
+
+func (UnimplementedGroupServer) CreateGroup(context.Context, *CreateGroupRequest) (*GroupInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedGroupServer) GetGroup(context.Context, *GetGroupRequest) (*GroupInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedGroupServer) DisbandGroup(context.Context, *DisbandGroupRequest) (*DisbandGroupReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisbandGroup not implemented")
+}
+func (UnimplementedGroupServer) ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGroupMembers not implemented")
+}
+func (UnimplementedGroupServer) InviteGroupMembers(context.Context, *InviteGroupMembersRequest) (*InviteGroupMembersReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method InviteGroupMembers not implemented")
+}
+func (UnimplementedGroupServer) KickGroupMember(context.Context, *KickGroupMemberRequest) (*KickGroupMemberReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method KickGroupMember not implemented")
+}
+func (UnimplementedGroupServer) LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveGroup not implemented")
+}
+func (UnimplementedGroupServer) SetGroupMemberRole(context.Context, *SetGroupMemberRoleRequest) (*SetGroupMemberRoleReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetGroupMemberRole not implemented")
+}
+func (UnimplementedGroupServer) MuteGroupMember(context.Context, *MuteGroupMemberRequest) (*MuteGroupMemberReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method MuteGroupMember not implemented")
+}
+func (UnimplementedGroupServer) mustEmbedUnimplementedGroupServer() {}
+func (UnimplementedGroupServer) testEmbeddedByValue()               {}
+
+// UnsafeGroupServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupServer will
+// result in compilation errors.
+type UnsafeGroupServer interface {
+	mustEmbedUnimplementedGroupServer()
+}
+
+func RegisterGroupServer(s grpc.ServiceRegistrar, srv GroupServer) {
+	// If the following call panics, it indicates UnimplementedGroupServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Group_ServiceDesc, srv)
+}
+
+func _Group_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_DisbandGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisbandGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).DisbandGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_DisbandGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).DisbandGroup(ctx, req.(*DisbandGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_ListGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).ListGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_ListGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).ListGroupMembers(ctx, req.(*ListGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_InviteGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteGroupMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).InviteGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_InviteGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).InviteGroupMembers(ctx, req.(*InviteGroupMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_KickGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).KickGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_KickGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).KickGroupMember(ctx, req.(*KickGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_LeaveGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).LeaveGroup(ctx, req.(*LeaveGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_SetGroupMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetGroupMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_SetGroupMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetGroupMemberRole(ctx, req.(*SetGroupMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_MuteGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).MuteGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_MuteGroupMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).MuteGroupMember(ctx, req.(*MuteGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Group_ServiceDesc is the grpc.ServiceDesc for Group service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Group_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.chat.v1.Group",
+	HandlerType: (*GroupServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Group_CreateGroup_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _Group_GetGroup_Handler,
+		},
+		{
+			MethodName: "DisbandGroup",
+			Handler:    _Group_DisbandGroup_Handler,
+		},
+		{
+			MethodName: "ListGroupMembers",
+			Handler:    _Group_ListGroupMembers_Handler,
+		},
+		{
+			MethodName: "InviteGroupMembers",
+			Handler:    _Group_InviteGroupMembers_Handler,
+		},
+		{
+			MethodName: "KickGroupMember",
+			Handler:    _Group_KickGroupMember_Handler,
+		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _Group_LeaveGroup_Handler,
+		},
+		{
+			MethodName: "SetGroupMemberRole",
+			Handler:    _Group_SetGroupMemberRole_Handler,
+		},
+		{
+			MethodName: "MuteGroupMember",
+			Handler:    _Group_MuteGroupMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chat/v1/group.proto",
+}
@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.2
+// - protoc             v6.33.2
+// source: chat/v1/group.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationGroupCreateGroup = "/api.chat.v1.Group/CreateGroup"
+const OperationGroupDisbandGroup = "/api.chat.v1.Group/DisbandGroup"
+const OperationGroupGetGroup = "/api.chat.v1.Group/GetGroup"
+const OperationGroupInviteGroupMembers = "/api.chat.v1.Group/InviteGroupMembers"
+const OperationGroupKickGroupMember = "/api.chat.v1.Group/KickGroupMember"
+const OperationGroupLeaveGroup = "/api.chat.v1.Group/LeaveGroup"
+const OperationGroupListGroupMembers = "/api.chat.v1.Group/ListGroupMembers"
+const OperationGroupMuteGroupMember = "/api.chat.v1.Group/MuteGroupMember"
+const OperationGroupSetGroupMemberRole = "/api.chat.v1.Group/SetGroupMemberRole"
+
+type GroupHTTPServer interface {
+	// CreateGroup 创建群组
+	CreateGroup(context.Context, *CreateGroupRequest) (*GroupInfo, error)
+	// DisbandGroup 解散群组
+	DisbandGroup(context.Context, *DisbandGroupRequest) (*DisbandGroupReply, error)
+	// GetGroup 群组信息
+	GetGroup(context.Context, *GetGroupRequest) (*GroupInfo, error)
+	// InviteGroupMembers 邀请入群
+	InviteGroupMembers(context.Context, *InviteGroupMembersRequest) (*InviteGroupMembersReply, error)
+	// KickGroupMember 移出成员
+	KickGroupMember(context.Context, *KickGroupMemberRequest) (*KickGroupMemberReply, error)
+	// LeaveGroup 退出群组
+	LeaveGroup(context.Context, *LeaveGroupRequest) (*LeaveGroupReply, error)
+	// ListGroupMembers 成员列表
+	ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersReply, error)
+	// MuteGroupMember 禁言成员
+	MuteGroupMember(context.Context, *MuteGroupMemberRequest) (*MuteGroupMemberReply, error)
+	// SetGroupMemberRole 设置成员角色
+	SetGroupMemberRole(context.Context, *SetGroupMemberRoleRequest) (*SetGroupMemberRoleReply, error)
+}
+
+func RegisterGroupHTTPServer(s *http.Server, srv GroupHTTPServer) {
+	r := s.Route("/")
+	r.POST("/chat/groups", _Group_CreateGroup0_HTTP_Handler(srv))
+	r.GET("/chat/groups/{group_id}", _Group_GetGroup0_HTTP_Handler(srv))
+	r.DELETE("/chat/groups/{group_id}", _Group_DisbandGroup0_HTTP_Handler(srv))
+	r.GET("/chat/groups/{group_id}/members", _Group_ListGroupMembers0_HTTP_Handler(srv))
+	r.POST("/chat/groups/{group_id}/members", _Group_InviteGroupMembers0_HTTP_Handler(srv))
+	r.DELETE("/chat/groups/{group_id}/members/{user_id}", _Group_KickGroupMember0_HTTP_Handler(srv))
+	r.POST("/chat/groups/{group_id}/leave", _Group_LeaveGroup0_HTTP_Handler(srv))
+	r.PUT("/chat/groups/{group_id}/members/{user_id}/role", _Group_SetGroupMemberRole0_HTTP_Handler(srv))
+	r.PUT("/chat/groups/{group_id}/members/{user_id}/mute", _Group_MuteGroupMember0_HTTP_Handler(srv))
+}
+
+func _Group_CreateGroup0_HTTP_Handler(srv GroupHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGroupCreateGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateGroup(ctx, req.(*CreateGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupInfo)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Group_GetGroup0_HTTP_Handler(srv GroupHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetGroupRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGroupGetGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetGroup(ctx, req.(*GetGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GroupInfo)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Group_DisbandGroup0_HTTP_Handler(srv GroupHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisbandGroupRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGroupDisbandGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisbandGroup(ctx, req.(*DisbandGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DisbandGroupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Group_ListGroupMembers0_HTTP_Handler(srv GroupHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListGroupMembersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGroupListGroupMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListGroupMembers(ctx, req.(*ListGroupMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListGroupMembersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Group_InviteGroupMembers0_HTTP_Handler(srv GroupHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InviteGroupMembersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGroupInviteGroupMembers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.InviteGroupMembers(ctx, req.(*InviteGroupMembersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*InviteGroupMembersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Group_KickGroupMember0_HTTP_Handler(srv GroupHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in KickGroupMemberRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGroupKickGroupMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.KickGroupMember(ctx, req.(*KickGroupMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*KickGroupMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Group_LeaveGroup0_HTTP_Handler(srv GroupHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LeaveGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGroupLeaveGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LeaveGroup(ctx, req.(*LeaveGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LeaveGroupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Group_SetGroupMemberRole0_HTTP_Handler(srv GroupHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetGroupMemberRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGroupSetGroupMemberRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetGroupMemberRole(ctx, req.(*SetGroupMemberRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetGroupMemberRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Group_MuteGroupMember0_HTTP_Handler(srv GroupHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MuteGroupMemberRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationGroupMuteGroupMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MuteGroupMember(ctx, req.(*MuteGroupMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MuteGroupMemberReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type GroupHTTPClient interface {
+	// CreateGroup 创建群组
+	CreateGroup(ctx context.Context, req *CreateGroupRequest, opts ...http.CallOption) (rsp *GroupInfo, err error)
+	// DisbandGroup 解散群组
+	DisbandGroup(ctx context.Context, req *DisbandGroupRequest, opts ...http.CallOption) (rsp *DisbandGroupReply, err error)
+	// GetGroup 群组信息
+	GetGroup(ctx context.Context, req *GetGroupRequest, opts ...http.CallOption) (rsp *GroupInfo, err error)
+	// InviteGroupMembers 邀请入群
+	InviteGroupMembers(ctx context.Context, req *InviteGroupMembersRequest, opts ...http.CallOption) (rsp *InviteGroupMembersReply, err error)
+	// KickGroupMember 移出成员
+	KickGroupMember(ctx context.Context, req *KickGroupMemberRequest, opts ...http.CallOption) (rsp *KickGroupMemberReply, err error)
+	// LeaveGroup 退出群组
+	LeaveGroup(ctx context.Context, req *LeaveGroupRequest, opts ...http.CallOption) (rsp *LeaveGroupReply, err error)
+	// ListGroupMembers 成员列表
+	ListGroupMembers(ctx context.Context, req *ListGroupMembersRequest, opts ...http.CallOption) (rsp *ListGroupMembersReply, err error)
+	// MuteGroupMember 禁言成员
+	MuteGroupMember(ctx context.Context, req *MuteGroupMemberRequest, opts ...http.CallOption) (rsp *MuteGroupMemberReply, err error)
+	// SetGroupMemberRole 设置成员角色
+	SetGroupMemberRole(ctx context.Context, req *SetGroupMemberRoleRequest, opts ...http.CallOption) (rsp *SetGroupMemberRoleReply, err error)
+}
+
+type GroupHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewGroupHTTPClient(client *http.Client) GroupHTTPClient {
+	return &GroupHTTPClientImpl{client}
+}
+
+// CreateGroup 创建群组
+func (c *GroupHTTPClientImpl) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...http.CallOption) (*GroupInfo, error) {
+	var out GroupInfo
+	pattern := "/chat/groups"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGroupCreateGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DisbandGroup 解散群组
+func (c *GroupHTTPClientImpl) DisbandGroup(ctx context.Context, in *DisbandGroupRequest, opts ...http.CallOption) (*DisbandGroupReply, error) {
+	var out DisbandGroupReply
+	pattern := "/chat/groups/{group_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGroupDisbandGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGroup 群组信息
+func (c *GroupHTTPClientImpl) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...http.CallOption) (*GroupInfo, error) {
+	var out GroupInfo
+	pattern := "/chat/groups/{group_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGroupGetGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// InviteGroupMembers 邀请入群
+func (c *GroupHTTPClientImpl) InviteGroupMembers(ctx context.Context, in *InviteGroupMembersRequest, opts ...http.CallOption) (*InviteGroupMembersReply, error) {
+	var out InviteGroupMembersReply
+	pattern := "/chat/groups/{group_id}/members"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGroupInviteGroupMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// KickGroupMember 移出成员
+func (c *GroupHTTPClientImpl) KickGroupMember(ctx context.Context, in *KickGroupMemberRequest, opts ...http.CallOption) (*KickGroupMemberReply, error) {
+	var out KickGroupMemberReply
+	pattern := "/chat/groups/{group_id}/members/{user_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGroupKickGroupMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LeaveGroup 退出群组
+func (c *GroupHTTPClientImpl) LeaveGroup(ctx context.Context, in *LeaveGroupRequest, opts ...http.CallOption) (*LeaveGroupReply, error) {
+	var out LeaveGroupReply
+	pattern := "/chat/groups/{group_id}/leave"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGroupLeaveGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGroupMembers 成员列表
+func (c *GroupHTTPClientImpl) ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...http.CallOption) (*ListGroupMembersReply, error) {
+	var out ListGroupMembersReply
+	pattern := "/chat/groups/{group_id}/members"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationGroupListGroupMembers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MuteGroupMember 禁言成员
+func (c *GroupHTTPClientImpl) MuteGroupMember(ctx context.Context, in *MuteGroupMemberRequest, opts ...http.CallOption) (*MuteGroupMemberReply, error) {
+	var out MuteGroupMemberReply
+	pattern := "/chat/groups/{group_id}/members/{user_id}/mute"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGroupMuteGroupMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetGroupMemberRole 设置成员角色
+func (c *GroupHTTPClientImpl) SetGroupMemberRole(ctx context.Context, in *SetGroupMemberRoleRequest, opts ...http.CallOption) (*SetGroupMemberRoleReply, error) {
+	var out SetGroupMemberRoleReply
+	pattern := "/chat/groups/{group_id}/members/{user_id}/role"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationGroupSetGroupMemberRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: api/notification/v1/notification.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/google/gnostic/openapiv3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type NotificationInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 通知类型
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// 标题
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// 内容
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// 业务参数
+	Data map[string]string `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 是否已读
+	Read bool `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	// 已读时间
+	ReadAt int64 `protobuf:"varint,7,opt,name=read_at,proto3" json:"read_at,omitempty"`
+	// 创建时间
+	CreatedAt     int64 `protobuf:"varint,8,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationInfo) Reset() {
+	*x = NotificationInfo{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationInfo) ProtoMessage() {}
+
+func (x *NotificationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationInfo.ProtoReflect.Descriptor instead.
+func (*NotificationInfo) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NotificationInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NotificationInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotificationInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *NotificationInfo) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *NotificationInfo) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *NotificationInfo) GetReadAt() int64 {
+	if x != nil {
+		return x.ReadAt
+	}
+	return 0
+}
+
+func (x *NotificationInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 仅未读
+	UnreadOnly bool `protobuf:"varint,1,opt,name=unread_only,proto3" json:"unread_only,omitempty"`
+	// 页码
+	Page int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// 每页条数
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListNotificationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListNotificationsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知列表
+	List []*NotificationInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	// 总数
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsReply) Reset() {
+	*x = ListNotificationsReply{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsReply) ProtoMessage() {}
+
+func (x *ListNotificationsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsReply.ProtoReflect.Descriptor instead.
+func (*ListNotificationsReply) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListNotificationsReply) GetList() []*NotificationInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListNotificationsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type MarkNotificationsReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知 ID
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// 全部已读
+	All           bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MarkNotificationsReadRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *MarkNotificationsReadRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type MarkNotificationsReadReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 标记条数
+	Updated       int64 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadReply) Reset() {
+	*x = MarkNotificationsReadReply{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadReply) ProtoMessage() {}
+
+func (x *MarkNotificationsReadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadReply.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadReply) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarkNotificationsReadReply) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type GetUnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountRequest) Reset() {
+	*x = GetUnreadCountRequest{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+type GetUnreadCountReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 未读数
+	Count         int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountReply) Reset() {
+	*x = GetUnreadCountReply{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountReply) ProtoMessage() {}
+
+func (x *GetUnreadCountReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountReply.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountReply) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUnreadCountReply) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+}
+
+type TypePreference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知类型
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// 接收渠道
+	Channels []string `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	// 可选渠道
+	AvailableChannels []string `protobuf:"bytes,3,rep,name=available_channels,proto3" json:"available_channels,omitempty"`
+	// 是否强制
+	Mandatory     bool `protobuf:"varint,4,opt,name=mandatory,proto3" json:"mandatory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypePreference) Reset() {
+	*x = TypePreference{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypePreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypePreference) ProtoMessage() {}
+
+func (x *TypePreference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypePreference.ProtoReflect.Descriptor instead.
+func (*TypePreference) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TypePreference) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TypePreference) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *TypePreference) GetAvailableChannels() []string {
+	if x != nil {
+		return x.AvailableChannels
+	}
+	return nil
+}
+
+func (x *TypePreference) GetMandatory() bool {
+	if x != nil {
+		return x.Mandatory
+	}
+	return false
+}
+
+type QuietHours struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 开始时间
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// 结束时间
+	End string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// 时区
+	Timezone      string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietHours) Reset() {
+	*x = QuietHours{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHours) ProtoMessage() {}
+
+func (x *QuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHours.ProtoReflect.Descriptor instead.
+func (*QuietHours) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QuietHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *QuietHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *QuietHours) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type Preferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 各类型的接收渠道
+	Types []*TypePreference `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// 免打扰时段
+	QuietHours    *QuietHours `protobuf:"bytes,2,opt,name=quiet_hours,proto3" json:"quiet_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Preferences) Reset() {
+	*x = Preferences{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Preferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preferences) ProtoMessage() {}
+
+func (x *Preferences) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Preferences) GetTypes() []*TypePreference {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *Preferences) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+type UpdatePreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 各类型的接收渠道
+	Types []*TypePreference `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// 免打扰时段
+	QuietHours    *QuietHours `protobuf:"bytes,2,opt,name=quiet_hours,proto3" json:"quiet_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_api_notification_v1_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_notification_v1_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdatePreferencesRequest) GetTypes() []*TypePreference {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *UpdatePreferencesRequest) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+var File_api_notification_v1_notification_proto protoreflect.FileDescriptor
+
+const file_api_notification_v1_notification_proto_rawDesc = "" +
+	"\n" +
+	"&api/notification/v1/notification.proto\x12\x13api.notification.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1copenapi/v3/annotations.proto\"\x9c\x04\n" +
+	"\x10NotificationInfo\x12\x1f\n" +
+	"\x02id\x18\x01 \x01(\x03B\x0f\xbaG\f\x92\x02\t通知 IDR\x02id\x128\n" +
+	"\x04type\x18\x02 \x01(\tB$\xbaG!\x92\x02\x1e通知类型，如 login_alertR\x04type\x12\"\n" +
+	"\x05title\x18\x03 \x01(\tB\f\xbaG\t\x92\x02\x06标题R\x05title\x12&\n" +
+	"\acontent\x18\x04 \x01(\tB\f\xbaG\t\x92\x02\x06内容R\acontent\x12o\n" +
+	"\x04data\x18\x05 \x03(\v2/.api.notification.v1.NotificationInfo.DataEntryB*\xbaG'\x92\x02$业务参数，用于客户端跳转R\x04data\x12&\n" +
+	"\x04read\x18\x06 \x01(\bB\x12\xbaG\x0f\x92\x02\f是否已读R\x04read\x12L\n" +
+	"\aread_at\x18\a \x01(\x03B2\xbaG/\x92\x02,已读时间戳，单位秒，未读时为 0R\aread_at\x12A\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03B!\xbaG\x1e\x92\x02\x1b通知时间戳，单位秒R\n" +
+	"created_at\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf0\x01\n" +
+	"\x18ListNotificationsRequest\x12=\n" +
+	"\vunread_only\x18\x01 \x01(\bB\x1b\xbaG\x18\x92\x02\x15仅查询未读通知R\vunread_only\x12A\n" +
+	"\x04page\x18\x02 \x01(\x05B-\xfaB\x04\x1a\x02(\x00\xbaG#\x92\x02 页码，从 1 开始，默认 1R\x04page\x12R\n" +
+	"\tpage_size\x18\x03 \x01(\x05B4\xfaB\x06\x1a\x04\x18d(\x00\xbaG(\x92\x02%每页条数，默认 20，最大 100R\tpage_size\"\xb2\x01\n" +
+	"\x16ListNotificationsReply\x12_\n" +
+	"\x04list\x18\x01 \x03(\v2%.api.notification.v1.NotificationInfoB$\xbaG!\x92\x02\x1e通知列表，按时间倒序R\x04list\x127\n" +
+	"\x05total\x18\x02 \x01(\x03B!\xbaG\x1e\x92\x02\x1b符合条件的通知总数R\x05total\"\xa9\x01\n" +
+	"\x1cMarkNotificationsReadRequest\x12N\n" +
+	"\x03ids\x18\x01 \x03(\x03B<\xfaB\x05\x92\x01\x02\x10d\xbaG1\x92\x02.待标记的通知 ID，all 为 true 时忽略R\x03ids\x129\n" +
+	"\x03all\x18\x02 \x01(\bB'\xbaG$\x92\x02!标记全部未读通知为已读R\x03all\"_\n" +
+	"\x1aMarkNotificationsReadReply\x12A\n" +
+	"\aupdated\x18\x01 \x01(\x03B'\xbaG$\x92\x02!本次标记为已读的通知数R\aupdated\"\x17\n" +
+	"\x15GetUnreadCountRequest\"B\n" +
+	"\x13GetUnreadCountReply\x12+\n" +
+	"\x05count\x18\x01 \x01(\x03B\x15\xbaG\x12\x92\x02\x0f未读通知数R\x05count\"\x17\n" +
+	"\x15GetPreferencesRequest\"\xab\x03\n" +
+	"\x0eTypePreference\x12/\n" +
+	"\x04type\x18\x01 \x01(\tB\x1b\xfaB\x06r\x04\x10\x01\x182\xbaG\x0f\x92\x02\f通知类型R\x04type\x12\x9a\x01\n" +
+	"\bchannels\x18\x02 \x03(\tB~\xfaB\x1f\x92\x01\x1c\x10\x03\x18\x01\"\x16r\x14R\x06in_appR\x05emailR\x03sms\xbaGY\x92\x02V接收渠道：in_app=站内通知，email=邮件，sms=短信；空列表表示退订R\bchannels\x12`\n" +
+	"\x12available_channels\x18\x03 \x03(\tB0\xbaG-\x92\x02*该类型可选的渠道，修改时忽略R\x12available_channels\x12i\n" +
+	"\tmandatory\x18\x04 \x01(\bBK\xbaGH\x92\x02E强制通知，不可退订且不受免打扰限制，修改时忽略R\tmandatory\"\xef\x02\n" +
+	"\n" +
+	"QuietHours\x12u\n" +
+	"\x05start\x18\x01 \x01(\tB_\xfaB&r$2\"^$|^([01][0-9]|2[0-3]):[0-5][0-9]$\xbaG3\x92\x020开始时间，如 22:00，为空表示不开启R\x05start\x12\x80\x01\n" +
+	"\x03end\x18\x02 \x01(\tBn\xfaB&r$2\"^$|^([01][0-9]|2[0-3]):[0-5][0-9]$\xbaGB\x92\x02?结束时间，如 08:00，可早于开始时间表示跨零点R\x03end\x12g\n" +
+	"\btimezone\x18\x03 \x01(\tBK\xfaB\x04r\x02\x18@\xbaGA\x92\x02>时区，如 Asia/Shanghai，为空时使用系统默认时区R\btimezone\"\x85\x02\n" +
+	"\vPreferences\x12_\n" +
+	"\x05types\x18\x01 \x03(\v2#.api.notification.v1.TypePreferenceB$\xbaG!\x92\x02\x1e各通知类型的接收渠道R\x05types\x12\x94\x01\n" +
+	"\vquiet_hours\x18\x02 \x01(\v2\x1f.api.notification.v1.QuietHoursBQ\xbaGN\x92\x02K免打扰时段，期间不发送邮件和短信，站内通知照常保存R\vquiet_hours\"\xfb\x01\n" +
+	"\x18UpdatePreferencesRequest\x12s\n" +
+	"\x05types\x18\x01 \x03(\v2#.api.notification.v1.TypePreferenceB8\xfaB\x05\x92\x01\x02\x10d\xbaG-\x92\x02*需要修改的通知类型及接收渠道R\x05types\x12j\n" +
+	"\vquiet_hours\x18\x02 \x01(\v2\x1f.api.notification.v1.QuietHoursB'\xbaG$\x92\x02!免打扰时段，为空时关闭R\vquiet_hours2\xd4\t\n" +
+	"\fNotification\x12\x9b\x02\n" +
+	"\x11ListNotifications\x12-.api.notification.v1.ListNotificationsRequest\x1a+.api.notification.v1.ListNotificationsReply\"\xa9\x01\xbaG\x8f\x01\x12\x12查询站内通知\x1ay查询当前用户的站内通知，按时间倒序；在线时新通知另通过 WebSocket 以 notification 动作推送\x82\xd3\xe4\x93\x02\x10\x12\x0e/notifications\x12\xe8\x01\n" +
+	"\x15MarkNotificationsRead\x121.api.notification.v1.MarkNotificationsReadRequest\x1a/.api.notification.v1.MarkNotificationsReadReply\"k\xbaGJ\x12\f标记已读\x1a:按 ID 标记已读，或标记全部未读通知为已读\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/notifications/read\x12\x9f\x01\n" +
+	"\x0eGetUnreadCount\x12*.api.notification.v1.GetUnreadCountRequest\x1a(.api.notification.v1.GetUnreadCountReply\"7\xbaG\x11\x12\x0f未读通知数\x82\xd3\xe4\x93\x02\x1d\x12\x1b/notifications/unread-count\x12\xed\x01\n" +
+	"\x0eGetPreferences\x12*.api.notification.v1.GetPreferencesRequest\x1a .api.notification.v1.Preferences\"\x8c\x01\xbaGg\x12\x12获取通知偏好\x1aQ返回各通知类型可选及当前生效的接收渠道，以及免打扰时段\x82\xd3\xe4\x93\x02\x1c\x12\x1a/notifications/preferences\x12\xa8\x02\n" +
+	"\x11UpdatePreferences\x12-.api.notification.v1.UpdatePreferencesRequest\x1a .api.notification.v1.Preferences\"\xc1\x01\xbaG\x98\x01\x12\x12修改通知偏好\x1a\x81\x01未包含的通知类型保持原设置；渠道为空列表表示退订该类型；强制通知（如安全提醒）不可退订\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/notifications/preferencesB]\n" +
+	"\x13api.notification.v1P\x01ZDgithub.com/sober-studio/bubble-boot-go-kratos/api/notification/v1;v1b\x06proto3"
+
+var (
+	file_api_notification_v1_notification_proto_rawDescOnce sync.Once
+	file_api_notification_v1_notification_proto_rawDescData []byte
+)
+
+func file_api_notification_v1_notification_proto_rawDescGZIP() []byte {
+	file_api_notification_v1_notification_proto_rawDescOnce.Do(func() {
+		file_api_notification_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_notification_v1_notification_proto_rawDesc), len(file_api_notification_v1_notification_proto_rawDesc)))
+	})
+	return file_api_notification_v1_notification_proto_rawDescData
+}
+
+var file_api_notification_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_notification_v1_notification_proto_goTypes = []any{
+	(*NotificationInfo)(nil),             // 0: api.notification.v1.NotificationInfo
+	(*ListNotificationsRequest)(nil),     // 1: api.notification.v1.ListNotificationsRequest
+	(*ListNotificationsReply)(nil),       // 2: api.notification.v1.ListNotificationsReply
+	(*MarkNotificationsReadRequest)(nil), // 3: api.notification.v1.MarkNotificationsReadRequest
+	(*MarkNotificationsReadReply)(nil),   // 4: api.notification.v1.MarkNotificationsReadReply
+	(*GetUnreadCountRequest)(nil),        // 5: api.notification.v1.GetUnreadCountRequest
+	(*GetUnreadCountReply)(nil),          // 6: api.notification.v1.GetUnreadCountReply
+	(*GetPreferencesRequest)(nil),        // 7: api.notification.v1.GetPreferencesRequest
+	(*TypePreference)(nil),               // 8: api.notification.v1.TypePreference
+	(*QuietHours)(nil),                   // 9: api.notification.v1.QuietHours
+	(*Preferences)(nil),                  // 10: api.notification.v1.Preferences
+	(*UpdatePreferencesRequest)(nil),     // 11: api.notification.v1.UpdatePreferencesRequest
+	nil,                                  // 12: api.notification.v1.NotificationInfo.DataEntry
+}
+var file_api_notification_v1_notification_proto_depIdxs = []int32{
+	12, // 0: api.notification.v1.NotificationInfo.data:type_name -> api.notification.v1.NotificationInfo.DataEntry
+	0,  // 1: api.notification.v1.ListNotificationsReply.list:type_name -> api.notification.v1.NotificationInfo
+	8,  // 2: api.notification.v1.Preferences.types:type_name -> api.notification.v1.TypePreference
+	9,  // 3: api.notification.v1.Preferences.quiet_hours:type_name -> api.notification.v1.QuietHours
+	8,  // 4: api.notification.v1.UpdatePreferencesRequest.types:type_name -> api.notification.v1.TypePreference
+	9,  // 5: api.notification.v1.UpdatePreferencesRequest.quiet_hours:type_name -> api.notification.v1.QuietHours
+	1,  // 6: api.notification.v1.Notification.ListNotifications:input_type -> api.notification.v1.ListNotificationsRequest
+	3,  // 7: api.notification.v1.Notification.MarkNotificationsRead:input_type -> api.notification.v1.MarkNotificationsReadRequest
+	5,  // 8: api.notification.v1.Notification.GetUnreadCount:input_type -> api.notification.v1.GetUnreadCountRequest
+	7,  // 9: api.notification.v1.Notification.GetPreferences:input_type -> api.notification.v1.GetPreferencesRequest
+	11, // 10: api.notification.v1.Notification.UpdatePreferences:input_type -> api.notification.v1.UpdatePreferencesRequest
+	2,  // 11: api.notification.v1.Notification.ListNotifications:output_type -> api.notification.v1.ListNotificationsReply
+	4,  // 12: api.notification.v1.Notification.MarkNotificationsRead:output_type -> api.notification.v1.MarkNotificationsReadReply
+	6,  // 13: api.notification.v1.Notification.GetUnreadCount:output_type -> api.notification.v1.GetUnreadCountReply
+	10, // 14: api.notification.v1.Notification.GetPreferences:output_type -> api.notification.v1.Preferences
+	10, // 15: api.notification.v1.Notification.UpdatePreferences:output_type -> api.notification.v1.Preferences
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_api_notification_v1_notification_proto_init() }
+func file_api_notification_v1_notification_proto_init() {
+	if File_api_notification_v1_notification_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_notification_v1_notification_proto_rawDesc), len(file_api_notification_v1_notification_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_notification_v1_notification_proto_goTypes,
+		DependencyIndexes: file_api_notification_v1_notification_proto_depIdxs,
+		MessageInfos:      file_api_notification_v1_notification_proto_msgTypes,
+	}.Build()
+	File_api_notification_v1_notification_proto = out.File
+	file_api_notification_v1_notification_proto_goTypes = nil
+	file_api_notification_v1_notification_proto_depIdxs = nil
+}
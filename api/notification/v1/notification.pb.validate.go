@@ -0,0 +1,1579 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/notification/v1/notification.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on NotificationInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NotificationInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationInfoMultiError, or nil if none found.
+func (m *NotificationInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Data
+
+	// no validation rules for Read
+
+	// no validation rules for ReadAt
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return NotificationInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationInfoMultiError is an error wrapping multiple validation errors
+// returned by NotificationInfo.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationInfoMultiError) AllErrors() []error { return m }
+
+// NotificationInfoValidationError is the validation error returned by
+// NotificationInfo.Validate if the designated constraints aren't met.
+type NotificationInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationInfoValidationError) ErrorName() string { return "NotificationInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationInfoValidationError{}
+
+// Validate checks the field values on ListNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsRequestMultiError, or nil if none found.
+func (m *ListNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UnreadOnly
+
+	if m.GetPage() < 0 {
+		err := ListNotificationsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListNotificationsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.Validate if the designated constraints aren't met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+// Validate checks the field values on ListNotificationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListNotificationsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListNotificationsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListNotificationsReplyMultiError, or nil if none found.
+func (m *ListNotificationsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListNotificationsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListNotificationsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListNotificationsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListNotificationsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListNotificationsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListNotificationsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListNotificationsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListNotificationsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListNotificationsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListNotificationsReplyMultiError) AllErrors() []error { return m }
+
+// ListNotificationsReplyValidationError is the validation error returned by
+// ListNotificationsReply.Validate if the designated constraints aren't met.
+type ListNotificationsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsReplyValidationError) ErrorName() string {
+	return "ListNotificationsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsReplyValidationError{}
+
+// Validate checks the field values on MarkNotificationsReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkNotificationsReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkNotificationsReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkNotificationsReadRequestMultiError, or nil if none found.
+func (m *MarkNotificationsReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkNotificationsReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIds()) > 100 {
+		err := MarkNotificationsReadRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for All
+
+	if len(errors) > 0 {
+		return MarkNotificationsReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkNotificationsReadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkNotificationsReadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MarkNotificationsReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkNotificationsReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkNotificationsReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkNotificationsReadRequestValidationError is the validation error returned
+// by MarkNotificationsReadRequest.Validate if the designated constraints
+// aren't met.
+type MarkNotificationsReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkNotificationsReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkNotificationsReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkNotificationsReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkNotificationsReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkNotificationsReadRequestValidationError) ErrorName() string {
+	return "MarkNotificationsReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkNotificationsReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkNotificationsReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkNotificationsReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkNotificationsReadRequestValidationError{}
+
+// Validate checks the field values on MarkNotificationsReadReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkNotificationsReadReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkNotificationsReadReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkNotificationsReadReplyMultiError, or nil if none found.
+func (m *MarkNotificationsReadReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkNotificationsReadReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Updated
+
+	if len(errors) > 0 {
+		return MarkNotificationsReadReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkNotificationsReadReplyMultiError is an error wrapping multiple
+// validation errors returned by MarkNotificationsReadReply.ValidateAll() if
+// the designated constraints aren't met.
+type MarkNotificationsReadReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkNotificationsReadReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkNotificationsReadReplyMultiError) AllErrors() []error { return m }
+
+// MarkNotificationsReadReplyValidationError is the validation error returned
+// by MarkNotificationsReadReply.Validate if the designated constraints aren't met.
+type MarkNotificationsReadReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkNotificationsReadReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkNotificationsReadReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkNotificationsReadReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkNotificationsReadReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkNotificationsReadReplyValidationError) ErrorName() string {
+	return "MarkNotificationsReadReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkNotificationsReadReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkNotificationsReadReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkNotificationsReadReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkNotificationsReadReplyValidationError{}
+
+// Validate checks the field values on GetUnreadCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUnreadCountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUnreadCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUnreadCountRequestMultiError, or nil if none found.
+func (m *GetUnreadCountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUnreadCountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetUnreadCountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUnreadCountRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUnreadCountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUnreadCountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUnreadCountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUnreadCountRequestMultiError) AllErrors() []error { return m }
+
+// GetUnreadCountRequestValidationError is the validation error returned by
+// GetUnreadCountRequest.Validate if the designated constraints aren't met.
+type GetUnreadCountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUnreadCountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUnreadCountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUnreadCountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUnreadCountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUnreadCountRequestValidationError) ErrorName() string {
+	return "GetUnreadCountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUnreadCountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUnreadCountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUnreadCountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUnreadCountRequestValidationError{}
+
+// Validate checks the field values on GetUnreadCountReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUnreadCountReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUnreadCountReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUnreadCountReplyMultiError, or nil if none found.
+func (m *GetUnreadCountReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUnreadCountReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return GetUnreadCountReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUnreadCountReplyMultiError is an error wrapping multiple validation
+// errors returned by GetUnreadCountReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetUnreadCountReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUnreadCountReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUnreadCountReplyMultiError) AllErrors() []error { return m }
+
+// GetUnreadCountReplyValidationError is the validation error returned by
+// GetUnreadCountReply.Validate if the designated constraints aren't met.
+type GetUnreadCountReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUnreadCountReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUnreadCountReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUnreadCountReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUnreadCountReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUnreadCountReplyValidationError) ErrorName() string {
+	return "GetUnreadCountReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUnreadCountReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUnreadCountReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUnreadCountReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUnreadCountReplyValidationError{}
+
+// Validate checks the field values on GetPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreferencesRequestMultiError, or nil if none found.
+func (m *GetPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPreferencesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreferencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// GetPreferencesRequestValidationError is the validation error returned by
+// GetPreferencesRequest.Validate if the designated constraints aren't met.
+type GetPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreferencesRequestValidationError) ErrorName() string {
+	return "GetPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreferencesRequestValidationError{}
+
+// Validate checks the field values on TypePreference with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TypePreference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TypePreference with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TypePreferenceMultiError,
+// or nil if none found.
+func (m *TypePreference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TypePreference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetType()); l < 1 || l > 50 {
+		err := TypePreferenceValidationError{
+			field:  "Type",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetChannels()) > 3 {
+		err := TypePreferenceValidationError{
+			field:  "Channels",
+			reason: "value must contain no more than 3 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_TypePreference_Channels_Unique := make(map[string]struct{}, len(m.GetChannels()))
+
+	for idx, item := range m.GetChannels() {
+		_, _ = idx, item
+
+		if _, exists := _TypePreference_Channels_Unique[item]; exists {
+			err := TypePreferenceValidationError{
+				field:  fmt.Sprintf("Channels[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_TypePreference_Channels_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _TypePreference_Channels_InLookup[item]; !ok {
+			err := TypePreferenceValidationError{
+				field:  fmt.Sprintf("Channels[%v]", idx),
+				reason: "value must be in list [in_app email sms]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Mandatory
+
+	if len(errors) > 0 {
+		return TypePreferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// TypePreferenceMultiError is an error wrapping multiple validation errors
+// returned by TypePreference.ValidateAll() if the designated constraints
+// aren't met.
+type TypePreferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TypePreferenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TypePreferenceMultiError) AllErrors() []error { return m }
+
+// TypePreferenceValidationError is the validation error returned by
+// TypePreference.Validate if the designated constraints aren't met.
+type TypePreferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TypePreferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TypePreferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TypePreferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TypePreferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TypePreferenceValidationError) ErrorName() string { return "TypePreferenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TypePreferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTypePreference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TypePreferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TypePreferenceValidationError{}
+
+var _TypePreference_Channels_InLookup = map[string]struct{}{
+	"in_app": {},
+	"email":  {},
+	"sms":    {},
+}
+
+// Validate checks the field values on QuietHours with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuietHours) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuietHours with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuietHoursMultiError, or
+// nil if none found.
+func (m *QuietHours) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuietHours) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_QuietHours_Start_Pattern.MatchString(m.GetStart()) {
+		err := QuietHoursValidationError{
+			field:  "Start",
+			reason: "value does not match regex pattern \"^$|^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_QuietHours_End_Pattern.MatchString(m.GetEnd()) {
+		err := QuietHoursValidationError{
+			field:  "End",
+			reason: "value does not match regex pattern \"^$|^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+		err := QuietHoursValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return QuietHoursMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuietHoursMultiError is an error wrapping multiple validation errors
+// returned by QuietHours.ValidateAll() if the designated constraints aren't met.
+type QuietHoursMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuietHoursMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuietHoursMultiError) AllErrors() []error { return m }
+
+// QuietHoursValidationError is the validation error returned by
+// QuietHours.Validate if the designated constraints aren't met.
+type QuietHoursValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuietHoursValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuietHoursValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuietHoursValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuietHoursValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuietHoursValidationError) ErrorName() string { return "QuietHoursValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuietHoursValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuietHours.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuietHoursValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuietHoursValidationError{}
+
+var _QuietHours_Start_Pattern = regexp.MustCompile("^$|^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+var _QuietHours_End_Pattern = regexp.MustCompile("^$|^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+// Validate checks the field values on Preferences with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Preferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Preferences with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PreferencesMultiError, or
+// nil if none found.
+func (m *Preferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Preferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreferencesValidationError{
+						field:  fmt.Sprintf("Types[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreferencesValidationError{
+						field:  fmt.Sprintf("Types[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreferencesValidationError{
+					field:  fmt.Sprintf("Types[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuietHours()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreferencesValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreferencesValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuietHours()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreferencesValidationError{
+				field:  "QuietHours",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreferencesMultiError is an error wrapping multiple validation errors
+// returned by Preferences.ValidateAll() if the designated constraints aren't met.
+type PreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreferencesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreferencesMultiError) AllErrors() []error { return m }
+
+// PreferencesValidationError is the validation error returned by
+// Preferences.Validate if the designated constraints aren't met.
+type PreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreferencesValidationError) ErrorName() string { return "PreferencesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreferencesValidationError{}
+
+// Validate checks the field values on UpdatePreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePreferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePreferencesRequestMultiError, or nil if none found.
+func (m *UpdatePreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTypes()) > 100 {
+		err := UpdatePreferencesRequestValidationError{
+			field:  "Types",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdatePreferencesRequestValidationError{
+						field:  fmt.Sprintf("Types[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdatePreferencesRequestValidationError{
+						field:  fmt.Sprintf("Types[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdatePreferencesRequestValidationError{
+					field:  fmt.Sprintf("Types[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuietHours()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePreferencesRequestValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePreferencesRequestValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuietHours()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePreferencesRequestValidationError{
+				field:  "QuietHours",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePreferencesRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePreferencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePreferencesRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePreferencesRequestValidationError is the validation error returned by
+// UpdatePreferencesRequest.Validate if the designated constraints aren't met.
+type UpdatePreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePreferencesRequestValidationError) ErrorName() string {
+	return "UpdatePreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePreferencesRequestValidationError{}
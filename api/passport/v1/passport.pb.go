@@ -33,10 +33,12 @@ type RegisterRequest struct {
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	// 确认密码，规则：6-20位字符
 	ConfirmPassword string `protobuf:"bytes,3,opt,name=confirm_password,proto3" json:"confirm_password,omitempty"`
-	// 手机号，规则：11位数字，选填
+	// 手机号，规则：5-15位数字，可带 + 国际区号，选填
 	Mobile string `protobuf:"bytes,4,opt,name=mobile,proto3" json:"mobile,omitempty"`
 	// 验证码，规则：4-6位字符，选填
-	Code          string `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	Code string `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	// 国际区号，选填
+	CountryCode   string `protobuf:"bytes,6,opt,name=country_code,proto3" json:"country_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,6 +108,13 @@ func (x *RegisterRequest) GetCode() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
 type RegisterReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 登录凭证
@@ -158,9 +167,9 @@ type LoginByPasswordRequest struct {
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	// 密码，规则：6-20位字符
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	// 图形验证码ID
+	// 图形验证码ID，高风险时必填
 	CaptchaId string `protobuf:"bytes,3,opt,name=captcha_id,proto3" json:"captcha_id,omitempty"`
-	// 图形验证码
+	// 图形验证码，高风险时必填
 	Captcha       string `protobuf:"bytes,4,opt,name=captcha,proto3" json:"captcha,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -227,10 +236,12 @@ func (x *LoginByPasswordRequest) GetCaptcha() string {
 // ========== 验证码登录 ==========
 type LoginByOtpRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 手机号，规则：11位数字
+	// 手机号，规则：5-15位数字，可带 + 国际区号
 	Mobile string `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
 	// 验证码
-	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// 国际区号，选填
+	CountryCode   string `protobuf:"bytes,4,opt,name=country_code,proto3" json:"country_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -279,6 +290,13 @@ func (x *LoginByOtpRequest) GetCode() string {
 	return ""
 }
 
+func (x *LoginByOtpRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
 // ========== 登录响应 ==========
 type LoginReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -442,7 +460,9 @@ type UserInfoReply struct {
 	// 手机号
 	Mobile string `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty"`
 	// 状态：0=禁用，1=正常
-	Status        int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Status int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	// 昵称
+	Nickname      string `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -498,6 +518,13 @@ func (x *UserInfoReply) GetStatus() int32 {
 	return 0
 }
 
+func (x *UserInfoReply) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
 // ========== 修改密码 ==========
 type UpdatePasswordRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -601,10 +628,12 @@ func (*UpdatePasswordReply) Descriptor() ([]byte, []int) {
 // ========== 绑定手机号 ==========
 type BindMobileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 手机号，规则：11位数字
+	// 手机号，规则：5-15位数字，可带 + 国际区号
 	Mobile string `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
 	// 验证码
-	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// 国际区号，选填
+	CountryCode   string `protobuf:"bytes,3,opt,name=country_code,proto3" json:"country_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -653,6 +682,13 @@ func (x *BindMobileRequest) GetCode() string {
 	return ""
 }
 
+func (x *BindMobileRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
 type BindMobileReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -692,10 +728,12 @@ func (*BindMobileReply) Descriptor() ([]byte, []int) {
 // ========== 修改绑定手机号 ==========
 type UpdateMobileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 新手机号，规则：11位数字
+	// 新手机号，规则：5-15位数字，可带 + 国际区号
 	Mobile string `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
 	// 验证码
-	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// 国际区号，选填
+	CountryCode   string `protobuf:"bytes,3,opt,name=country_code,proto3" json:"country_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -744,6 +782,13 @@ func (x *UpdateMobileRequest) GetCode() string {
 	return ""
 }
 
+func (x *UpdateMobileRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
 type UpdateMobileReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -780,10 +825,92 @@ func (*UpdateMobileReply) Descriptor() ([]byte, []int) {
 	return file_api_passport_v1_passport_proto_rawDescGZIP(), []int{14}
 }
 
+// ========== 修改昵称 ==========
+type UpdateNicknameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 昵称，规则：1-20位字符
+	Nickname      string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNicknameRequest) Reset() {
+	*x = UpdateNicknameRequest{}
+	mi := &file_api_passport_v1_passport_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNicknameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNicknameRequest) ProtoMessage() {}
+
+func (x *UpdateNicknameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_passport_v1_passport_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNicknameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNicknameRequest) Descriptor() ([]byte, []int) {
+	return file_api_passport_v1_passport_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateNicknameRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type UpdateNicknameReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNicknameReply) Reset() {
+	*x = UpdateNicknameReply{}
+	mi := &file_api_passport_v1_passport_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNicknameReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNicknameReply) ProtoMessage() {}
+
+func (x *UpdateNicknameReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_passport_v1_passport_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNicknameReply.ProtoReflect.Descriptor instead.
+func (*UpdateNicknameReply) Descriptor() ([]byte, []int) {
+	return file_api_passport_v1_passport_proto_rawDescGZIP(), []int{16}
+}
+
 // ========== 找回密码 ==========
 type ResetPasswordRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 手机号，规则：11位数字
+	// 手机号，规则：5-15位数字，可带 + 国际区号
 	Mobile string `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
 	// 短信验证码
 	SmsCode string `protobuf:"bytes,2,opt,name=sms_code,proto3" json:"sms_code,omitempty"`
@@ -791,13 +918,15 @@ type ResetPasswordRequest struct {
 	NewPassword string `protobuf:"bytes,3,opt,name=new_password,proto3" json:"new_password,omitempty"`
 	// 确认新密码，规则：6-20位字符
 	ConfirmPassword string `protobuf:"bytes,4,opt,name=confirm_password,proto3" json:"confirm_password,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// 国际区号，选填
+	CountryCode   string `protobuf:"bytes,5,opt,name=country_code,proto3" json:"country_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResetPasswordRequest) Reset() {
 	*x = ResetPasswordRequest{}
-	mi := &file_api_passport_v1_passport_proto_msgTypes[15]
+	mi := &file_api_passport_v1_passport_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +938,7 @@ func (x *ResetPasswordRequest) String() string {
 func (*ResetPasswordRequest) ProtoMessage() {}
 
 func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_passport_v1_passport_proto_msgTypes[15]
+	mi := &file_api_passport_v1_passport_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +951,7 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_api_passport_v1_passport_proto_rawDescGZIP(), []int{15}
+	return file_api_passport_v1_passport_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ResetPasswordRequest) GetMobile() string {
@@ -853,6 +982,13 @@ func (x *ResetPasswordRequest) GetConfirmPassword() string {
 	return ""
 }
 
+func (x *ResetPasswordRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
 type ResetPasswordReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -861,7 +997,7 @@ type ResetPasswordReply struct {
 
 func (x *ResetPasswordReply) Reset() {
 	*x = ResetPasswordReply{}
-	mi := &file_api_passport_v1_passport_proto_msgTypes[16]
+	mi := &file_api_passport_v1_passport_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -873,7 +1009,7 @@ func (x *ResetPasswordReply) String() string {
 func (*ResetPasswordReply) ProtoMessage() {}
 
 func (x *ResetPasswordReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_passport_v1_passport_proto_msgTypes[16]
+	mi := &file_api_passport_v1_passport_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,61 +1022,71 @@ func (x *ResetPasswordReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordReply.ProtoReflect.Descriptor instead.
 func (*ResetPasswordReply) Descriptor() ([]byte, []int) {
-	return file_api_passport_v1_passport_proto_rawDescGZIP(), []int{16}
+	return file_api_passport_v1_passport_proto_rawDescGZIP(), []int{18}
 }
 
 var File_api_passport_v1_passport_proto protoreflect.FileDescriptor
 
 const file_api_passport_v1_passport_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/passport/v1/passport.proto\x12\x0fapi.passport.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1copenapi/v3/annotations.proto\"\xa7\x03\n" +
+	"\x1eapi/passport/v1/passport.proto\x12\x0fapi.passport.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1copenapi/v3/annotations.proto\"\x81\x05\n" +
 	"\x0fRegisterRequest\x12H\n" +
 	"\busername\x18\x01 \x01(\tB,\xe2A\x01\x02\xfaB\x06r\x04\x10\x03\x18\x14\xbaG\x1c\x92\x02\x19用户名，3-20位字符R\busername\x12E\n" +
 	"\bpassword\x18\x02 \x01(\tB)\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x19\x92\x02\x16密码，6-20位字符R\bpassword\x12[\n" +
-	"\x10confirm_password\x18\x03 \x01(\tB/\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x1f\x92\x02\x1c确认密码，6-20位字符R\x10confirm_password\x12Y\n" +
-	"\x06mobile\x18\x04 \x01(\tBA\xe2A\x01\x01\xfaB\x14r\x122\r^1[3-9]\\d{9}$\xd0\x01\x01\xbaG#\x92\x02 手机号，11位数字，选填R\x06mobile\x12K\n" +
-	"\x04code\x18\x05 \x01(\tB7\xe2A\x01\x01\xfaB\tr\a\x10\x04\x18\x06\xd0\x01\x01\xbaG$\x92\x02!验证码，4-6位字符，选填R\x04code\"9\n" +
+	"\x10confirm_password\x18\x03 \x01(\tB/\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x1f\x92\x02\x1c确认密码，6-20位字符R\x10confirm_password\x12\xa9\x01\n" +
+	"\x06mobile\x18\x04 \x01(\tB\x90\x01\xe2A\x01\x01\xfaB\x14r\x122\r^\\+?\\d{5,15}$\xd0\x01\x01\xbaGr\x92\x02o手机号，可带国际区号如 +85291234567，未带区号时按 country_code 或默认地区解析，选填R\x06mobile\x12K\n" +
+	"\x04code\x18\x05 \x01(\tB7\xe2A\x01\x01\xfaB\tr\a\x10\x04\x18\x06\xd0\x01\x01\xbaG$\x92\x02!验证码，4-6位字符，选填R\x04code\x12\x86\x01\n" +
+	"\fcountry_code\x18\x06 \x01(\tBb\xe2A\x01\x01\xfaB\x13r\x112\f^\\+?\\d{1,3}$\xd0\x01\x01\xbaGE\x92\x02B国际区号，如 86、852，选填，为空时使用默认地区R\fcountry_code\"9\n" +
 	"\rRegisterReply\x12(\n" +
-	"\x05token\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f登录凭证R\x05token\"\xa1\x02\n" +
+	"\x05token\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f登录凭证R\x05token\"\xff\x03\n" +
 	"\x16LoginByPasswordRequest\x12H\n" +
 	"\busername\x18\x01 \x01(\tB,\xe2A\x01\x02\xfaB\x06r\x04\x10\x03\x18\x14\xbaG\x1c\x92\x02\x19用户名，3-20位字符R\busername\x12E\n" +
-	"\bpassword\x18\x02 \x01(\tB)\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x19\x92\x02\x16密码，6-20位字符R\bpassword\x12;\n" +
+	"\bpassword\x18\x02 \x01(\tB)\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x19\x92\x02\x16密码，6-20位字符R\bpassword\x12\x85\x01\n" +
 	"\n" +
-	"captcha_id\x18\x03 \x01(\tB\x1b\xe2A\x01\x02\xbaG\x14\x92\x02\x11图形验证码IDR\n" +
-	"captcha_id\x129\n" +
-	"\acaptcha\x18\x04 \x01(\tB\x1f\xe2A\x01\x02\xbaG\x18\x92\x02\x15图形验证码内容R\acaptcha\"\x9f\x01\n" +
-	"\x11LoginByOtpRequest\x12I\n" +
-	"\x06mobile\x18\x01 \x01(\tB1\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$\xbaG\x1a\x92\x02\x17手机号，11位数字R\x06mobile\x12?\n" +
-	"\x04code\x18\x03 \x01(\tB+\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG\x1b\x92\x02\x18验证码，4-6位字符R\x04code\"6\n" +
+	"captcha_id\x18\x03 \x01(\tBe\xe2A\x01\x01\xbaG^\x92\x02[图形验证码ID，风险评估要求验证码时必填（错误原因 CAPTCHA_REQUIRED）R\n" +
+	"captcha_id\x12\xcb\x01\n" +
+	"\acaptcha\x18\x04 \x01(\tB\xb0\x01\xe2A\x01\x01\xbaG\xa8\x01\x92\x02\xa4\x01图形验证码内容，风险评估要求验证码时必填；滑块验证码为 JSON：{\"x\":缺口横坐标,\"duration\":拖动毫秒数,\"track\":[[x,y,毫秒],...]}R\acaptcha\"\xf9\x02\n" +
+	"\x11LoginByOtpRequest\x12\x99\x01\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x80\x01\xfaB\x11r\x0f2\r^\\+?\\d{5,15}$\xbaGi\x92\x02f手机号，可带国际区号如 +85291234567，未带区号时按 country_code 或默认地区解析R\x06mobile\x12?\n" +
+	"\x04code\x18\x03 \x01(\tB+\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG\x1b\x92\x02\x18验证码，4-6位字符R\x04code\x12\x86\x01\n" +
+	"\fcountry_code\x18\x04 \x01(\tBb\xe2A\x01\x01\xfaB\x13r\x112\f^\\+?\\d{1,3}$\xd0\x01\x01\xbaGE\x92\x02B国际区号，如 86、852，选填，为空时使用默认地区R\fcountry_code\"6\n" +
 	"\n" +
 	"LoginReply\x12(\n" +
 	"\x05token\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f登录凭证R\x05token\"\x0f\n" +
 	"\rLogoutRequest\"\r\n" +
 	"\vLogoutReply\"\x11\n" +
-	"\x0fUserInfoRequest\"\xa1\x01\n" +
+	"\x0fUserInfoRequest\"\xcb\x01\n" +
 	"\rUserInfoReply\x12+\n" +
 	"\busername\x18\x01 \x01(\tB\x0f\xbaG\f\x92\x02\t用户名R\busername\x12'\n" +
 	"\x06mobile\x18\x02 \x01(\tB\x0f\xbaG\f\x92\x02\t手机号R\x06mobile\x12:\n" +
-	"\x06status\x18\x03 \x01(\x05B\"\xbaG\x1f\x92\x02\x1c状态：0=禁用，1=正常R\x06status\"\x9b\x02\n" +
+	"\x06status\x18\x03 \x01(\x05B\"\xbaG\x1f\x92\x02\x1c状态：0=禁用，1=正常R\x06status\x12(\n" +
+	"\bnickname\x18\x04 \x01(\tB\f\xbaG\t\x92\x02\x06昵称R\bnickname\"\x9b\x02\n" +
 	"\x15UpdatePasswordRequest\x12P\n" +
 	"\fold_password\x18\x01 \x01(\tB,\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x1c\x92\x02\x19旧密码，6-20位字符R\fold_password\x12P\n" +
 	"\fnew_password\x18\x02 \x01(\tB,\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x1c\x92\x02\x19新密码，6-20位字符R\fnew_password\x12^\n" +
 	"\x10confirm_password\x18\x03 \x01(\tB2\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\"\x92\x02\x1f确认新密码，6-20位字符R\x10confirm_password\"\x15\n" +
-	"\x13UpdatePasswordReply\"\xa3\x01\n" +
-	"\x11BindMobileRequest\x12M\n" +
-	"\x06mobile\x18\x01 \x01(\tB5\xe2A\x01\x02\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$\xbaG\x1a\x92\x02\x17手机号，11位数字R\x06mobile\x12?\n" +
-	"\x04code\x18\x02 \x01(\tB+\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG\x1b\x92\x02\x18验证码，4-6位字符R\x04code\"\x11\n" +
-	"\x0fBindMobileReply\"\xa8\x01\n" +
-	"\x13UpdateMobileRequest\x12P\n" +
-	"\x06mobile\x18\x01 \x01(\tB8\xe2A\x01\x02\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$\xbaG\x1d\x92\x02\x1a新手机号，11位数字R\x06mobile\x12?\n" +
-	"\x04code\x18\x02 \x01(\tB+\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG\x1b\x92\x02\x18验证码，4-6位字符R\x04code\"\x13\n" +
-	"\x11UpdateMobileReply\"\xe6\x02\n" +
-	"\x14ResetPasswordRequest\x12M\n" +
-	"\x06mobile\x18\x01 \x01(\tB5\xe2A\x01\x02\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$\xbaG\x1a\x92\x02\x17手机号，11位数字R\x06mobile\x12M\n" +
+	"\x13UpdatePasswordReply\"\xfd\x02\n" +
+	"\x11BindMobileRequest\x12\x9d\x01\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x84\x01\xe2A\x01\x02\xfaB\x11r\x0f2\r^\\+?\\d{5,15}$\xbaGi\x92\x02f手机号，可带国际区号如 +85291234567，未带区号时按 country_code 或默认地区解析R\x06mobile\x12?\n" +
+	"\x04code\x18\x02 \x01(\tB+\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG\x1b\x92\x02\x18验证码，4-6位字符R\x04code\x12\x86\x01\n" +
+	"\fcountry_code\x18\x03 \x01(\tBb\xe2A\x01\x01\xfaB\x13r\x112\f^\\+?\\d{1,3}$\xd0\x01\x01\xbaGE\x92\x02B国际区号，如 86、852，选填，为空时使用默认地区R\fcountry_code\"\x11\n" +
+	"\x0fBindMobileReply\"\x82\x03\n" +
+	"\x13UpdateMobileRequest\x12\xa0\x01\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x87\x01\xe2A\x01\x02\xfaB\x11r\x0f2\r^\\+?\\d{5,15}$\xbaGl\x92\x02i新手机号，可带国际区号如 +85291234567，未带区号时按 country_code 或默认地区解析R\x06mobile\x12?\n" +
+	"\x04code\x18\x02 \x01(\tB+\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG\x1b\x92\x02\x18验证码，4-6位字符R\x04code\x12\x86\x01\n" +
+	"\fcountry_code\x18\x03 \x01(\tBb\xe2A\x01\x01\xfaB\x13r\x112\f^\\+?\\d{1,3}$\xd0\x01\x01\xbaGE\x92\x02B国际区号，如 86、852，选填，为空时使用默认地区R\fcountry_code\"\x13\n" +
+	"\x11UpdateMobileReply\"^\n" +
+	"\x15UpdateNicknameRequest\x12E\n" +
+	"\bnickname\x18\x01 \x01(\tB)\xe2A\x01\x02\xfaB\x06r\x04\x10\x01\x18\x14\xbaG\x19\x92\x02\x16昵称，1-20位字符R\bnickname\"\x15\n" +
+	"\x13UpdateNicknameReply\"\xc0\x04\n" +
+	"\x14ResetPasswordRequest\x12\x9d\x01\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x84\x01\xe2A\x01\x02\xfaB\x11r\x0f2\r^\\+?\\d{5,15}$\xbaGi\x92\x02f手机号，可带国际区号如 +85291234567，未带区号时按 country_code 或默认地区解析R\x06mobile\x12M\n" +
 	"\bsms_code\x18\x02 \x01(\tB1\xe2A\x01\x02\xfaB\x06r\x04\x10\x04\x18\x06\xbaG!\x92\x02\x1e短信验证码，4-6位字符R\bsms_code\x12P\n" +
 	"\fnew_password\x18\x03 \x01(\tB,\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\x1c\x92\x02\x19新密码，6-20位字符R\fnew_password\x12^\n" +
-	"\x10confirm_password\x18\x04 \x01(\tB2\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\"\x92\x02\x1f确认新密码，6-20位字符R\x10confirm_password\"\x14\n" +
-	"\x12ResetPasswordReply2\xe5\t\n" +
+	"\x10confirm_password\x18\x04 \x01(\tB2\xe2A\x01\x02\xfaB\x06r\x04\x10\x06\x18\x14\xbaG\"\x92\x02\x1f确认新密码，6-20位字符R\x10confirm_password\x12\x86\x01\n" +
+	"\fcountry_code\x18\x05 \x01(\tBb\xe2A\x01\x01\xfaB\x13r\x112\f^\\+?\\d{1,3}$\xd0\x01\x01\xbaGE\x92\x02B国际区号，如 86、852，选填，为空时使用默认地区R\fcountry_code\"\x14\n" +
+	"\x12ResetPasswordReply2\xfd\n" +
+	"\n" +
 	"\bPassport\x12|\n" +
 	"\bRegister\x12 .api.passport.v1.RegisterRequest\x1a\x1e.api.passport.v1.RegisterReply\".\xbaG\x0e\x12\f用户注册\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/passport/register\x12\x8d\x01\n" +
 	"\x0fLoginByPassword\x12'.api.passport.v1.LoginByPasswordRequest\x1a\x1b.api.passport.v1.LoginReply\"4\xbaG\x0e\x12\f密码登录\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/passport/login/password\x12\x81\x01\n" +
@@ -951,7 +1097,8 @@ const file_api_passport_v1_passport_proto_rawDesc = "" +
 	"\x0eUpdatePassword\x12&.api.passport.v1.UpdatePasswordRequest\x1a$.api.passport.v1.UpdatePasswordReply\"5\xbaG\x0e\x12\f修改密码\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/passport/update-password\x12\x88\x01\n" +
 	"\n" +
 	"BindMobile\x12\".api.passport.v1.BindMobileRequest\x1a .api.passport.v1.BindMobileReply\"4\xbaG\x11\x12\x0f绑定手机号\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/passport/bind-mobile\x12\x96\x01\n" +
-	"\fUpdateMobile\x12$.api.passport.v1.UpdateMobileRequest\x1a\".api.passport.v1.UpdateMobileReply\"<\xbaG\x17\x12\x15修改绑定手机号\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/passport/update-mobile\x12\x91\x01\n" +
+	"\fUpdateMobile\x12$.api.passport.v1.UpdateMobileRequest\x1a\".api.passport.v1.UpdateMobileReply\"<\xbaG\x17\x12\x15修改绑定手机号\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/passport/update-mobile\x12\x95\x01\n" +
+	"\x0eUpdateNickname\x12&.api.passport.v1.UpdateNicknameRequest\x1a$.api.passport.v1.UpdateNicknameReply\"5\xbaG\x0e\x12\f修改昵称\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/passport/update-nickname\x12\x91\x01\n" +
 	"\rResetPassword\x12%.api.passport.v1.ResetPasswordRequest\x1a#.api.passport.v1.ResetPasswordReply\"4\xbaG\x0e\x12\f找回密码\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/passport/reset-passwordBU\n" +
 	"\x0fapi.passport.v1P\x01Z@github.com/sober-studio/bubble-boot-go-kratos/api/passport/v1;v1b\x06proto3"
 
@@ -967,7 +1114,7 @@ func file_api_passport_v1_passport_proto_rawDescGZIP() []byte {
 	return file_api_passport_v1_passport_proto_rawDescData
 }
 
-var file_api_passport_v1_passport_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_api_passport_v1_passport_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_api_passport_v1_passport_proto_goTypes = []any{
 	(*RegisterRequest)(nil),        // 0: api.passport.v1.RegisterRequest
 	(*RegisterReply)(nil),          // 1: api.passport.v1.RegisterReply
@@ -984,8 +1131,10 @@ var file_api_passport_v1_passport_proto_goTypes = []any{
 	(*BindMobileReply)(nil),        // 12: api.passport.v1.BindMobileReply
 	(*UpdateMobileRequest)(nil),    // 13: api.passport.v1.UpdateMobileRequest
 	(*UpdateMobileReply)(nil),      // 14: api.passport.v1.UpdateMobileReply
-	(*ResetPasswordRequest)(nil),   // 15: api.passport.v1.ResetPasswordRequest
-	(*ResetPasswordReply)(nil),     // 16: api.passport.v1.ResetPasswordReply
+	(*UpdateNicknameRequest)(nil),  // 15: api.passport.v1.UpdateNicknameRequest
+	(*UpdateNicknameReply)(nil),    // 16: api.passport.v1.UpdateNicknameReply
+	(*ResetPasswordRequest)(nil),   // 17: api.passport.v1.ResetPasswordRequest
+	(*ResetPasswordReply)(nil),     // 18: api.passport.v1.ResetPasswordReply
 }
 var file_api_passport_v1_passport_proto_depIdxs = []int32{
 	0,  // 0: api.passport.v1.Passport.Register:input_type -> api.passport.v1.RegisterRequest
@@ -996,18 +1145,20 @@ var file_api_passport_v1_passport_proto_depIdxs = []int32{
 	9,  // 5: api.passport.v1.Passport.UpdatePassword:input_type -> api.passport.v1.UpdatePasswordRequest
 	11, // 6: api.passport.v1.Passport.BindMobile:input_type -> api.passport.v1.BindMobileRequest
 	13, // 7: api.passport.v1.Passport.UpdateMobile:input_type -> api.passport.v1.UpdateMobileRequest
-	15, // 8: api.passport.v1.Passport.ResetPassword:input_type -> api.passport.v1.ResetPasswordRequest
-	1,  // 9: api.passport.v1.Passport.Register:output_type -> api.passport.v1.RegisterReply
-	4,  // 10: api.passport.v1.Passport.LoginByPassword:output_type -> api.passport.v1.LoginReply
-	4,  // 11: api.passport.v1.Passport.LoginByOtp:output_type -> api.passport.v1.LoginReply
-	6,  // 12: api.passport.v1.Passport.Logout:output_type -> api.passport.v1.LogoutReply
-	8,  // 13: api.passport.v1.Passport.UserInfo:output_type -> api.passport.v1.UserInfoReply
-	10, // 14: api.passport.v1.Passport.UpdatePassword:output_type -> api.passport.v1.UpdatePasswordReply
-	12, // 15: api.passport.v1.Passport.BindMobile:output_type -> api.passport.v1.BindMobileReply
-	14, // 16: api.passport.v1.Passport.UpdateMobile:output_type -> api.passport.v1.UpdateMobileReply
-	16, // 17: api.passport.v1.Passport.ResetPassword:output_type -> api.passport.v1.ResetPasswordReply
-	9,  // [9:18] is the sub-list for method output_type
-	0,  // [0:9] is the sub-list for method input_type
+	15, // 8: api.passport.v1.Passport.UpdateNickname:input_type -> api.passport.v1.UpdateNicknameRequest
+	17, // 9: api.passport.v1.Passport.ResetPassword:input_type -> api.passport.v1.ResetPasswordRequest
+	1,  // 10: api.passport.v1.Passport.Register:output_type -> api.passport.v1.RegisterReply
+	4,  // 11: api.passport.v1.Passport.LoginByPassword:output_type -> api.passport.v1.LoginReply
+	4,  // 12: api.passport.v1.Passport.LoginByOtp:output_type -> api.passport.v1.LoginReply
+	6,  // 13: api.passport.v1.Passport.Logout:output_type -> api.passport.v1.LogoutReply
+	8,  // 14: api.passport.v1.Passport.UserInfo:output_type -> api.passport.v1.UserInfoReply
+	10, // 15: api.passport.v1.Passport.UpdatePassword:output_type -> api.passport.v1.UpdatePasswordReply
+	12, // 16: api.passport.v1.Passport.BindMobile:output_type -> api.passport.v1.BindMobileReply
+	14, // 17: api.passport.v1.Passport.UpdateMobile:output_type -> api.passport.v1.UpdateMobileReply
+	16, // 18: api.passport.v1.Passport.UpdateNickname:output_type -> api.passport.v1.UpdateNicknameReply
+	18, // 19: api.passport.v1.Passport.ResetPassword:output_type -> api.passport.v1.ResetPasswordReply
+	10, // [10:20] is the sub-list for method output_type
+	0,  // [0:10] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -1024,7 +1175,7 @@ func file_api_passport_v1_passport_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_passport_v1_passport_proto_rawDesc), len(file_api_passport_v1_passport_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -95,7 +95,7 @@ func (m *RegisterRequest) validate(all bool) error {
 		if !_RegisterRequest_Mobile_Pattern.MatchString(m.GetMobile()) {
 			err := RegisterRequestValidationError{
 				field:  "Mobile",
-				reason: "value does not match regex pattern \"^1[3-9]\\\\d{9}$\"",
+				reason: "value does not match regex pattern \"^\\\\+?\\\\d{5,15}$\"",
 			}
 			if !all {
 				return err
@@ -120,6 +120,21 @@ func (m *RegisterRequest) validate(all bool) error {
 
 	}
 
+	if m.GetCountryCode() != "" {
+
+		if !_RegisterRequest_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := RegisterRequestValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^\\\\+?\\\\d{1,3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return RegisterRequestMultiError(errors)
 	}
@@ -198,7 +213,9 @@ var _ interface {
 	ErrorName() string
 } = RegisterRequestValidationError{}
 
-var _RegisterRequest_Mobile_Pattern = regexp.MustCompile("^1[3-9]\\d{9}$")
+var _RegisterRequest_Mobile_Pattern = regexp.MustCompile("^\\+?\\d{5,15}$")
+
+var _RegisterRequest_CountryCode_Pattern = regexp.MustCompile("^\\+?\\d{1,3}$")
 
 // Validate checks the field values on RegisterReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -455,7 +472,7 @@ func (m *LoginByOtpRequest) validate(all bool) error {
 	if !_LoginByOtpRequest_Mobile_Pattern.MatchString(m.GetMobile()) {
 		err := LoginByOtpRequestValidationError{
 			field:  "Mobile",
-			reason: "value does not match regex pattern \"^1[3-9]\\\\d{9}$\"",
+			reason: "value does not match regex pattern \"^\\\\+?\\\\d{5,15}$\"",
 		}
 		if !all {
 			return err
@@ -474,6 +491,21 @@ func (m *LoginByOtpRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetCountryCode() != "" {
+
+		if !_LoginByOtpRequest_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := LoginByOtpRequestValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^\\\\+?\\\\d{1,3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return LoginByOtpRequestMultiError(errors)
 	}
@@ -554,7 +586,9 @@ var _ interface {
 	ErrorName() string
 } = LoginByOtpRequestValidationError{}
 
-var _LoginByOtpRequest_Mobile_Pattern = regexp.MustCompile("^1[3-9]\\d{9}$")
+var _LoginByOtpRequest_Mobile_Pattern = regexp.MustCompile("^\\+?\\d{5,15}$")
+
+var _LoginByOtpRequest_CountryCode_Pattern = regexp.MustCompile("^\\+?\\d{1,3}$")
 
 // Validate checks the field values on LoginReply with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -984,6 +1018,8 @@ func (m *UserInfoReply) validate(all bool) error {
 
 	// no validation rules for Status
 
+	// no validation rules for Nickname
+
 	if len(errors) > 0 {
 		return UserInfoReplyMultiError(errors)
 	}
@@ -1324,7 +1360,7 @@ func (m *BindMobileRequest) validate(all bool) error {
 	if !_BindMobileRequest_Mobile_Pattern.MatchString(m.GetMobile()) {
 		err := BindMobileRequestValidationError{
 			field:  "Mobile",
-			reason: "value does not match regex pattern \"^1[3-9]\\\\d{9}$\"",
+			reason: "value does not match regex pattern \"^\\\\+?\\\\d{5,15}$\"",
 		}
 		if !all {
 			return err
@@ -1343,6 +1379,21 @@ func (m *BindMobileRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetCountryCode() != "" {
+
+		if !_BindMobileRequest_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := BindMobileRequestValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^\\\\+?\\\\d{1,3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return BindMobileRequestMultiError(errors)
 	}
@@ -1423,7 +1474,9 @@ var _ interface {
 	ErrorName() string
 } = BindMobileRequestValidationError{}
 
-var _BindMobileRequest_Mobile_Pattern = regexp.MustCompile("^1[3-9]\\d{9}$")
+var _BindMobileRequest_Mobile_Pattern = regexp.MustCompile("^\\+?\\d{5,15}$")
+
+var _BindMobileRequest_CountryCode_Pattern = regexp.MustCompile("^\\+?\\d{1,3}$")
 
 // Validate checks the field values on BindMobileReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -1550,7 +1603,7 @@ func (m *UpdateMobileRequest) validate(all bool) error {
 	if !_UpdateMobileRequest_Mobile_Pattern.MatchString(m.GetMobile()) {
 		err := UpdateMobileRequestValidationError{
 			field:  "Mobile",
-			reason: "value does not match regex pattern \"^1[3-9]\\\\d{9}$\"",
+			reason: "value does not match regex pattern \"^\\\\+?\\\\d{5,15}$\"",
 		}
 		if !all {
 			return err
@@ -1569,6 +1622,21 @@ func (m *UpdateMobileRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetCountryCode() != "" {
+
+		if !_UpdateMobileRequest_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := UpdateMobileRequestValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^\\\\+?\\\\d{1,3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UpdateMobileRequestMultiError(errors)
 	}
@@ -1649,7 +1717,9 @@ var _ interface {
 	ErrorName() string
 } = UpdateMobileRequestValidationError{}
 
-var _UpdateMobileRequest_Mobile_Pattern = regexp.MustCompile("^1[3-9]\\d{9}$")
+var _UpdateMobileRequest_Mobile_Pattern = regexp.MustCompile("^\\+?\\d{5,15}$")
+
+var _UpdateMobileRequest_CountryCode_Pattern = regexp.MustCompile("^\\+?\\d{1,3}$")
 
 // Validate checks the field values on UpdateMobileReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -1753,6 +1823,221 @@ var _ interface {
 	ErrorName() string
 } = UpdateMobileReplyValidationError{}
 
+// Validate checks the field values on UpdateNicknameRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNicknameRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNicknameRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNicknameRequestMultiError, or nil if none found.
+func (m *UpdateNicknameRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNicknameRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetNickname()); l < 1 || l > 20 {
+		err := UpdateNicknameRequestValidationError{
+			field:  "Nickname",
+			reason: "value length must be between 1 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateNicknameRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNicknameRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateNicknameRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateNicknameRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNicknameRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNicknameRequestMultiError) AllErrors() []error { return m }
+
+// UpdateNicknameRequestValidationError is the validation error returned by
+// UpdateNicknameRequest.Validate if the designated constraints aren't met.
+type UpdateNicknameRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNicknameRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNicknameRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNicknameRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNicknameRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNicknameRequestValidationError) ErrorName() string {
+	return "UpdateNicknameRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNicknameRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNicknameRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNicknameRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNicknameRequestValidationError{}
+
+// Validate checks the field values on UpdateNicknameReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNicknameReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNicknameReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNicknameReplyMultiError, or nil if none found.
+func (m *UpdateNicknameReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNicknameReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateNicknameReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNicknameReplyMultiError is an error wrapping multiple validation
+// errors returned by UpdateNicknameReply.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateNicknameReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNicknameReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNicknameReplyMultiError) AllErrors() []error { return m }
+
+// UpdateNicknameReplyValidationError is the validation error returned by
+// UpdateNicknameReply.Validate if the designated constraints aren't met.
+type UpdateNicknameReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNicknameReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNicknameReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNicknameReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNicknameReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNicknameReplyValidationError) ErrorName() string {
+	return "UpdateNicknameReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNicknameReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNicknameReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNicknameReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNicknameReplyValidationError{}
+
 // Validate checks the field values on ResetPasswordRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1778,7 +2063,7 @@ func (m *ResetPasswordRequest) validate(all bool) error {
 	if !_ResetPasswordRequest_Mobile_Pattern.MatchString(m.GetMobile()) {
 		err := ResetPasswordRequestValidationError{
 			field:  "Mobile",
-			reason: "value does not match regex pattern \"^1[3-9]\\\\d{9}$\"",
+			reason: "value does not match regex pattern \"^\\\\+?\\\\d{5,15}$\"",
 		}
 		if !all {
 			return err
@@ -1819,6 +2104,21 @@ func (m *ResetPasswordRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetCountryCode() != "" {
+
+		if !_ResetPasswordRequest_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := ResetPasswordRequestValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^\\\\+?\\\\d{1,3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ResetPasswordRequestMultiError(errors)
 	}
@@ -1899,7 +2199,9 @@ var _ interface {
 	ErrorName() string
 } = ResetPasswordRequestValidationError{}
 
-var _ResetPasswordRequest_Mobile_Pattern = regexp.MustCompile("^1[3-9]\\d{9}$")
+var _ResetPasswordRequest_Mobile_Pattern = regexp.MustCompile("^\\+?\\d{5,15}$")
+
+var _ResetPasswordRequest_CountryCode_Pattern = regexp.MustCompile("^\\+?\\d{1,3}$")
 
 // Validate checks the field values on ResetPasswordReply with the rules
 // defined in the proto definition for this message. If any rules are
@@ -27,6 +27,7 @@ const (
 	Passport_UpdatePassword_FullMethodName  = "/api.passport.v1.Passport/UpdatePassword"
 	Passport_BindMobile_FullMethodName      = "/api.passport.v1.Passport/BindMobile"
 	Passport_UpdateMobile_FullMethodName    = "/api.passport.v1.Passport/UpdateMobile"
+	Passport_UpdateNickname_FullMethodName  = "/api.passport.v1.Passport/UpdateNickname"
 	Passport_ResetPassword_FullMethodName   = "/api.passport.v1.Passport/ResetPassword"
 )
 
@@ -50,6 +51,8 @@ type PassportClient interface {
 	BindMobile(ctx context.Context, in *BindMobileRequest, opts ...grpc.CallOption) (*BindMobileReply, error)
 	// 修改绑定手机号
 	UpdateMobile(ctx context.Context, in *UpdateMobileRequest, opts ...grpc.CallOption) (*UpdateMobileReply, error)
+	// 修改昵称
+	UpdateNickname(ctx context.Context, in *UpdateNicknameRequest, opts ...grpc.CallOption) (*UpdateNicknameReply, error)
 	// 找回密码
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error)
 }
@@ -142,6 +145,16 @@ func (c *passportClient) UpdateMobile(ctx context.Context, in *UpdateMobileReque
 	return out, nil
 }
 
+func (c *passportClient) UpdateNickname(ctx context.Context, in *UpdateNicknameRequest, opts ...grpc.CallOption) (*UpdateNicknameReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNicknameReply)
+	err := c.cc.Invoke(ctx, Passport_UpdateNickname_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *passportClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResetPasswordReply)
@@ -172,6 +185,8 @@ type PassportServer interface {
 	BindMobile(context.Context, *BindMobileRequest) (*BindMobileReply, error)
 	// 修改绑定手机号
 	UpdateMobile(context.Context, *UpdateMobileRequest) (*UpdateMobileReply, error)
+	// 修改昵称
+	UpdateNickname(context.Context, *UpdateNicknameRequest) (*UpdateNicknameReply, error)
 	// 找回密码
 	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
 	mustEmbedUnimplementedPassportServer()
@@ -208,6 +223,9 @@ func (UnimplementedPassportServer) BindMobile(context.Context, *BindMobileReques
 func (UnimplementedPassportServer) UpdateMobile(context.Context, *UpdateMobileRequest) (*UpdateMobileReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateMobile not implemented")
 }
+func (UnimplementedPassportServer) UpdateNickname(context.Context, *UpdateNicknameRequest) (*UpdateNicknameReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateNickname not implemented")
+}
 func (UnimplementedPassportServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResetPassword not implemented")
 }
@@ -376,6 +394,24 @@ func _Passport_UpdateMobile_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Passport_UpdateNickname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNicknameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PassportServer).UpdateNickname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passport_UpdateNickname_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PassportServer).UpdateNickname(ctx, req.(*UpdateNicknameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Passport_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResetPasswordRequest)
 	if err := dec(in); err != nil {
@@ -433,6 +469,10 @@ var Passport_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMobile",
 			Handler:    _Passport_UpdateMobile_Handler,
 		},
+		{
+			MethodName: "UpdateNickname",
+			Handler:    _Passport_UpdateNickname_Handler,
+		},
 		{
 			MethodName: "ResetPassword",
 			Handler:    _Passport_ResetPassword_Handler,
@@ -26,6 +26,7 @@ const OperationPassportLogout = "/api.passport.v1.Passport/Logout"
 const OperationPassportRegister = "/api.passport.v1.Passport/Register"
 const OperationPassportResetPassword = "/api.passport.v1.Passport/ResetPassword"
 const OperationPassportUpdateMobile = "/api.passport.v1.Passport/UpdateMobile"
+const OperationPassportUpdateNickname = "/api.passport.v1.Passport/UpdateNickname"
 const OperationPassportUpdatePassword = "/api.passport.v1.Passport/UpdatePassword"
 const OperationPassportUserInfo = "/api.passport.v1.Passport/UserInfo"
 
@@ -44,6 +45,8 @@ type PassportHTTPServer interface {
 	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordReply, error)
 	// UpdateMobile 修改绑定手机号
 	UpdateMobile(context.Context, *UpdateMobileRequest) (*UpdateMobileReply, error)
+	// UpdateNickname 修改昵称
+	UpdateNickname(context.Context, *UpdateNicknameRequest) (*UpdateNicknameReply, error)
 	// UpdatePassword 修改密码
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordReply, error)
 	// UserInfo 获取用户信息
@@ -60,6 +63,7 @@ func RegisterPassportHTTPServer(s *http.Server, srv PassportHTTPServer) {
 	r.POST("/passport/update-password", _Passport_UpdatePassword0_HTTP_Handler(srv))
 	r.POST("/passport/bind-mobile", _Passport_BindMobile0_HTTP_Handler(srv))
 	r.POST("/passport/update-mobile", _Passport_UpdateMobile0_HTTP_Handler(srv))
+	r.POST("/passport/update-nickname", _Passport_UpdateNickname0_HTTP_Handler(srv))
 	r.POST("/passport/reset-password", _Passport_ResetPassword0_HTTP_Handler(srv))
 }
 
@@ -236,6 +240,28 @@ func _Passport_UpdateMobile0_HTTP_Handler(srv PassportHTTPServer) func(ctx http.
 	}
 }
 
+func _Passport_UpdateNickname0_HTTP_Handler(srv PassportHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateNicknameRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPassportUpdateNickname)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateNickname(ctx, req.(*UpdateNicknameRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateNicknameReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Passport_ResetPassword0_HTTP_Handler(srv PassportHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ResetPasswordRequest
@@ -273,6 +299,8 @@ type PassportHTTPClient interface {
 	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordReply, err error)
 	// UpdateMobile 修改绑定手机号
 	UpdateMobile(ctx context.Context, req *UpdateMobileRequest, opts ...http.CallOption) (rsp *UpdateMobileReply, err error)
+	// UpdateNickname 修改昵称
+	UpdateNickname(ctx context.Context, req *UpdateNicknameRequest, opts ...http.CallOption) (rsp *UpdateNicknameReply, err error)
 	// UpdatePassword 修改密码
 	UpdatePassword(ctx context.Context, req *UpdatePasswordRequest, opts ...http.CallOption) (rsp *UpdatePasswordReply, err error)
 	// UserInfo 获取用户信息
@@ -385,6 +413,20 @@ func (c *PassportHTTPClientImpl) UpdateMobile(ctx context.Context, in *UpdateMob
 	return &out, nil
 }
 
+// UpdateNickname 修改昵称
+func (c *PassportHTTPClientImpl) UpdateNickname(ctx context.Context, in *UpdateNicknameRequest, opts ...http.CallOption) (*UpdateNicknameReply, error) {
+	var out UpdateNicknameReply
+	pattern := "/passport/update-nickname"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPassportUpdateNickname))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdatePassword 修改密码
 func (c *PassportHTTPClientImpl) UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...http.CallOption) (*UpdatePasswordReply, error) {
 	var out UpdatePasswordReply
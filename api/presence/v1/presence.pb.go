@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: api/presence/v1/presence.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/google/gnostic/openapiv3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PresenceInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户 ID
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	// 是否在线
+	Online bool `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	// 最后活跃时间
+	LastSeen int64 `protobuf:"varint,3,opt,name=last_seen,proto3" json:"last_seen,omitempty"`
+	// 在线设备
+	Devices       []string `protobuf:"bytes,4,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresenceInfo) Reset() {
+	*x = PresenceInfo{}
+	mi := &file_api_presence_v1_presence_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresenceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceInfo) ProtoMessage() {}
+
+func (x *PresenceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_presence_v1_presence_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceInfo.ProtoReflect.Descriptor instead.
+func (*PresenceInfo) Descriptor() ([]byte, []int) {
+	return file_api_presence_v1_presence_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PresenceInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PresenceInfo) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *PresenceInfo) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *PresenceInfo) GetDevices() []string {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type GetPresenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户 ID
+	UserIds       []int64 `protobuf:"varint,1,rep,packed,name=user_ids,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresenceRequest) Reset() {
+	*x = GetPresenceRequest{}
+	mi := &file_api_presence_v1_presence_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceRequest) ProtoMessage() {}
+
+func (x *GetPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_presence_v1_presence_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceRequest.ProtoReflect.Descriptor instead.
+func (*GetPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_api_presence_v1_presence_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPresenceRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetPresenceReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 在线状态
+	List          []*PresenceInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresenceReply) Reset() {
+	*x = GetPresenceReply{}
+	mi := &file_api_presence_v1_presence_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceReply) ProtoMessage() {}
+
+func (x *GetPresenceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_presence_v1_presence_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceReply.ProtoReflect.Descriptor instead.
+func (*GetPresenceReply) Descriptor() ([]byte, []int) {
+	return file_api_presence_v1_presence_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPresenceReply) GetList() []*PresenceInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_api_presence_v1_presence_proto protoreflect.FileDescriptor
+
+const file_api_presence_v1_presence_proto_rawDesc = "" +
+	"\n" +
+	"\x1eapi/presence/v1/presence.proto\x12\x0fapi.presence.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1copenapi/v3/annotations.proto\"\x99\x02\n" +
+	"\fPresenceInfo\x12)\n" +
+	"\auser_id\x18\x01 \x01(\x03B\x0f\xbaG\f\x92\x02\t用户 IDR\auser_id\x12*\n" +
+	"\x06online\x18\x02 \x01(\bB\x12\xbaG\x0f\x92\x02\f是否在线R\x06online\x12Y\n" +
+	"\tlast_seen\x18\x03 \x01(\x03B;\xbaG8\x92\x025最后活跃时间戳，单位秒，无记录时为 0R\tlast_seen\x12W\n" +
+	"\adevices\x18\x04 \x03(\tB=\xbaG:\x92\x027在线的设备类型，如 web、ios，离线时为空R\adevices\"Y\n" +
+	"\x12GetPresenceRequest\x12C\n" +
+	"\buser_ids\x18\x01 \x03(\x03B'\xfaB\t\x92\x01\x06\b\x01\x10d\x18\x01\xbaG\x18\x92\x02\x15待查询的用户 IDR\buser_ids\"q\n" +
+	"\x10GetPresenceReply\x12]\n" +
+	"\x04list\x18\x01 \x03(\v2\x1d.api.presence.v1.PresenceInfoB*\xbaG'\x92\x02$在线状态，顺序与请求一致R\x04list2\xcb\x03\n" +
+	"\bPresence\x12\xbe\x03\n" +
+	"\vGetPresence\x12#.api.presence.v1.GetPresenceRequest\x1a!.api.presence.v1.GetPresenceReply\"\xe6\x02\xbaG\xd1\x02\x12\x18批量查询在线状态\x1a\xb4\x02只返回自己及联系人（有单聊会话或同在一个群组的用户）的状态，其他用户不出现在结果中；用户在任一实例、任一设备上有连接即为在线；状态变化另通过 WebSocket 订阅 presence:{user_id} 房间以 presence 动作推送，订阅同样限于联系人\x82\xd3\xe4\x93\x02\v\x12\t/presenceBU\n" +
+	"\x0fapi.presence.v1P\x01Z@github.com/sober-studio/bubble-boot-go-kratos/api/presence/v1;v1b\x06proto3"
+
+var (
+	file_api_presence_v1_presence_proto_rawDescOnce sync.Once
+	file_api_presence_v1_presence_proto_rawDescData []byte
+)
+
+func file_api_presence_v1_presence_proto_rawDescGZIP() []byte {
+	file_api_presence_v1_presence_proto_rawDescOnce.Do(func() {
+		file_api_presence_v1_presence_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_presence_v1_presence_proto_rawDesc), len(file_api_presence_v1_presence_proto_rawDesc)))
+	})
+	return file_api_presence_v1_presence_proto_rawDescData
+}
+
+var file_api_presence_v1_presence_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_presence_v1_presence_proto_goTypes = []any{
+	(*PresenceInfo)(nil),       // 0: api.presence.v1.PresenceInfo
+	(*GetPresenceRequest)(nil), // 1: api.presence.v1.GetPresenceRequest
+	(*GetPresenceReply)(nil),   // 2: api.presence.v1.GetPresenceReply
+}
+var file_api_presence_v1_presence_proto_depIdxs = []int32{
+	0, // 0: api.presence.v1.GetPresenceReply.list:type_name -> api.presence.v1.PresenceInfo
+	1, // 1: api.presence.v1.Presence.GetPresence:input_type -> api.presence.v1.GetPresenceRequest
+	2, // 2: api.presence.v1.Presence.GetPresence:output_type -> api.presence.v1.GetPresenceReply
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_presence_v1_presence_proto_init() }
+func file_api_presence_v1_presence_proto_init() {
+	if File_api_presence_v1_presence_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_presence_v1_presence_proto_rawDesc), len(file_api_presence_v1_presence_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_presence_v1_presence_proto_goTypes,
+		DependencyIndexes: file_api_presence_v1_presence_proto_depIdxs,
+		MessageInfos:      file_api_presence_v1_presence_proto_msgTypes,
+	}.Build()
+	File_api_presence_v1_presence_proto = out.File
+	file_api_presence_v1_presence_proto_goTypes = nil
+	file_api_presence_v1_presence_proto_depIdxs = nil
+}
@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/presence/v1/presence.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PresenceInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PresenceInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresenceInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PresenceInfoMultiError, or
+// nil if none found.
+func (m *PresenceInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresenceInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Online
+
+	// no validation rules for LastSeen
+
+	if len(errors) > 0 {
+		return PresenceInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresenceInfoMultiError is an error wrapping multiple validation errors
+// returned by PresenceInfo.ValidateAll() if the designated constraints aren't met.
+type PresenceInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresenceInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresenceInfoMultiError) AllErrors() []error { return m }
+
+// PresenceInfoValidationError is the validation error returned by
+// PresenceInfo.Validate if the designated constraints aren't met.
+type PresenceInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresenceInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresenceInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresenceInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresenceInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresenceInfoValidationError) ErrorName() string { return "PresenceInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PresenceInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresenceInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresenceInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresenceInfoValidationError{}
+
+// Validate checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceRequestMultiError, or nil if none found.
+func (m *GetPresenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUserIds()); l < 1 || l > 100 {
+		err := GetPresenceRequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_GetPresenceRequest_UserIds_Unique := make(map[int64]struct{}, len(m.GetUserIds()))
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if _, exists := _GetPresenceRequest_UserIds_Unique[item]; exists {
+			err := GetPresenceRequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GetPresenceRequest_UserIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for UserIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return GetPresenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPresenceRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPresenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceRequestMultiError) AllErrors() []error { return m }
+
+// GetPresenceRequestValidationError is the validation error returned by
+// GetPresenceRequest.Validate if the designated constraints aren't met.
+type GetPresenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceRequestValidationError) ErrorName() string {
+	return "GetPresenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPresenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceRequestValidationError{}
+
+// Validate checks the field values on GetPresenceReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPresenceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPresenceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPresenceReplyMultiError, or nil if none found.
+func (m *GetPresenceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPresenceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetPresenceReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetPresenceReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetPresenceReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetPresenceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPresenceReplyMultiError is an error wrapping multiple validation errors
+// returned by GetPresenceReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetPresenceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPresenceReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPresenceReplyMultiError) AllErrors() []error { return m }
+
+// GetPresenceReplyValidationError is the validation error returned by
+// GetPresenceReply.Validate if the designated constraints aren't met.
+type GetPresenceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPresenceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPresenceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPresenceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPresenceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPresenceReplyValidationError) ErrorName() string { return "GetPresenceReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetPresenceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPresenceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPresenceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPresenceReplyValidationError{}
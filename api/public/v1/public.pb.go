@@ -88,7 +88,9 @@ func (SmsOtpScene) EnumDescriptor() ([]byte, []int) {
 
 // ========== 获取图形验证码 ==========
 type GetCaptchaRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 验证码场景
+	Scene         string `protobuf:"bytes,1,opt,name=scene,proto3" json:"scene,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -123,12 +125,25 @@ func (*GetCaptchaRequest) Descriptor() ([]byte, []int) {
 	return file_api_public_v1_public_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *GetCaptchaRequest) GetScene() string {
+	if x != nil {
+		return x.Scene
+	}
+	return ""
+}
+
 type GetCaptchaReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 验证码ID
 	CaptchaId string `protobuf:"bytes,1,opt,name=captcha_id,proto3" json:"captcha_id,omitempty"`
 	// Base64编码的图片
-	ImageB64      string `protobuf:"bytes,2,opt,name=image_b64,proto3" json:"image_b64,omitempty"`
+	ImageB64 string `protobuf:"bytes,2,opt,name=image_b64,proto3" json:"image_b64,omitempty"`
+	// 验证码类型
+	Driver string `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	// 滑块拼图块
+	PieceB64 string `protobuf:"bytes,4,opt,name=piece_b64,proto3" json:"piece_b64,omitempty"`
+	// 滑块拼图块纵坐标
+	PieceY        int32 `protobuf:"varint,5,opt,name=piece_y,proto3" json:"piece_y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -177,23 +192,164 @@ func (x *GetCaptchaReply) GetImageB64() string {
 	return ""
 }
 
+func (x *GetCaptchaReply) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *GetCaptchaReply) GetPieceB64() string {
+	if x != nil {
+		return x.PieceB64
+	}
+	return ""
+}
+
+func (x *GetCaptchaReply) GetPieceY() int32 {
+	if x != nil {
+		return x.PieceY
+	}
+	return 0
+}
+
+// ========== 风险评估 ==========
+type CheckRiskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 操作类型
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// 账号
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// 国际区号，选填
+	CountryCode   string `protobuf:"bytes,3,opt,name=country_code,proto3" json:"country_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRiskRequest) Reset() {
+	*x = CheckRiskRequest{}
+	mi := &file_api_public_v1_public_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRiskRequest) ProtoMessage() {}
+
+func (x *CheckRiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_public_v1_public_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRiskRequest.ProtoReflect.Descriptor instead.
+func (*CheckRiskRequest) Descriptor() ([]byte, []int) {
+	return file_api_public_v1_public_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CheckRiskRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *CheckRiskRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *CheckRiskRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type CheckRiskReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否需要图形验证码
+	CaptchaRequired bool `protobuf:"varint,1,opt,name=captcha_required,proto3" json:"captcha_required,omitempty"`
+	// 验证码场景
+	CaptchaScene  string `protobuf:"bytes,2,opt,name=captcha_scene,proto3" json:"captcha_scene,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRiskReply) Reset() {
+	*x = CheckRiskReply{}
+	mi := &file_api_public_v1_public_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRiskReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRiskReply) ProtoMessage() {}
+
+func (x *CheckRiskReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_public_v1_public_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRiskReply.ProtoReflect.Descriptor instead.
+func (*CheckRiskReply) Descriptor() ([]byte, []int) {
+	return file_api_public_v1_public_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckRiskReply) GetCaptchaRequired() bool {
+	if x != nil {
+		return x.CaptchaRequired
+	}
+	return false
+}
+
+func (x *CheckRiskReply) GetCaptchaScene() string {
+	if x != nil {
+		return x.CaptchaScene
+	}
+	return ""
+}
+
 type SendSmsOtpRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 手机号，规则：11位数字
+	// 手机号，规则：5-15位数字，可带 + 国际区号
 	Mobile string `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
-	// 图形验证码ID
+	// 图形验证码ID，高风险时必填
 	CaptchaId string `protobuf:"bytes,2,opt,name=captcha_id,proto3" json:"captcha_id,omitempty"`
-	// 图形验证码
+	// 图形验证码，高风险时必填
 	Captcha string `protobuf:"bytes,3,opt,name=captcha,proto3" json:"captcha,omitempty"`
 	// 验证码场景
-	Scene         SmsOtpScene `protobuf:"varint,4,opt,name=scene,proto3,enum=api.public.v1.SmsOtpScene" json:"scene,omitempty"`
+	Scene SmsOtpScene `protobuf:"varint,4,opt,name=scene,proto3,enum=api.public.v1.SmsOtpScene" json:"scene,omitempty"`
+	// 国际区号，选填
+	CountryCode   string `protobuf:"bytes,5,opt,name=country_code,proto3" json:"country_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendSmsOtpRequest) Reset() {
 	*x = SendSmsOtpRequest{}
-	mi := &file_api_public_v1_public_proto_msgTypes[2]
+	mi := &file_api_public_v1_public_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -205,7 +361,7 @@ func (x *SendSmsOtpRequest) String() string {
 func (*SendSmsOtpRequest) ProtoMessage() {}
 
 func (x *SendSmsOtpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_public_v1_public_proto_msgTypes[2]
+	mi := &file_api_public_v1_public_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -218,7 +374,7 @@ func (x *SendSmsOtpRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendSmsOtpRequest.ProtoReflect.Descriptor instead.
 func (*SendSmsOtpRequest) Descriptor() ([]byte, []int) {
-	return file_api_public_v1_public_proto_rawDescGZIP(), []int{2}
+	return file_api_public_v1_public_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SendSmsOtpRequest) GetMobile() string {
@@ -249,6 +405,13 @@ func (x *SendSmsOtpRequest) GetScene() SmsOtpScene {
 	return SmsOtpScene_UNSPECIFIED
 }
 
+func (x *SendSmsOtpRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
 type SendSmsOtpReply struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 验证码过期时间戳（秒）
@@ -259,7 +422,7 @@ type SendSmsOtpReply struct {
 
 func (x *SendSmsOtpReply) Reset() {
 	*x = SendSmsOtpReply{}
-	mi := &file_api_public_v1_public_proto_msgTypes[3]
+	mi := &file_api_public_v1_public_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -271,7 +434,7 @@ func (x *SendSmsOtpReply) String() string {
 func (*SendSmsOtpReply) ProtoMessage() {}
 
 func (x *SendSmsOtpReply) ProtoReflect() protoreflect.Message {
-	mi := &file_api_public_v1_public_proto_msgTypes[3]
+	mi := &file_api_public_v1_public_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -284,7 +447,7 @@ func (x *SendSmsOtpReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendSmsOtpReply.ProtoReflect.Descriptor instead.
 func (*SendSmsOtpReply) Descriptor() ([]byte, []int) {
-	return file_api_public_v1_public_proto_rawDescGZIP(), []int{3}
+	return file_api_public_v1_public_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SendSmsOtpReply) GetExpireAt() int64 {
@@ -298,20 +461,32 @@ var File_api_public_v1_public_proto protoreflect.FileDescriptor
 
 const file_api_public_v1_public_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/public/v1/public.proto\x12\rapi.public.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1copenapi/v3/annotations.proto\"\x13\n" +
-	"\x11GetCaptchaRequest\"y\n" +
+	"\x1aapi/public/v1/public.proto\x12\rapi.public.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1copenapi/v3/annotations.proto\"\xb4\x01\n" +
+	"\x11GetCaptchaRequest\x12\x9e\x01\n" +
+	"\x05scene\x18\x01 \x01(\tB\x87\x01\xe2A\x01\x01\xfaB\x12r\x102\x0e^[a-z_]{0,32}$\xbaGk\x92\x02h验证码使用场景：login=密码登录，sms_otp=获取短信验证码，为空时使用默认样式R\x05scene\"\xe0\x03\n" +
 	"\x0fGetCaptchaReply\x121\n" +
 	"\n" +
 	"captcha_id\x18\x01 \x01(\tB\x11\xbaG\x0e\x92\x02\v验证码idR\n" +
 	"captcha_id\x123\n" +
-	"\timage_b64\x18\x02 \x01(\tB\x15\xbaG\x12\x92\x02\x0f验证码内容R\timage_b64\"\xd9\x02\n" +
-	"\x11SendSmsOtpRequest\x12M\n" +
-	"\x06mobile\x18\x01 \x01(\tB5\xe2A\x01\x02\xfaB\x11r\x0f2\r^1[3-9]\\d{9}$\xbaG\x1a\x92\x02\x17手机号，11位数字R\x06mobile\x12;\n" +
+	"\timage_b64\x18\x02 \x01(\tB\x15\xbaG\x12\x92\x02\x0f验证码内容R\timage_b64\x12\xa9\x01\n" +
+	"\x06driver\x18\x03 \x01(\tB\x90\x01\xbaG\x8c\x01\x92\x02\x88\x01验证码类型：digit/string/math/chinese 为图片，audio 为音频，slider 为滑块拼图（image_b64 为带缺口的背景图）R\x06driver\x12U\n" +
+	"\tpiece_b64\x18\x04 \x01(\tB7\xbaG4\x92\x021滑块验证码：Base64 编码的拼图块图片R\tpiece_b64\x12b\n" +
+	"\apiece_y\x18\x05 \x01(\x05BH\xbaGE\x92\x02B滑块验证码：拼图块在背景图中的纵坐标（像素）R\apiece_y\"\x81\x03\n" +
+	"\x10CheckRiskRequest\x12x\n" +
+	"\x06action\x18\x01 \x01(\tB`\xe2A\x01\x02\xfaB\x12r\x10R\x05loginR\asms_otp\xbaGD\x92\x02A操作类型：login=密码登录，sms_otp=获取短信验证码R\x06action\x12r\n" +
+	"\aaccount\x18\x02 \x01(\tBX\xe2A\x01\x01\xfaB\x04r\x02\x18 \xbaGJ\x92\x02G账号：login 为用户名或手机号，sms_otp 为手机号，选填R\aaccount\x12\x7f\n" +
+	"\fcountry_code\x18\x03 \x01(\tB[\xe2A\x01\x01\xfaB\x13r\x112\f^\\+?\\d{1,3}$\xd0\x01\x01\xbaG>\x92\x02;国际区号，如 86、852，account 为手机号时选填R\fcountry_code\"\xeb\x01\n" +
+	"\x0eCheckRiskReply\x12\x83\x01\n" +
+	"\x10captcha_required\x18\x01 \x01(\bBW\xbaGT\x92\x02Q是否需要图形验证码，为 true 时需先获取验证码并随请求提交R\x10captcha_required\x12S\n" +
+	"\rcaptcha_scene\x18\x02 \x01(\tB-\xbaG*\x92\x02'获取图形验证码时使用的场景R\rcaptcha_scene\"\x91\x06\n" +
+	"\x11SendSmsOtpRequest\x12\x9d\x01\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x84\x01\xe2A\x01\x02\xfaB\x11r\x0f2\r^\\+?\\d{5,15}$\xbaGi\x92\x02f手机号，可带国际区号如 +85291234567，未带区号时按 country_code 或默认地区解析R\x06mobile\x12\x85\x01\n" +
 	"\n" +
-	"captcha_id\x18\x02 \x01(\tB\x1b\xe2A\x01\x02\xbaG\x14\x92\x02\x11图形验证码IDR\n" +
-	"captcha_id\x129\n" +
-	"\acaptcha\x18\x03 \x01(\tB\x1f\xe2A\x01\x02\xbaG\x18\x92\x02\x15图形验证码内容R\acaptcha\x12}\n" +
-	"\x05scene\x18\x04 \x01(\x0e2\x1a.api.public.v1.SmsOtpSceneBK\xe2A\x01\x02\xfaB\a\x82\x01\x04\x10\x01 \x00\xbaG:\x92\x027短信验证码业务场景：REGISTER/LOGIN/BIND/RESETR\x05scene\"[\n" +
+	"captcha_id\x18\x02 \x01(\tBe\xe2A\x01\x01\xbaG^\x92\x02[图形验证码ID，风险评估要求验证码时必填（错误原因 CAPTCHA_REQUIRED）R\n" +
+	"captcha_id\x12\xcb\x01\n" +
+	"\acaptcha\x18\x03 \x01(\tB\xb0\x01\xe2A\x01\x01\xbaG\xa8\x01\x92\x02\xa4\x01图形验证码内容，风险评估要求验证码时必填；滑块验证码为 JSON：{\"x\":缺口横坐标,\"duration\":拖动毫秒数,\"track\":[[x,y,毫秒],...]}R\acaptcha\x12}\n" +
+	"\x05scene\x18\x04 \x01(\x0e2\x1a.api.public.v1.SmsOtpSceneBK\xe2A\x01\x02\xfaB\a\x82\x01\x04\x10\x01 \x00\xbaG:\x92\x027短信验证码业务场景：REGISTER/LOGIN/BIND/RESETR\x05scene\x12\x86\x01\n" +
+	"\fcountry_code\x18\x05 \x01(\tBb\xe2A\x01\x01\xfaB\x13r\x112\f^\\+?\\d{1,3}$\xd0\x01\x01\xbaGE\x92\x02B国际区号，如 86、852，选填，为空时使用默认地区R\fcountry_code\"[\n" +
 	"\x0fSendSmsOtpReply\x12H\n" +
 	"\texpire_at\x18\x01 \x01(\x03B*\xbaG'\x92\x02$验证码过期时间戳，单位秒R\texpire_at*L\n" +
 	"\vSmsOtpScene\x12\x0f\n" +
@@ -319,10 +494,11 @@ const file_api_public_v1_public_proto_rawDesc = "" +
 	"\bREGISTER\x10\x01\x12\t\n" +
 	"\x05LOGIN\x10\x02\x12\b\n" +
 	"\x04BIND\x10\x03\x12\t\n" +
-	"\x05RESET\x10\x042\x93\x02\n" +
+	"\x05RESET\x10\x042\xfa\x03\n" +
 	"\x06Public\x12\x81\x01\n" +
 	"\n" +
-	"GetCaptcha\x12 .api.public.v1.GetCaptchaRequest\x1a\x1e.api.public.v1.GetCaptchaReply\"1\xbaG\x17\x12\x15获取图形验证码\x82\xd3\xe4\x93\x02\x11\x12\x0f/public/captcha\x12\x84\x01\n" +
+	"GetCaptcha\x12 .api.public.v1.GetCaptchaRequest\x1a\x1e.api.public.v1.GetCaptchaReply\"1\xbaG\x17\x12\x15获取图形验证码\x82\xd3\xe4\x93\x02\x11\x12\x0f/public/captcha\x12\xe4\x01\n" +
+	"\tCheckRisk\x12\x1f.api.public.v1.CheckRiskRequest\x1a\x1d.api.public.v1.CheckRiskReply\"\x96\x01\xbaGv\x12\f风险评估\x1af在展示登录或获取短信验证码表单前调用，判断本次操作是否需要图形验证码\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/public/risk/check\x12\x84\x01\n" +
 	"\n" +
 	"SendSmsOtp\x12 .api.public.v1.SendSmsOtpRequest\x1a\x1e.api.public.v1.SendSmsOtpReply\"4\xbaG\x17\x12\x15获取短信验证码\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/public/otp/smsBQ\n" +
 	"\rapi.public.v1P\x01Z>github.com/sober-studio/bubble-boot-go-kratos/api/public/v1;v1b\x06proto3"
@@ -340,22 +516,26 @@ func file_api_public_v1_public_proto_rawDescGZIP() []byte {
 }
 
 var file_api_public_v1_public_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_public_v1_public_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_public_v1_public_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_api_public_v1_public_proto_goTypes = []any{
 	(SmsOtpScene)(0),          // 0: api.public.v1.SmsOtpScene
 	(*GetCaptchaRequest)(nil), // 1: api.public.v1.GetCaptchaRequest
 	(*GetCaptchaReply)(nil),   // 2: api.public.v1.GetCaptchaReply
-	(*SendSmsOtpRequest)(nil), // 3: api.public.v1.SendSmsOtpRequest
-	(*SendSmsOtpReply)(nil),   // 4: api.public.v1.SendSmsOtpReply
+	(*CheckRiskRequest)(nil),  // 3: api.public.v1.CheckRiskRequest
+	(*CheckRiskReply)(nil),    // 4: api.public.v1.CheckRiskReply
+	(*SendSmsOtpRequest)(nil), // 5: api.public.v1.SendSmsOtpRequest
+	(*SendSmsOtpReply)(nil),   // 6: api.public.v1.SendSmsOtpReply
 }
 var file_api_public_v1_public_proto_depIdxs = []int32{
 	0, // 0: api.public.v1.SendSmsOtpRequest.scene:type_name -> api.public.v1.SmsOtpScene
 	1, // 1: api.public.v1.Public.GetCaptcha:input_type -> api.public.v1.GetCaptchaRequest
-	3, // 2: api.public.v1.Public.SendSmsOtp:input_type -> api.public.v1.SendSmsOtpRequest
-	2, // 3: api.public.v1.Public.GetCaptcha:output_type -> api.public.v1.GetCaptchaReply
-	4, // 4: api.public.v1.Public.SendSmsOtp:output_type -> api.public.v1.SendSmsOtpReply
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
+	3, // 2: api.public.v1.Public.CheckRisk:input_type -> api.public.v1.CheckRiskRequest
+	5, // 3: api.public.v1.Public.SendSmsOtp:input_type -> api.public.v1.SendSmsOtpRequest
+	2, // 4: api.public.v1.Public.GetCaptcha:output_type -> api.public.v1.GetCaptchaReply
+	4, // 5: api.public.v1.Public.CheckRisk:output_type -> api.public.v1.CheckRiskReply
+	6, // 6: api.public.v1.Public.SendSmsOtp:output_type -> api.public.v1.SendSmsOtpReply
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
 	1, // [1:1] is the sub-list for extension extendee
 	0, // [0:1] is the sub-list for field type_name
@@ -372,7 +552,7 @@ func file_api_public_v1_public_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_public_v1_public_proto_rawDesc), len(file_api_public_v1_public_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
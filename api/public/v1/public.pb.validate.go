@@ -57,6 +57,17 @@ func (m *GetCaptchaRequest) validate(all bool) error {
 
 	var errors []error
 
+	if !_GetCaptchaRequest_Scene_Pattern.MatchString(m.GetScene()) {
+		err := GetCaptchaRequestValidationError{
+			field:  "Scene",
+			reason: "value does not match regex pattern \"^[a-z_]{0,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return GetCaptchaRequestMultiError(errors)
 	}
@@ -137,6 +148,8 @@ var _ interface {
 	ErrorName() string
 } = GetCaptchaRequestValidationError{}
 
+var _GetCaptchaRequest_Scene_Pattern = regexp.MustCompile("^[a-z_]{0,32}$")
+
 // Validate checks the field values on GetCaptchaReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -163,6 +176,12 @@ func (m *GetCaptchaReply) validate(all bool) error {
 
 	// no validation rules for ImageB64
 
+	// no validation rules for Driver
+
+	// no validation rules for PieceB64
+
+	// no validation rules for PieceY
+
 	if len(errors) > 0 {
 		return GetCaptchaReplyMultiError(errors)
 	}
@@ -241,6 +260,254 @@ var _ interface {
 	ErrorName() string
 } = GetCaptchaReplyValidationError{}
 
+// Validate checks the field values on CheckRiskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckRiskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckRiskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckRiskRequestMultiError, or nil if none found.
+func (m *CheckRiskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckRiskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _CheckRiskRequest_Action_InLookup[m.GetAction()]; !ok {
+		err := CheckRiskRequestValidationError{
+			field:  "Action",
+			reason: "value must be in list [login sms_otp]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAccount()) > 32 {
+		err := CheckRiskRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCountryCode() != "" {
+
+		if !_CheckRiskRequest_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := CheckRiskRequestValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^\\\\+?\\\\d{1,3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckRiskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckRiskRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckRiskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckRiskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckRiskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckRiskRequestMultiError) AllErrors() []error { return m }
+
+// CheckRiskRequestValidationError is the validation error returned by
+// CheckRiskRequest.Validate if the designated constraints aren't met.
+type CheckRiskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckRiskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckRiskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckRiskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckRiskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckRiskRequestValidationError) ErrorName() string { return "CheckRiskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckRiskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckRiskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckRiskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckRiskRequestValidationError{}
+
+var _CheckRiskRequest_Action_InLookup = map[string]struct{}{
+	"login":   {},
+	"sms_otp": {},
+}
+
+var _CheckRiskRequest_CountryCode_Pattern = regexp.MustCompile("^\\+?\\d{1,3}$")
+
+// Validate checks the field values on CheckRiskReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckRiskReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckRiskReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckRiskReplyMultiError,
+// or nil if none found.
+func (m *CheckRiskReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckRiskReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CaptchaRequired
+
+	// no validation rules for CaptchaScene
+
+	if len(errors) > 0 {
+		return CheckRiskReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckRiskReplyMultiError is an error wrapping multiple validation errors
+// returned by CheckRiskReply.ValidateAll() if the designated constraints
+// aren't met.
+type CheckRiskReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckRiskReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckRiskReplyMultiError) AllErrors() []error { return m }
+
+// CheckRiskReplyValidationError is the validation error returned by
+// CheckRiskReply.Validate if the designated constraints aren't met.
+type CheckRiskReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckRiskReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckRiskReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckRiskReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckRiskReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckRiskReplyValidationError) ErrorName() string { return "CheckRiskReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckRiskReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckRiskReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckRiskReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckRiskReplyValidationError{}
+
 // Validate checks the field values on SendSmsOtpRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -266,7 +533,7 @@ func (m *SendSmsOtpRequest) validate(all bool) error {
 	if !_SendSmsOtpRequest_Mobile_Pattern.MatchString(m.GetMobile()) {
 		err := SendSmsOtpRequestValidationError{
 			field:  "Mobile",
-			reason: "value does not match regex pattern \"^1[3-9]\\\\d{9}$\"",
+			reason: "value does not match regex pattern \"^\\\\+?\\\\d{5,15}$\"",
 		}
 		if !all {
 			return err
@@ -300,6 +567,21 @@ func (m *SendSmsOtpRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetCountryCode() != "" {
+
+		if !_SendSmsOtpRequest_CountryCode_Pattern.MatchString(m.GetCountryCode()) {
+			err := SendSmsOtpRequestValidationError{
+				field:  "CountryCode",
+				reason: "value does not match regex pattern \"^\\\\+?\\\\d{1,3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SendSmsOtpRequestMultiError(errors)
 	}
@@ -380,12 +662,14 @@ var _ interface {
 	ErrorName() string
 } = SendSmsOtpRequestValidationError{}
 
-var _SendSmsOtpRequest_Mobile_Pattern = regexp.MustCompile("^1[3-9]\\d{9}$")
+var _SendSmsOtpRequest_Mobile_Pattern = regexp.MustCompile("^\\+?\\d{5,15}$")
 
 var _SendSmsOtpRequest_Scene_NotInLookup = map[SmsOtpScene]struct{}{
 	0: {},
 }
 
+var _SendSmsOtpRequest_CountryCode_Pattern = regexp.MustCompile("^\\+?\\d{1,3}$")
+
 // Validate checks the field values on SendSmsOtpReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
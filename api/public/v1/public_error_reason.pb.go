@@ -37,6 +37,8 @@ const (
 	PublicErrorReason_SMS_SENDER_ERROR PublicErrorReason = 4
 	// 短信验证码错误
 	PublicErrorReason_SMS_CODE_ERROR PublicErrorReason = 5
+	// 风险较高，需要图形验证码
+	PublicErrorReason_CAPTCHA_REQUIRED PublicErrorReason = 6
 )
 
 // Enum value maps for PublicErrorReason.
@@ -48,6 +50,7 @@ var (
 		3: "SMS_SEND_TOO_FREQUENT",
 		4: "SMS_SENDER_ERROR",
 		5: "SMS_CODE_ERROR",
+		6: "CAPTCHA_REQUIRED",
 	}
 	PublicErrorReason_value = map[string]int32{
 		"IMAGE_CAPTCHA_GENERATE_FAILED": 0,
@@ -56,6 +59,7 @@ var (
 		"SMS_SEND_TOO_FREQUENT":         3,
 		"SMS_SENDER_ERROR":              4,
 		"SMS_CODE_ERROR":                5,
+		"CAPTCHA_REQUIRED":              6,
 	}
 )
 
@@ -90,14 +94,15 @@ var File_api_public_v1_public_error_reason_proto protoreflect.FileDescriptor
 
 const file_api_public_v1_public_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"'api/public/v1/public_error_reason.proto\x12\rapi.public.v1\x1a\x13errors/errors.proto*\xb9\x01\n" +
+	"'api/public/v1/public_error_reason.proto\x12\rapi.public.v1\x1a\x13errors/errors.proto*\xcf\x01\n" +
 	"\x11PublicErrorReason\x12!\n" +
 	"\x1dIMAGE_CAPTCHA_GENERATE_FAILED\x10\x00\x12\x1f\n" +
 	"\x1bIMAGE_CAPTCHA_VERIFY_FAILED\x10\x01\x12\x15\n" +
 	"\x11SMS_SCENE_INVALID\x10\x02\x12\x19\n" +
 	"\x15SMS_SEND_TOO_FREQUENT\x10\x03\x12\x14\n" +
 	"\x10SMS_SENDER_ERROR\x10\x04\x12\x12\n" +
-	"\x0eSMS_CODE_ERROR\x10\x05\x1a\x04\xa0E\x90\x03BQ\n" +
+	"\x0eSMS_CODE_ERROR\x10\x05\x12\x14\n" +
+	"\x10CAPTCHA_REQUIRED\x10\x06\x1a\x04\xa0E\x90\x03BQ\n" +
 	"\rapi.public.v1P\x01Z>github.com/sober-studio/bubble-boot-go-kratos/api/public/v1;v1b\x06proto3"
 
 var (
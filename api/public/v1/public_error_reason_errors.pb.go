@@ -94,3 +94,17 @@ func IsSmsCodeError(err error) bool {
 func ErrorSmsCodeError(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, PublicErrorReason_SMS_CODE_ERROR.String(), fmt.Sprintf(format, args...))
 }
+
+// 风险较高，需要图形验证码
+func IsCaptchaRequired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == PublicErrorReason_CAPTCHA_REQUIRED.String() && e.Code == 400
+}
+
+// 风险较高，需要图形验证码
+func ErrorCaptchaRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, PublicErrorReason_CAPTCHA_REQUIRED.String(), fmt.Sprintf(format, args...))
+}
@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	Public_GetCaptcha_FullMethodName = "/api.public.v1.Public/GetCaptcha"
+	Public_CheckRisk_FullMethodName  = "/api.public.v1.Public/CheckRisk"
 	Public_SendSmsOtp_FullMethodName = "/api.public.v1.Public/SendSmsOtp"
 )
 
@@ -29,6 +30,8 @@ const (
 type PublicClient interface {
 	// 获取图形验证码
 	GetCaptcha(ctx context.Context, in *GetCaptchaRequest, opts ...grpc.CallOption) (*GetCaptchaReply, error)
+	// 风险评估：是否需要图形验证码
+	CheckRisk(ctx context.Context, in *CheckRiskRequest, opts ...grpc.CallOption) (*CheckRiskReply, error)
 	// 获取短信验证码
 	SendSmsOtp(ctx context.Context, in *SendSmsOtpRequest, opts ...grpc.CallOption) (*SendSmsOtpReply, error)
 }
@@ -51,6 +54,16 @@ func (c *publicClient) GetCaptcha(ctx context.Context, in *GetCaptchaRequest, op
 	return out, nil
 }
 
+func (c *publicClient) CheckRisk(ctx context.Context, in *CheckRiskRequest, opts ...grpc.CallOption) (*CheckRiskReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckRiskReply)
+	err := c.cc.Invoke(ctx, Public_CheckRisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicClient) SendSmsOtp(ctx context.Context, in *SendSmsOtpRequest, opts ...grpc.CallOption) (*SendSmsOtpReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SendSmsOtpReply)
@@ -67,6 +80,8 @@ func (c *publicClient) SendSmsOtp(ctx context.Context, in *SendSmsOtpRequest, op
 type PublicServer interface {
 	// 获取图形验证码
 	GetCaptcha(context.Context, *GetCaptchaRequest) (*GetCaptchaReply, error)
+	// 风险评估：是否需要图形验证码
+	CheckRisk(context.Context, *CheckRiskRequest) (*CheckRiskReply, error)
 	// 获取短信验证码
 	SendSmsOtp(context.Context, *SendSmsOtpRequest) (*SendSmsOtpReply, error)
 	mustEmbedUnimplementedPublicServer()
@@ -82,6 +97,9 @@ type UnimplementedPublicServer struct{}
 func (UnimplementedPublicServer) GetCaptcha(context.Context, *GetCaptchaRequest) (*GetCaptchaReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCaptcha not implemented")
 }
+func (UnimplementedPublicServer) CheckRisk(context.Context, *CheckRiskRequest) (*CheckRiskReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckRisk not implemented")
+}
 func (UnimplementedPublicServer) SendSmsOtp(context.Context, *SendSmsOtpRequest) (*SendSmsOtpReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendSmsOtp not implemented")
 }
@@ -124,6 +142,24 @@ func _Public_GetCaptcha_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Public_CheckRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicServer).CheckRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Public_CheckRisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicServer).CheckRisk(ctx, req.(*CheckRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Public_SendSmsOtp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendSmsOtpRequest)
 	if err := dec(in); err != nil {
@@ -153,6 +189,10 @@ var Public_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCaptcha",
 			Handler:    _Public_GetCaptcha_Handler,
 		},
+		{
+			MethodName: "CheckRisk",
+			Handler:    _Public_CheckRisk_Handler,
+		},
 		{
 			MethodName: "SendSmsOtp",
 			Handler:    _Public_SendSmsOtp_Handler,
@@ -19,10 +19,13 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationPublicCheckRisk = "/api.public.v1.Public/CheckRisk"
 const OperationPublicGetCaptcha = "/api.public.v1.Public/GetCaptcha"
 const OperationPublicSendSmsOtp = "/api.public.v1.Public/SendSmsOtp"
 
 type PublicHTTPServer interface {
+	// CheckRisk 风险评估：是否需要图形验证码
+	CheckRisk(context.Context, *CheckRiskRequest) (*CheckRiskReply, error)
 	// GetCaptcha 获取图形验证码
 	GetCaptcha(context.Context, *GetCaptchaRequest) (*GetCaptchaReply, error)
 	// SendSmsOtp 获取短信验证码
@@ -32,6 +35,7 @@ type PublicHTTPServer interface {
 func RegisterPublicHTTPServer(s *http.Server, srv PublicHTTPServer) {
 	r := s.Route("/")
 	r.GET("/public/captcha", _Public_GetCaptcha0_HTTP_Handler(srv))
+	r.POST("/public/risk/check", _Public_CheckRisk0_HTTP_Handler(srv))
 	r.POST("/public/otp/sms", _Public_SendSmsOtp0_HTTP_Handler(srv))
 }
 
@@ -54,6 +58,28 @@ func _Public_GetCaptcha0_HTTP_Handler(srv PublicHTTPServer) func(ctx http.Contex
 	}
 }
 
+func _Public_CheckRisk0_HTTP_Handler(srv PublicHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckRiskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPublicCheckRisk)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckRisk(ctx, req.(*CheckRiskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckRiskReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Public_SendSmsOtp0_HTTP_Handler(srv PublicHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SendSmsOtpRequest
@@ -77,6 +103,8 @@ func _Public_SendSmsOtp0_HTTP_Handler(srv PublicHTTPServer) func(ctx http.Contex
 }
 
 type PublicHTTPClient interface {
+	// CheckRisk 风险评估：是否需要图形验证码
+	CheckRisk(ctx context.Context, req *CheckRiskRequest, opts ...http.CallOption) (rsp *CheckRiskReply, err error)
 	// GetCaptcha 获取图形验证码
 	GetCaptcha(ctx context.Context, req *GetCaptchaRequest, opts ...http.CallOption) (rsp *GetCaptchaReply, err error)
 	// SendSmsOtp 获取短信验证码
@@ -91,6 +119,20 @@ func NewPublicHTTPClient(client *http.Client) PublicHTTPClient {
 	return &PublicHTTPClientImpl{client}
 }
 
+// CheckRisk 风险评估：是否需要图形验证码
+func (c *PublicHTTPClientImpl) CheckRisk(ctx context.Context, in *CheckRiskRequest, opts ...http.CallOption) (*CheckRiskReply, error) {
+	var out CheckRiskReply
+	pattern := "/public/risk/check"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPublicCheckRisk))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetCaptcha 获取图形验证码
 func (c *PublicHTTPClientImpl) GetCaptcha(ctx context.Context, in *GetCaptchaRequest, opts ...http.CallOption) (*GetCaptchaReply, error) {
 	var out GetCaptchaReply
@@ -34,6 +34,8 @@ const (
 	UploadScene_UPLOAD_COMMON UploadScene = 0
 	// 用户头像
 	UploadScene_UPLOAD_AVATAR UploadScene = 1
+	// 聊天附件（图片、文件、语音），私有访问
+	UploadScene_UPLOAD_CHAT UploadScene = 2
 )
 
 // Enum value maps for UploadScene.
@@ -41,10 +43,12 @@ var (
 	UploadScene_name = map[int32]string{
 		0: "UPLOAD_COMMON",
 		1: "UPLOAD_AVATAR",
+		2: "UPLOAD_CHAT",
 	}
 	UploadScene_value = map[string]int32{
 		"UPLOAD_COMMON": 0,
 		"UPLOAD_AVATAR": 1,
+		"UPLOAD_CHAT":   2,
 	}
 )
 
@@ -149,7 +153,11 @@ type UploadFileReply struct {
 	// 是否为私有文件
 	IsPrivate bool `protobuf:"varint,4,opt,name=is_private,proto3" json:"is_private,omitempty"`
 	// 上传时间戳（秒）
-	UploadedAt    int64 `protobuf:"varint,5,opt,name=uploaded_at,proto3" json:"uploaded_at,omitempty"`
+	UploadedAt int64 `protobuf:"varint,5,opt,name=uploaded_at,proto3" json:"uploaded_at,omitempty"`
+	// 图片宽度（像素）
+	Width int32 `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	// 图片高度（像素）
+	Height        int32 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -219,15 +227,29 @@ func (x *UploadFileReply) GetUploadedAt() int64 {
 	return 0
 }
 
+func (x *UploadFileReply) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *UploadFileReply) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 var File_api_upload_v1_upload_proto protoreflect.FileDescriptor
 
 const file_api_upload_v1_upload_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/upload/v1/upload.proto\x12\rapi.upload.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1copenapi/v3/annotations.proto\"\xf9\x02\n" +
-	"\x11UploadFileRequest\x12\x95\x01\n" +
-	"\x05scene\x18\x01 \x01(\x0e2\x1a.api.upload.v1.UploadSceneBc\xe2A\x01\x02\xfaB\x05\x82\x01\x02\x10\x01\xbaGT\x92\x02Q上传场景类型，必须为已定义的枚举值：UPLOAD_COMMON/UPLOAD_AVATARR\x05scene\x12f\n" +
+	"\x1aapi/upload/v1/upload.proto\x12\rapi.upload.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1copenapi/v3/annotations.proto\"\x85\x03\n" +
+	"\x11UploadFileRequest\x12\xa1\x01\n" +
+	"\x05scene\x18\x01 \x01(\x0e2\x1a.api.upload.v1.UploadSceneBo\xe2A\x01\x02\xfaB\x05\x82\x01\x02\x10\x01\xbaG`\x92\x02]上传场景类型，必须为已定义的枚举值：UPLOAD_COMMON/UPLOAD_AVATAR/UPLOAD_CHATR\x05scene\x12f\n" +
 	"\x04file\x18\x02 \x01(\fBR\xe2A\x01\x02\xbaGK\x92\x02?文件二进制数据，使用 multipart/form-data 格式上传\x9a\x02\x06binaryR\x04file\x12d\n" +
-	"\bfilename\x18\x03 \x01(\tBH\xbaGE\x92\x02B原始文件名，用于获取文件扩展名，如：document.pdfR\bfilename\"\xd8\x03\n" +
+	"\bfilename\x18\x03 \x01(\tBH\xbaGE\x92\x02B原始文件名，用于获取文件扩展名，如：document.pdfR\bfilename\"\xee\x04\n" +
 	"\x0fUploadFileReply\x12O\n" +
 	"\bfile_key\x18\x01 \x01(\tB3\xbaG0\x92\x02-文件在对象存储中的唯一标识路径R\bfile_key\x12q\n" +
 	"\bfile_url\x18\x02 \x01(\tBU\xbaGR\x92\x02O文件访问地址，私有文件为临时签名URL（默认1小时有效期）R\bfile_url\x12H\n" +
@@ -235,10 +257,13 @@ const file_api_upload_v1_upload_proto_rawDesc = "" +
 	"\n" +
 	"is_private\x18\x04 \x01(\bBC\xbaG@\x92\x02=文件是否为私有访问，true表示需要签名URL访问R\n" +
 	"is_private\x12R\n" +
-	"\vuploaded_at\x18\x05 \x01(\x03B0\xbaG-\x92\x02*文件上传完成的时间戳，单位秒R\vuploaded_at*3\n" +
+	"\vuploaded_at\x18\x05 \x01(\x03B0\xbaG-\x92\x02*文件上传完成的时间戳，单位秒R\vuploaded_at\x12H\n" +
+	"\x05width\x18\x06 \x01(\x05B2\xbaG/\x92\x02,图片宽度，单位像素，非图片为 0R\x05width\x12J\n" +
+	"\x06height\x18\a \x01(\x05B2\xbaG/\x92\x02,图片高度，单位像素，非图片为 0R\x06height*D\n" +
 	"\vUploadScene\x12\x11\n" +
 	"\rUPLOAD_COMMON\x10\x00\x12\x11\n" +
-	"\rUPLOAD_AVATAR\x10\x012\xde\x01\n" +
+	"\rUPLOAD_AVATAR\x10\x01\x12\x0f\n" +
+	"\vUPLOAD_CHAT\x10\x022\xde\x01\n" +
 	"\x06Upload\x12\xd3\x01\n" +
 	"\n" +
 	"UploadFile\x12 .api.upload.v1.UploadFileRequest\x1a\x1e.api.upload.v1.UploadFileReply\"\x82\x01\xbaGm\x12\f上传文件\x1a]支持多种场景的文件上传，根据场景类型自动配置存储路径和访问权限\x82\xd3\xe4\x93\x02\f:\x01*\"\a/uploadBQ\n" +
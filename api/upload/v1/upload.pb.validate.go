@@ -184,6 +184,10 @@ func (m *UploadFileReply) validate(all bool) error {
 
 	// no validation rules for UploadedAt
 
+	// no validation rules for Width
+
+	// no validation rules for Height
+
 	if len(errors) > 0 {
 		return UploadFileReplyMultiError(errors)
 	}
@@ -0,0 +1,502 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.2
+// source: api/ws/v1/ws.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/google/gnostic/openapiv3"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// data 的编码方式
+type Frame_Encoding int32
+
+const (
+	// 动作对应请求、响应类型的 proto 二进制编码
+	Frame_PROTO Frame_Encoding = 0
+	// JSON，用于没有 proto 定义的推送，如 new_chat
+	Frame_JSON Frame_Encoding = 1
+)
+
+// Enum value maps for Frame_Encoding.
+var (
+	Frame_Encoding_name = map[int32]string{
+		0: "PROTO",
+		1: "JSON",
+	}
+	Frame_Encoding_value = map[string]int32{
+		"PROTO": 0,
+		"JSON":  1,
+	}
+)
+
+func (x Frame_Encoding) Enum() *Frame_Encoding {
+	p := new(Frame_Encoding)
+	*p = x
+	return p
+}
+
+func (x Frame_Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Frame_Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ws_v1_ws_proto_enumTypes[0].Descriptor()
+}
+
+func (Frame_Encoding) Type() protoreflect.EnumType {
+	return &file_api_ws_v1_ws_proto_enumTypes[0]
+}
+
+func (x Frame_Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Frame_Encoding.Descriptor instead.
+func (Frame_Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_api_ws_v1_ws_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// 子协议 protobuf 下客户端与服务端之间的二进制帧，字段含义与 json 子协议的消息一致
+type Frame struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务动作，如 chat、ping、subscribe
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// 请求序号，响应原样带回；可靠消息的投递序号
+	Seq string `protobuf:"bytes,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	// 业务数据
+	Data     []byte         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Encoding Frame_Encoding `protobuf:"varint,4,opt,name=encoding,proto3,enum=api.ws.v1.Frame_Encoding" json:"encoding,omitempty"`
+	// 请求失败时的错误
+	Error         *Error `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Frame) Reset() {
+	*x = Frame{}
+	mi := &file_api_ws_v1_ws_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Frame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Frame) ProtoMessage() {}
+
+func (x *Frame) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_v1_ws_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Frame.ProtoReflect.Descriptor instead.
+func (*Frame) Descriptor() ([]byte, []int) {
+	return file_api_ws_v1_ws_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Frame) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Frame) GetSeq() string {
+	if x != nil {
+		return x.Seq
+	}
+	return ""
+}
+
+func (x *Frame) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Frame) GetEncoding() Frame_Encoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return Frame_PROTO
+}
+
+func (x *Frame) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// 请求失败时的错误，与 HTTP 接口的 Kratos 错误一致
+type Error struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_api_ws_v1_ws_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_v1_ws_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_api_ws_v1_ws_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Error) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Error) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Error) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// subscribe、unsubscribe 动作的请求
+type RoomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 房间，格式为 类型:ID，如 order:123、group:42
+	Room          string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomRequest) Reset() {
+	*x = RoomRequest{}
+	mi := &file_api_ws_v1_ws_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomRequest) ProtoMessage() {}
+
+func (x *RoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_v1_ws_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomRequest.ProtoReflect.Descriptor instead.
+func (*RoomRequest) Descriptor() ([]byte, []int) {
+	return file_api_ws_v1_ws_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RoomRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+// subscribe、unsubscribe 动作的响应
+type RoomReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          string                 `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomReply) Reset() {
+	*x = RoomReply{}
+	mi := &file_api_ws_v1_ws_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomReply) ProtoMessage() {}
+
+func (x *RoomReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_v1_ws_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomReply.ProtoReflect.Descriptor instead.
+func (*RoomReply) Descriptor() ([]byte, []int) {
+	return file_api_ws_v1_ws_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RoomReply) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+type CreateTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketRequest) Reset() {
+	*x = CreateTicketRequest{}
+	mi := &file_api_ws_v1_ws_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketRequest) ProtoMessage() {}
+
+func (x *CreateTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_v1_ws_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketRequest.ProtoReflect.Descriptor instead.
+func (*CreateTicketRequest) Descriptor() ([]byte, []int) {
+	return file_api_ws_v1_ws_proto_rawDescGZIP(), []int{4}
+}
+
+type CreateTicketReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 连接凭证，只能使用一次
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	// 凭证过期时间戳（秒）
+	ExpireAt      int64 `protobuf:"varint,2,opt,name=expire_at,proto3" json:"expire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketReply) Reset() {
+	*x = CreateTicketReply{}
+	mi := &file_api_ws_v1_ws_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketReply) ProtoMessage() {}
+
+func (x *CreateTicketReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_v1_ws_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketReply.ProtoReflect.Descriptor instead.
+func (*CreateTicketReply) Descriptor() ([]byte, []int) {
+	return file_api_ws_v1_ws_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTicketReply) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *CreateTicketReply) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+var File_api_ws_v1_ws_proto protoreflect.FileDescriptor
+
+const file_api_ws_v1_ws_proto_rawDesc = "" +
+	"\n" +
+	"\x12api/ws/v1/ws.proto\x12\tapi.ws.v1\x1a\x17validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1copenapi/v3/annotations.proto\"\xc5\x01\n" +
+	"\x05Frame\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\tR\x03seq\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x125\n" +
+	"\bencoding\x18\x04 \x01(\x0e2\x19.api.ws.v1.Frame.EncodingR\bencoding\x12&\n" +
+	"\x05error\x18\x05 \x01(\v2\x10.api.ws.v1.ErrorR\x05error\"\x1f\n" +
+	"\bEncoding\x12\t\n" +
+	"\x05PROTO\x10\x00\x12\b\n" +
+	"\x04JSON\x10\x01\"\xc6\x01\n" +
+	"\x05Error\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12:\n" +
+	"\bmetadata\x18\x04 \x03(\v2\x1e.api.ws.v1.Error.MetadataEntryR\bmetadata\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\",\n" +
+	"\vRoomRequest\x12\x1d\n" +
+	"\x04room\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04room\"\x1f\n" +
+	"\tRoomReply\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\"\x15\n" +
+	"\x13CreateTicketRequest\"\x9b\x01\n" +
+	"\x11CreateTicketReply\x12?\n" +
+	"\x06ticket\x18\x01 \x01(\tB'\xbaG$\x92\x02!连接凭证，只能使用一次R\x06ticket\x12E\n" +
+	"\texpire_at\x18\x02 \x01(\x03B'\xbaG$\x92\x02!凭证过期时间戳，单位秒R\texpire_at2\x84\x02\n" +
+	"\tWebsocket\x12\xf6\x01\n" +
+	"\fCreateTicket\x12\x1e.api.ws.v1.CreateTicketRequest\x1a\x1c.api.ws.v1.CreateTicketReply\"\xa7\x01\xbaG\x8e\x01\x12\x12获取连接凭证\x1ax换取一次性、短时有效的连接凭证，建立连接时通过 /ws?ticket= 传入，避免令牌出现在 URL 中\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/ws/ticketBI\n" +
+	"\tapi.ws.v1P\x01Z:github.com/sober-studio/bubble-boot-go-kratos/api/ws/v1;v1b\x06proto3"
+
+var (
+	file_api_ws_v1_ws_proto_rawDescOnce sync.Once
+	file_api_ws_v1_ws_proto_rawDescData []byte
+)
+
+func file_api_ws_v1_ws_proto_rawDescGZIP() []byte {
+	file_api_ws_v1_ws_proto_rawDescOnce.Do(func() {
+		file_api_ws_v1_ws_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_ws_v1_ws_proto_rawDesc), len(file_api_ws_v1_ws_proto_rawDesc)))
+	})
+	return file_api_ws_v1_ws_proto_rawDescData
+}
+
+var file_api_ws_v1_ws_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_ws_v1_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_ws_v1_ws_proto_goTypes = []any{
+	(Frame_Encoding)(0),         // 0: api.ws.v1.Frame.Encoding
+	(*Frame)(nil),               // 1: api.ws.v1.Frame
+	(*Error)(nil),               // 2: api.ws.v1.Error
+	(*RoomRequest)(nil),         // 3: api.ws.v1.RoomRequest
+	(*RoomReply)(nil),           // 4: api.ws.v1.RoomReply
+	(*CreateTicketRequest)(nil), // 5: api.ws.v1.CreateTicketRequest
+	(*CreateTicketReply)(nil),   // 6: api.ws.v1.CreateTicketReply
+	nil,                         // 7: api.ws.v1.Error.MetadataEntry
+}
+var file_api_ws_v1_ws_proto_depIdxs = []int32{
+	0, // 0: api.ws.v1.Frame.encoding:type_name -> api.ws.v1.Frame.Encoding
+	2, // 1: api.ws.v1.Frame.error:type_name -> api.ws.v1.Error
+	7, // 2: api.ws.v1.Error.metadata:type_name -> api.ws.v1.Error.MetadataEntry
+	5, // 3: api.ws.v1.Websocket.CreateTicket:input_type -> api.ws.v1.CreateTicketRequest
+	6, // 4: api.ws.v1.Websocket.CreateTicket:output_type -> api.ws.v1.CreateTicketReply
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_ws_v1_ws_proto_init() }
+func file_api_ws_v1_ws_proto_init() {
+	if File_api_ws_v1_ws_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_ws_v1_ws_proto_rawDesc), len(file_api_ws_v1_ws_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_ws_v1_ws_proto_goTypes,
+		DependencyIndexes: file_api_ws_v1_ws_proto_depIdxs,
+		EnumInfos:         file_api_ws_v1_ws_proto_enumTypes,
+		MessageInfos:      file_api_ws_v1_ws_proto_msgTypes,
+	}.Build()
+	File_api_ws_v1_ws_proto = out.File
+	file_api_ws_v1_ws_proto_goTypes = nil
+	file_api_ws_v1_ws_proto_depIdxs = nil
+}
@@ -0,0 +1,696 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/ws/v1/ws.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Frame with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Frame) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Frame with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FrameMultiError, or nil if none found.
+func (m *Frame) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Frame) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Action
+
+	// no validation rules for Seq
+
+	// no validation rules for Data
+
+	// no validation rules for Encoding
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FrameValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FrameValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FrameValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FrameMultiError(errors)
+	}
+
+	return nil
+}
+
+// FrameMultiError is an error wrapping multiple validation errors returned by
+// Frame.ValidateAll() if the designated constraints aren't met.
+type FrameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FrameMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FrameMultiError) AllErrors() []error { return m }
+
+// FrameValidationError is the validation error returned by Frame.Validate if
+// the designated constraints aren't met.
+type FrameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FrameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FrameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FrameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FrameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FrameValidationError) ErrorName() string { return "FrameValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FrameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFrame.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FrameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FrameValidationError{}
+
+// Validate checks the field values on Error with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Error) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Error with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ErrorMultiError, or nil if none found.
+func (m *Error) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Error) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	// no validation rules for Metadata
+
+	if len(errors) > 0 {
+		return ErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorMultiError is an error wrapping multiple validation errors returned by
+// Error.ValidateAll() if the designated constraints aren't met.
+type ErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorMultiError) AllErrors() []error { return m }
+
+// ErrorValidationError is the validation error returned by Error.Validate if
+// the designated constraints aren't met.
+type ErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorValidationError) ErrorName() string { return "ErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorValidationError{}
+
+// Validate checks the field values on RoomRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoomRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoomRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoomRequestMultiError, or
+// nil if none found.
+func (m *RoomRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoomRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetRoom()); l < 1 || l > 64 {
+		err := RoomRequestValidationError{
+			field:  "Room",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RoomRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoomRequestMultiError is an error wrapping multiple validation errors
+// returned by RoomRequest.ValidateAll() if the designated constraints aren't met.
+type RoomRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoomRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoomRequestMultiError) AllErrors() []error { return m }
+
+// RoomRequestValidationError is the validation error returned by
+// RoomRequest.Validate if the designated constraints aren't met.
+type RoomRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoomRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoomRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoomRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoomRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoomRequestValidationError) ErrorName() string { return "RoomRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoomRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoomRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoomRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoomRequestValidationError{}
+
+// Validate checks the field values on RoomReply with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoomReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoomReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoomReplyMultiError, or nil
+// if none found.
+func (m *RoomReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoomReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Room
+
+	if len(errors) > 0 {
+		return RoomReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoomReplyMultiError is an error wrapping multiple validation errors returned
+// by RoomReply.ValidateAll() if the designated constraints aren't met.
+type RoomReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoomReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoomReplyMultiError) AllErrors() []error { return m }
+
+// RoomReplyValidationError is the validation error returned by
+// RoomReply.Validate if the designated constraints aren't met.
+type RoomReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoomReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoomReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoomReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoomReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoomReplyValidationError) ErrorName() string { return "RoomReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoomReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoomReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoomReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoomReplyValidationError{}
+
+// Validate checks the field values on CreateTicketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTicketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTicketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTicketRequestMultiError, or nil if none found.
+func (m *CreateTicketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTicketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CreateTicketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTicketRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTicketRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTicketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTicketRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTicketRequestMultiError) AllErrors() []error { return m }
+
+// CreateTicketRequestValidationError is the validation error returned by
+// CreateTicketRequest.Validate if the designated constraints aren't met.
+type CreateTicketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTicketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTicketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTicketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTicketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTicketRequestValidationError) ErrorName() string {
+	return "CreateTicketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTicketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTicketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTicketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTicketRequestValidationError{}
+
+// Validate checks the field values on CreateTicketReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTicketReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTicketReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTicketReplyMultiError, or nil if none found.
+func (m *CreateTicketReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTicketReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ticket
+
+	// no validation rules for ExpireAt
+
+	if len(errors) > 0 {
+		return CreateTicketReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTicketReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateTicketReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTicketReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTicketReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTicketReplyMultiError) AllErrors() []error { return m }
+
+// CreateTicketReplyValidationError is the validation error returned by
+// CreateTicketReply.Validate if the designated constraints aren't met.
+type CreateTicketReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTicketReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTicketReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTicketReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTicketReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTicketReplyValidationError) ErrorName() string {
+	return "CreateTicketReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTicketReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTicketReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTicketReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTicketReplyValidationError{}
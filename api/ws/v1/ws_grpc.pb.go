@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.2
+// source: ws/v1/ws.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Websocket_CreateTicket_FullMethodName = "/api.ws.v1.Websocket/CreateTicket"
+)
+
+// WebsocketClient is the client API for Websocket service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebsocketClient interface {
+	// 获取连接凭证
+	CreateTicket(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*CreateTicketReply, error)
+}
+
+type websocketClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebsocketClient(cc grpc.ClientConnInterface) WebsocketClient {
+	return &websocketClient{cc}
+}
+
+func (c *websocketClient) CreateTicket(ctx context.Context, in *CreateTicketRequest, opts ...grpc.CallOption) (*CreateTicketReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTicketReply)
+	err := c.cc.Invoke(ctx, Websocket_CreateTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebsocketServer is the server API for Websocket service.
+// All implementations must embed UnimplementedWebsocketServer
+// for forward compatibility.
+type WebsocketServer interface {
+	// 获取连接凭证
+	CreateTicket(context.Context, *CreateTicketRequest) (*CreateTicketReply, error)
+	mustEmbedUnimplementedWebsocketServer()
+}
+
+// UnimplementedWebsocketServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWebsocketServer struct{}
+
+func (UnimplementedWebsocketServer) CreateTicket(context.Context, *CreateTicketRequest) (*CreateTicketReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTicket not implemented")
+}
+func (UnimplementedWebsocketServer) mustEmbedUnimplementedWebsocketServer() {}
+func (UnimplementedWebsocketServer) testEmbeddedByValue()                   {}
+
+// UnsafeWebsocketServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebsocketServer will
+// result in compilation errors.
+type UnsafeWebsocketServer interface {
+	mustEmbedUnimplementedWebsocketServer()
+}
+
+func RegisterWebsocketServer(s grpc.ServiceRegistrar, srv WebsocketServer) {
+	// If the following call panics, it indicates UnimplementedWebsocketServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Websocket_ServiceDesc, srv)
+}
+
+func _Websocket_CreateTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebsocketServer).CreateTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Websocket_CreateTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebsocketServer).CreateTicket(ctx, req.(*CreateTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Websocket_ServiceDesc is the grpc.ServiceDesc for Websocket service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Websocket_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.ws.v1.Websocket",
+	HandlerType: (*WebsocketServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTicket",
+			Handler:    _Websocket_CreateTicket_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ws/v1/ws.proto",
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/cron"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/env"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/worker"
 
 	_ "go.uber.org/automaxprocs"
 )
@@ -34,7 +35,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, cron *cron.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, cron *cron.Server, worker *worker.Server) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -45,6 +46,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, cron *cron.Serv
 			gs,
 			hs,
 			cron,
+			worker,
 		),
 	)
 }
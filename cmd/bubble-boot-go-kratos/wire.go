@@ -29,6 +29,9 @@ func wireApp(*conf.Server, *conf.Data, *conf.App, log.Logger) (*kratos.App, func
 		data.ProviderSet,
 		auth.ProviderSet,
 		ws.NewHub,
+		ws.NewAuthorizer,
+		ws.NewMailbox,
+		ws.NewTickets,
 		newApp,
 	))
 }
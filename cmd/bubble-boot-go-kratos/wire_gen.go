@@ -15,6 +15,9 @@ import (
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/job"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/email"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/msgtpl"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/oss"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/phone"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/sms"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/server"
@@ -29,35 +32,88 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, app *conf.App, logger log.Logger) (*kratos.App, func(), error) {
-	grpcServer := server.NewGRPCServer(confServer, logger)
-	db := data.NewDB(confData, logger)
 	client := data.NewRedis(confData, logger)
+	tokenStore := auth.NewTokenStore(app, client)
+	tokenService := auth.NewTokenService(app, tokenStore)
+	db := data.NewDB(confData, logger)
 	idGenerator := data.NewIDGenerator(app)
 	dataData, cleanup, err := data.NewData(confData, logger, db, client, idGenerator)
 	if err != nil {
 		return nil, nil, err
 	}
-	store := data.NewRedisCaptchaStore(dataData)
-	captchaUseCase := biz.NewCaptchaUseCase(store, logger)
-	sender := sms.NewSmsSender(confData, logger)
-	emailSender := email.NewEmailSender(confData, logger)
-	otpCache := data.NewRedisOtpCache(dataData)
-	otpUseCase := biz.NewOtpUseCase(sender, emailSender, otpCache, app, logger)
-	tokenStore := auth.NewTokenStore(app, client)
-	tokenService := auth.NewTokenService(app, tokenStore)
-	userRepo := data.NewUserRepo(dataData, logger)
-	passportUseCase := biz.NewPassportUseCase(tokenService, userRepo, app, logger)
-	publicService := service.NewPublicService(captchaUseCase, otpUseCase, passportUseCase, logger)
-	passportService := service.NewPassportService(passportUseCase, otpUseCase, captchaUseCase)
-	hub := ws.NewHub(logger)
+	presenceRepo := data.NewPresenceRepo(dataData, app, logger)
 	chatRepo := data.NewChatRepo(dataData, logger)
-	chatUseCase := biz.NewChatUseCase(chatRepo, logger)
-	chatService := service.NewChatService(hub, chatUseCase)
-	websocketService := service.NewWebsocketService(hub, chatService, tokenService, logger)
-	httpServer := server.NewHTTPServer(confServer, app, publicService, passportService, tokenService, websocketService, logger)
+	presenceUseCase := biz.NewPresenceUseCase(presenceRepo, chatRepo, tokenService, logger)
+	broker := data.NewWsBroker(dataData, confServer, logger)
+	hub := ws.NewHub(confServer, broker, logger)
+	authorizer := ws.NewAuthorizer()
+	presenceService := service.NewPresenceService(presenceUseCase, hub, authorizer, logger)
+	mailboxStore := data.NewWsMailboxStore(dataData, confServer)
+	mailbox := ws.NewMailbox(hub, mailboxStore, confServer, logger)
+	userRepo := data.NewUserRepo(dataData, logger)
+	groupRepo := data.NewGroupRepo(dataData, logger)
+	notificationPusher := service.NewNotificationPusher(mailbox)
+	groupRooms := service.NewGroupRooms(hub)
+	groupUseCase := biz.NewGroupUseCase(groupRepo, userRepo, tokenService, notificationPusher, groupRooms, app, logger)
+	sensitiveRepo := data.NewSensitiveRepo(dataData, logger)
+	sensitiveUseCase := biz.NewSensitiveUseCase(sensitiveRepo, app, logger)
+	storage := oss.NewOSS(confData, logger)
+	uploadRepo := data.NewUploadRepo(dataData, logger)
+	uploadUseCase := biz.NewUploadUseCase(storage, uploadRepo, tokenService, app, logger)
+	chatUseCase := biz.NewChatUseCase(chatRepo, userRepo, groupUseCase, sensitiveUseCase, uploadUseCase, tokenService, idGenerator, app, logger)
+	chatService := service.NewChatService(hub, mailbox, chatUseCase)
+	groupService := service.NewGroupService(groupUseCase, authorizer)
+	grpcServer := server.NewGRPCServer(confServer, app, tokenService, presenceService, chatService, groupService, logger)
+	captchaStore := data.NewRedisCaptchaStore(dataData)
+	captchaUseCase, err := biz.NewCaptchaUseCase(captchaStore, app, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	outboundQueue := data.NewOutboundQueue(dataData, app, logger)
+	registry := msgtpl.NewRegistry()
+	deliveryRepo := data.NewDeliveryRepo(dataData, logger)
+	deliveryUseCase := biz.NewDeliveryUseCase(deliveryRepo, logger)
+	recorder := biz.NewSmsRecorder(deliveryUseCase)
+	sender := sms.NewSmsSender(confData, registry, recorder, logger)
+	templates, err := email.NewTemplates(confData, registry)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	emailRecorder := biz.NewEmailRecorder(deliveryUseCase)
+	emailSender := email.NewEmailSender(confData, templates, emailRecorder, logger)
+	outboundUseCase := biz.NewOutboundUseCase(outboundQueue, sender, emailSender, idGenerator, app, logger)
+	smsSender := biz.NewOutboundSmsSender(outboundUseCase)
+	bizEmailSender := biz.NewOutboundEmailSender(outboundUseCase)
+	otpCache := data.NewRedisOtpCache(dataData)
+	otpUseCase := biz.NewOtpUseCase(smsSender, bizEmailSender, otpCache, app, logger)
+	normalizer, err := phone.NewNormalizer(app)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	notificationUseCase := biz.NewNotificationUseCase(notificationRepo, userRepo, tokenService, notificationPusher, smsSender, bizEmailSender, app, logger)
+	passportUseCase := biz.NewPassportUseCase(tokenService, userRepo, normalizer, sensitiveUseCase, notificationUseCase, app, logger)
+	riskCache := data.NewRedisRiskCache(dataData)
+	riskUseCase := biz.NewRiskUseCase(riskCache, captchaUseCase, app, logger)
+	publicService := service.NewPublicService(captchaUseCase, otpUseCase, passportUseCase, riskUseCase, normalizer, logger)
+	passportService := service.NewPassportService(passportUseCase, otpUseCase, riskUseCase, normalizer)
+	ticketStore := data.NewWsTicketStore(dataData)
+	tickets := ws.NewTickets(ticketStore, confServer)
+	websocketService := service.NewWebsocketService(hub, mailbox, authorizer, chatService, tokenService, tickets, confServer, logger)
+	deliveryService := service.NewDeliveryService(deliveryUseCase, normalizer, confData, logger)
+	messageTemplateRepo := data.NewMessageTemplateRepo(dataData, logger)
+	messageTemplateUseCase := biz.NewMessageTemplateUseCase(messageTemplateRepo, registry, templates, confData, logger)
+	messageTemplateService := service.NewMessageTemplateService(messageTemplateUseCase)
+	notificationService := service.NewNotificationService(notificationUseCase)
+	uploadService := service.NewUploadService(uploadUseCase)
+	httpServer := server.NewHTTPServer(confServer, app, publicService, passportService, tokenService, websocketService, chatService, groupService, deliveryService, messageTemplateService, notificationService, presenceService, uploadService, logger)
 	helloJob := job.NewHelloJob(logger)
 	cronServer := server.NewCronServer(confServer, logger, helloJob)
-	kratosApp := newApp(logger, grpcServer, httpServer, cronServer)
+	workerServer := server.NewWorkerServer(logger, outboundUseCase, messageTemplateUseCase, sensitiveUseCase, hub, mailbox)
+	kratosApp := newApp(logger, grpcServer, httpServer, cronServer, workerServer)
 	return kratosApp, func() {
 		cleanup()
 	}, nil
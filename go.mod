@@ -33,6 +33,7 @@ require (
 	github.com/qiniu/go-sdk/v7 v7.25.6
 	github.com/robfig/cron/v3 v3.0.1
 	golang.org/x/crypto v0.46.0
+	golang.org/x/text v0.32.0
 	gopkg.in/gomail.v2 v2.0.0-20160411212932-81ebce5c23df
 	gorm.io/plugin/dbresolver v1.6.2
 )
@@ -88,7 +89,6 @@ require (
 	golang.org/x/net v0.48.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
-	golang.org/x/text v0.32.0 // indirect
 	golang.org/x/time v0.14.0 // indirect
 	golang.org/x/tools v0.39.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250811160224-6b04f9b4fc78 // indirect
@@ -5,21 +5,36 @@ import (
 
 	"github.com/google/wire"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/email"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/msgtpl"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/oss"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/phone"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/sms"
 )
 
 // ProviderSet is biz providers.
 var ProviderSet = wire.NewSet(
 	NewCaptchaUseCase,
+	NewRiskUseCase,
 	NewOtpUseCase,
+	NewDeliveryUseCase,
+	NewSmsRecorder,
+	NewEmailRecorder,
+	msgtpl.NewRegistry,
+	NewMessageTemplateUseCase,
+	NewNotificationUseCase,
+	NewPresenceUseCase,
 	sms.NewSmsSender,
+	email.NewTemplates,
 	email.NewEmailSender,
-	wire.Bind(new(SmsSender), new(sms.Sender)),
-	wire.Bind(new(EmailSender), new(email.Sender)),
+	NewOutboundUseCase,
+	NewOutboundSmsSender,
+	NewOutboundEmailSender,
 	oss.NewOSS,
+	phone.NewNormalizer,
 	// domains
 	NewChatUseCase,
+	NewGroupUseCase,
+	NewSensitiveUseCase,
 	NewPassportUseCase,
 	NewUploadUseCase,
 )
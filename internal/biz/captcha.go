@@ -2,70 +2,147 @@ package biz
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/mojocn/base64Captcha"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/debug"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/slider"
 )
 
 var (
 	ErrorImageCaptchaEmpty        = errors.BadRequest("IMAGE_CAPTCHA_EMPTY", "验证码不能为空")
 	ErrorImageCaptchaVerifyFailed = errors.BadRequest("IMAGE_CAPTCHA_VERIFY_FAILED", "图片验证码错误")
+	ErrorCaptchaGenerateFailed    = errors.InternalServer("CAPTCHA_GENERATE_FAILED", "生成验证码失败")
 )
 
+// 验证码场景，每个场景可在配置中指定不同的验证码样式
+const (
+	CaptchaSceneDefault = "default"
+	CaptchaSceneLogin   = "login"
+	CaptchaSceneSmsOtp  = "sms_otp"
+)
+
+const (
+	captchaDriverDigit   = "digit"
+	captchaDriverString  = "string"
+	captchaDriverMath    = "math"
+	captchaDriverChinese = "chinese"
+	captchaDriverAudio   = "audio"
+	captchaDriverSlider  = "slider"
+)
+
+const (
+	captchaDefaultTTL      = 10 * time.Minute
+	captchaDefaultHeight   = 80
+	captchaDefaultWidth    = 240
+	captchaDefaultLength   = 4
+	captchaDefaultMaxSkew  = 0.3
+	captchaDefaultDotCount = 10
+	captchaDefaultLanguage = "zh"
+	// 去除了易混淆字符的字符集
+	captchaDefaultSource = "23456789abcdefghjkmnpqrstuvwxyzABCDEFGHJKMNPQRSTUVWXYZ"
+	// 内置字体中唯一支持中文的字体
+	captchaChineseFont = "wqy-microhei.ttc"
+
+	captchaDefaultSliderTolerance   = 5
+	captchaDefaultSliderMinDuration = 300 * time.Millisecond
+	captchaDefaultSliderMinPoints   = 5
+)
+
+// CaptchaStore 验证码答案存储
+type CaptchaStore interface {
+	Set(ctx context.Context, id, value string, ttl time.Duration) error
+	// Get 获取答案，不存在时返回空字符串；clear 为 true 时读取后立即删除
+	Get(ctx context.Context, id string, clear bool) (string, error)
+}
+
+// Captcha 生成的验证码
+type Captcha struct {
+	ID     string
+	Driver string
+	Data   string // Base64 编码的图片或音频，滑块验证码为带缺口的背景图
+	Piece  string // 滑块验证码：Base64 编码的拼图块
+	PieceY int    // 滑块验证码：拼图块纵坐标
+}
+
+// captchaState 存储的答案，绑定生成时的场景，防止低强度场景的验证码被用于高强度场景
+type captchaState struct {
+	Scene  string `json:"scene"`
+	Driver string `json:"driver"`
+	Answer string `json:"answer,omitempty"`
+	X      int    `json:"x,omitempty"` // 滑块验证码：缺口横坐标
+}
+
+type captchaProfile struct {
+	driver    string
+	ttl       time.Duration
+	generator base64Captcha.Driver
+	// 滑块验证码
+	sliderOptions slider.Options
+	sliderRule    slider.Rule
+}
+
 type CaptchaUseCase struct {
-	store base64Captcha.Store
-	log   *log.Helper
+	store    CaptchaStore
+	fallback *captchaProfile
+	profiles map[string]*captchaProfile
+	log      *log.Helper
 }
 
-func NewCaptchaUseCase(store base64Captcha.Store, logger log.Logger) *CaptchaUseCase {
-	return &CaptchaUseCase{
-		store: store,
-		log:   log.NewHelper(logger),
+func NewCaptchaUseCase(store CaptchaStore, c *conf.App, logger log.Logger) (*CaptchaUseCase, error) {
+	cfg := c.Captcha
+	if cfg == nil {
+		cfg = &conf.App_Captcha{}
 	}
+
+	fallback, err := newCaptchaProfile(cfg.Default)
+	if err != nil {
+		return nil, fmt.Errorf("captcha default profile: %w", err)
+	}
+	profiles := make(map[string]*captchaProfile, len(cfg.Scenes))
+	for scene, pc := range cfg.Scenes {
+		p, err := newCaptchaProfile(pc)
+		if err != nil {
+			return nil, fmt.Errorf("captcha scene %s: %w", scene, err)
+		}
+		profiles[scene] = p
+	}
+
+	return &CaptchaUseCase{
+		store:    store,
+		fallback: fallback,
+		profiles: profiles,
+		log:      log.NewHelper(logger),
+	}, nil
 }
 
-// Generate 生成验证码
-func (uc *CaptchaUseCase) Generate(ctx context.Context) (id, b64 string, err error) {
-
-	// 验证码配置
-
-	// 1. 强干扰数字版
-	//driver := base64Captcha.NewDriverDigit(80, 240, 6, 0.8, 100)
-
-	// 2. 清爽数字版
-	driver := base64Captcha.NewDriverDigit(80, 240, 4, 0.3, 10)
-
-	// 3. 字母数字混合版
-	//driver := base64Captcha.NewDriverString(
-	//	80,  // 高度
-	//	240, // 宽度
-	//	2,   // 干扰线数量
-	//	base64Captcha.OptionShowSlimeLine|base64Captcha.OptionShowHollowLine, // 干扰线类型：粘液线+空心线
-	//	6, // 长度
-	//	"23456789abcdefghjkmnpqrstuvwxyzABCDEFGHJKMNPQRSTUVWXYZ", // 去除了易混淆字符的库
-	//	nil, // 背景颜色，nil 为随机
-	//	nil, // 字体，nil 为默认
-	//	nil, // 自定义字体路径
-	//)
-
-	// 4. 算数版
-	//driver := base64Captcha.NewDriverMath(
-	//	80,                                // 高度
-	//	240,                               // 宽度
-	//	0,                                 // 噪点数量（算术题通常靠干扰线和形状，不需要太多噪点）
-	//	base64Captcha.OptionShowSlimeLine, // 干扰线类型
-	//	nil,                               // 背景颜色
-	//	nil,                               // 字体
-	//	nil,                               // 自定义字体路径
-	//)
-
-	cp := base64Captcha.NewCaptcha(driver, uc.store)
-
-	id, b64, answer, err := cp.Generate()
+// Generate 按场景生成验证码，scene 为空时使用默认配置
+func (uc *CaptchaUseCase) Generate(ctx context.Context, scene string) (*Captcha, error) {
+	if scene == "" {
+		scene = CaptchaSceneDefault
+	}
+	p := uc.profile(scene)
+	if p.driver == captchaDriverSlider {
+		return uc.generateSlider(ctx, scene, p)
+	}
+
+	id, question, answer := p.generator.GenerateIdQuestionAnswer()
+	item, err := p.generator.DrawCaptcha(question)
 	if err != nil {
-		return "", "", err
+		uc.log.Errorf("绘制验证码失败: scene=%s driver=%s err=%v", scene, p.driver, err)
+		return nil, ErrorCaptchaGenerateFailed
+	}
+
+	state, _ := json.Marshal(&captchaState{Scene: scene, Driver: p.driver, Answer: answer})
+	if err := uc.store.Set(ctx, id, string(state), p.ttl); err != nil {
+		uc.log.Errorf("保存验证码失败: %v", err)
+		return nil, ErrorCaptchaGenerateFailed
 	}
 
 	// 如果是调试模式，将答案注入 Context
@@ -75,18 +152,175 @@ func (uc *CaptchaUseCase) Generate(ctx context.Context) (id, b64 string, err err
 		}
 	}
 
-	return id, b64, nil
+	return &Captcha{
+		ID:     id,
+		Driver: p.driver,
+		Data:   item.EncodeB64string(),
+	}, nil
 }
 
-// Verify 验证验证码
-func (uc *CaptchaUseCase) Verify(ctx context.Context, id, answer string) error {
+// Verify 验证验证码，scene 为调用方所在的业务场景
+func (uc *CaptchaUseCase) Verify(ctx context.Context, scene, id, answer string) error {
 	if id == "" || answer == "" {
 		return ErrorImageCaptchaEmpty
 	}
 
 	// 校验并自动删除（防止重放攻击）
-	if !uc.store.Verify(id, answer, true) {
+	raw, err := uc.store.Get(ctx, id, true)
+	if err != nil {
+		uc.log.Errorf("查询验证码失败: %v", err)
+		return ErrorImageCaptchaVerifyFailed
+	}
+	if raw == "" {
+		return ErrorImageCaptchaVerifyFailed
+	}
+
+	var state captchaState
+	if err := json.Unmarshal([]byte(raw), &state); err != nil {
+		return ErrorImageCaptchaVerifyFailed
+	}
+	if !uc.sceneMatches(state.Scene, scene) {
+		return ErrorImageCaptchaVerifyFailed
+	}
+	if state.Driver == captchaDriverSlider {
+		return uc.verifySlider(&state, answer)
+	}
+	if !strings.EqualFold(state.Answer, answer) {
+		return ErrorImageCaptchaVerifyFailed
+	}
+	return nil
+}
+
+// generateSlider 生成滑块验证码，缺口位置作为答案存储
+func (uc *CaptchaUseCase) generateSlider(ctx context.Context, scene string, p *captchaProfile) (*Captcha, error) {
+	puzzle, err := slider.Generate(p.sliderOptions)
+	if err != nil {
+		uc.log.Errorf("生成滑块验证码失败: scene=%s err=%v", scene, err)
+		return nil, ErrorCaptchaGenerateFailed
+	}
+
+	id := base64Captcha.RandomId()
+	state, _ := json.Marshal(&captchaState{Scene: scene, Driver: p.driver, X: puzzle.X})
+	if err := uc.store.Set(ctx, id, string(state), p.ttl); err != nil {
+		uc.log.Errorf("保存验证码失败: %v", err)
+		return nil, ErrorCaptchaGenerateFailed
+	}
+
+	if debug.IsDebug() {
+		if info, ok := debug.FromContext(ctx); ok {
+			info["captcha_answer"] = puzzle.X
+		}
+	}
+
+	return &Captcha{
+		ID:     id,
+		Driver: p.driver,
+		Data:   puzzle.Background,
+		Piece:  puzzle.Piece,
+		PieceY: puzzle.Y,
+	}, nil
+}
+
+// verifySlider 校验滑块偏移量、拖动时长与轨迹，规则取生成时所在场景的配置
+func (uc *CaptchaUseCase) verifySlider(state *captchaState, answer string) error {
+	a, err := slider.ParseAnswer(answer)
+	if err != nil {
+		return ErrorImageCaptchaVerifyFailed
+	}
+	if !a.Verify(state.X, uc.profile(state.Scene).sliderRule) {
 		return ErrorImageCaptchaVerifyFailed
 	}
 	return nil
 }
+
+func (uc *CaptchaUseCase) profile(scene string) *captchaProfile {
+	if p, ok := uc.profiles[scene]; ok {
+		return p
+	}
+	return uc.fallback
+}
+
+// sceneMatches 验证码只能用于生成时的场景；默认场景的验证码可用于未单独配置样式的场景，兼容未传 scene 的客户端
+func (uc *CaptchaUseCase) sceneMatches(issued, required string) bool {
+	if issued == required {
+		return true
+	}
+	if issued != CaptchaSceneDefault {
+		return false
+	}
+	_, ok := uc.profiles[required]
+	return !ok
+}
+
+func newCaptchaProfile(c *conf.App_Captcha_Profile) (*captchaProfile, error) {
+	if c == nil {
+		c = &conf.App_Captcha_Profile{}
+	}
+	p := &captchaProfile{driver: c.Driver, ttl: captchaDefaultTTL}
+	if p.driver == "" {
+		p.driver = captchaDriverDigit
+	}
+	if c.Ttl != nil && c.Ttl.AsDuration() > 0 {
+		p.ttl = c.Ttl.AsDuration()
+	}
+
+	if p.driver == captchaDriverSlider {
+		p.sliderOptions = slider.Options{
+			Width:     int(c.Width),
+			Height:    int(c.Height),
+			PieceSize: int(c.PieceSize),
+		}
+		p.sliderRule = slider.Rule{
+			Tolerance:      int(orDefault(c.Tolerance, captchaDefaultSliderTolerance)),
+			MinDuration:    captchaDefaultSliderMinDuration,
+			MinTrackPoints: int(orDefault(c.MinTrackPoints, captchaDefaultSliderMinPoints)),
+		}
+		if c.MinDuration != nil && c.MinDuration.AsDuration() > 0 {
+			p.sliderRule.MinDuration = c.MinDuration.AsDuration()
+		}
+		return p, nil
+	}
+
+	height := int(orDefault(c.Height, captchaDefaultHeight))
+	width := int(orDefault(c.Width, captchaDefaultWidth))
+	length := int(orDefault(c.Length, captchaDefaultLength))
+
+	switch p.driver {
+	case captchaDriverDigit:
+		maxSkew := c.MaxSkew
+		if maxSkew == 0 {
+			maxSkew = captchaDefaultMaxSkew
+		}
+		p.generator = base64Captcha.NewDriverDigit(height, width, length, maxSkew, int(orDefault(c.DotCount, captchaDefaultDotCount)))
+	case captchaDriverString:
+		source := c.Source
+		if source == "" {
+			source = captchaDefaultSource
+		}
+		p.generator = base64Captcha.NewDriverString(height, width, int(c.NoiseCount), int(c.LineOptions), length, source, nil, nil, nil).ConvertFonts()
+	case captchaDriverMath:
+		p.generator = base64Captcha.NewDriverMath(height, width, int(c.NoiseCount), int(c.LineOptions), nil, nil, nil).ConvertFonts()
+	case captchaDriverChinese:
+		source := c.Source
+		if source == "" {
+			source = strings.Join(strings.Split(base64Captcha.TxtChineseCharaters, ""), ",")
+		}
+		p.generator = base64Captcha.NewDriverChinese(height, width, int(c.NoiseCount), int(c.LineOptions), length, source, nil, nil, []string{captchaChineseFont})
+	case captchaDriverAudio:
+		language := c.Language
+		if language == "" {
+			language = captchaDefaultLanguage
+		}
+		p.generator = base64Captcha.NewDriverAudio(length, language)
+	default:
+		return nil, fmt.Errorf("unknown captcha driver %q", c.Driver)
+	}
+	return p, nil
+}
+
+func orDefault(v, def int32) int32 {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
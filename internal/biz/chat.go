@@ -3,84 +3,546 @@ package biz
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/idgen"
+)
+
+var (
+	ErrChatContentEmpty      = kerrors.BadRequest("CHAT_CONTENT_EMPTY", "消息内容不能为空")
+	ErrChatContentTooLong    = kerrors.BadRequest("CHAT_CONTENT_TOO_LONG", "消息内容过长")
+	ErrChatToSelf            = kerrors.BadRequest("CHAT_TO_SELF", "不能给自己发消息")
+	ErrConversationNotFound  = kerrors.NotFound("CONVERSATION_NOT_FOUND", "会话不存在")
+	ErrConversationIDInvalid = kerrors.BadRequest("CONVERSATION_ID_INVALID", "会话ID格式错误")
+	ErrMessageNotFound       = kerrors.NotFound("MESSAGE_NOT_FOUND", "消息不存在")
+	ErrMessageNotSender      = kerrors.Forbidden("MESSAGE_NOT_SENDER", "只能操作自己发送的消息")
+	ErrMessageRecalled       = kerrors.BadRequest("MESSAGE_RECALLED", "消息已撤回")
+	ErrMessageRecallExpired  = kerrors.BadRequest("MESSAGE_RECALL_EXPIRED", "消息已超过可撤回时间")
+	ErrMessageEditExpired    = kerrors.BadRequest("MESSAGE_EDIT_EXPIRED", "消息已超过可编辑时间")
+)
+
+// MessageType 消息类型，文本之外的类型内容为 JSON，见 chat_message.go
+type MessageType string
+
+const (
+	MessageTypeText MessageType = "text"
+)
+
+// MessageStatus 单聊消息状态，群聊按成员已读位置聚合
+type MessageStatus int16
+
+const (
+	MessageStatusUnread MessageStatus = 0
+	MessageStatusRead   MessageStatus = 1
+)
+
+const (
+	chatMaxContentLength = 5000 // 按字符计
+	chatDefaultPageSize  = 20
+	chatMaxPageSize      = 100
+
+	chatDefaultRecallWindow = 2 * time.Minute
+	chatDefaultEditWindow   = 24 * time.Hour
+
+	privateConversationPrefix = "p_"
+	groupConversationPrefix   = "g_"
 )
 
 // Message 聊天消息领域模型
 type Message struct {
-	ID        string
-	FromUID   string
-	ToUID     string
+	ID             int64
+	ConversationID string
+	SenderID       int64
+	ReceiverID     int64 // 单聊接收者
+	GroupID        int64 // 群聊群组
+	Type           MessageType
+	Content        string
+	Status         MessageStatus // 单聊：0 未读, 1 已读
+	EditedAt       *time.Time
+	RecalledAt     *time.Time
+	CreatedAt      time.Time
+}
+
+// Recalled 消息是否已撤回
+func (m *Message) Recalled() bool {
+	return m.RecalledAt != nil
+}
+
+// MessageEdit 消息编辑历史，记录编辑前的内容
+type MessageEdit struct {
+	MessageID int64
 	Content   string
-	Status    int // 0: 未读, 1: 已读
-	CreatedAt time.Time
+	EditedAt  time.Time
+}
+
+// ReadReceipt 标记已读产生的回执
+type ReadReceipt struct {
+	ConversationID    string
+	ReaderID          int64
+	LastReadMessageID int64
+	NotifyUserIDs     []int64 // 需要收到回执的用户：本次被读消息的发送者及阅读者本人（多设备同步）
+}
+
+// MessageReceipt 单条消息的已读情况，单聊为 0/1
+type MessageReceipt struct {
+	MessageID   int64
+	ReadCount   int32
+	UnreadCount int32
+}
+
+// Conversation 用户视角的会话
+type Conversation struct {
+	UserID            int64
+	ConversationID    string
+	PeerID            int64 // 单聊对方
+	GroupID           int64 // 群聊群组
+	LastMessage       *Message
+	LastMessageAt     *time.Time
+	LastReadMessageID int64
+	UnreadCount       int32 // 群聊为已读位置之后他人发送的消息数
+}
+
+// MessageQuery 历史消息查询条件，按消息 ID 倒序
+type MessageQuery struct {
+	ConversationID string
+	BeforeID       int64 // 游标：只返回 ID 小于该值的消息，0 表示从最新开始
+	Limit          int
 }
 
 // ChatRepo 数据库操作接口（由 data 层实现）
 type ChatRepo interface {
+	// SaveMessage 保存消息，并更新发送方、接收方的会话（最后一条消息、接收方未读数）
 	SaveMessage(ctx context.Context, msg *Message) error
-	UpdateUserStatus(ctx context.Context, uid string, online bool) error
+	// SaveGroupMessage 保存群消息，并更新所有成员会话的最后一条消息、发送方的已读位置
+	SaveGroupMessage(ctx context.Context, msg *Message) error
+	GetConversation(ctx context.Context, userID int64, conversationID string) (*Conversation, error)
+	// ListConversations 按最后一条消息时间倒序，附带最后一条消息及群聊未读数
+	ListConversations(ctx context.Context, userID int64, page, pageSize int) ([]*Conversation, int64, error)
+	ListMessages(ctx context.Context, q *MessageQuery) ([]*Message, error)
+	// MarkConversationRead 清零未读数，已读位置更新到最后一条消息，单聊同时将收到的消息置为已读；返回更新前后的已读位置
+	MarkConversationRead(ctx context.Context, userID int64, conversationID string) (int64, int64, error)
+	// ListSenders 会话中 (afterID, uptoID] 区间内除 exclude 外的消息发送者
+	ListSenders(ctx context.Context, conversationID string, afterID, uptoID, exclude int64) ([]int64, error)
+	// CountReads 群聊中除发送者外已读到该消息的成员数及成员总数
+	CountReads(ctx context.Context, conversationID string, messageID, senderID int64) (int32, int32, error)
+	GetMessage(ctx context.Context, id int64) (*Message, error)
+	// RecallMessage 撤回消息并清空内容，已撤回时返回 ErrMessageRecalled
+	RecallMessage(ctx context.Context, id int64, at time.Time) error
+	// EditMessage 记录编辑前的内容并更新消息
+	EditMessage(ctx context.Context, id int64, content string, at time.Time) error
+	// ListMessageEdits 按编辑时间倒序
+	ListMessageEdits(ctx context.Context, messageID int64) ([]*MessageEdit, error)
+	// ListContacts candidates 中与 userID 有单聊会话或同在一个群组的用户
+	ListContacts(ctx context.Context, userID int64, candidates []int64) ([]int64, error)
 }
 
 // ChatUseCase 业务逻辑实现
 type ChatUseCase struct {
-	repo ChatRepo
-	log  *log.Helper
+	repo      ChatRepo
+	user      UserRepo
+	group     *GroupUseCase
+	sensitive *SensitiveUseCase
+	upload    *UploadUseCase
+	auth      auth.TokenService
+	idgen     idgen.IDGenerator
+	log       *log.Helper
+
+	recallWindow time.Duration
+	editWindow   time.Duration
 }
 
-func NewChatUseCase(repo ChatRepo, logger log.Logger) *ChatUseCase {
-	return &ChatUseCase{
-		repo: repo,
-		log:  log.NewHelper(log.With(logger, "module", "usecase/chat")),
+func NewChatUseCase(repo ChatRepo, user UserRepo, group *GroupUseCase, sensitive *SensitiveUseCase, upload *UploadUseCase, auth auth.TokenService, idgen idgen.IDGenerator, c *conf.App, logger log.Logger) *ChatUseCase {
+	uc := &ChatUseCase{
+		repo:         repo,
+		user:         user,
+		group:        group,
+		sensitive:    sensitive,
+		upload:       upload,
+		auth:         auth,
+		idgen:        idgen,
+		log:          log.NewHelper(log.With(logger, "module", "usecase/chat")),
+		recallWindow: chatDefaultRecallWindow,
+		editWindow:   chatDefaultEditWindow,
 	}
+	if c.Chat != nil {
+		if c.Chat.RecallWindow != nil && c.Chat.RecallWindow.AsDuration() > 0 {
+			uc.recallWindow = c.Chat.RecallWindow.AsDuration()
+		}
+		if c.Chat.EditWindow != nil && c.Chat.EditWindow.AsDuration() > 0 {
+			uc.editWindow = c.Chat.EditWindow.AsDuration()
+		}
+	}
+	return uc
 }
 
-// ProcessMessage 处理并存储消息
-func (uc *ChatUseCase) ProcessMessage(ctx context.Context, from, to, content string) (*Message, error) {
-	uc.log.Infof("Processing message from %s to %s", from, to)
+// PrivateConversationID 单聊会话 ID，与参与者顺序无关
+func PrivateConversationID(a, b int64) string {
+	if a > b {
+		a, b = b, a
+	}
+	return fmt.Sprintf("%s%d_%d", privateConversationPrefix, a, b)
+}
+
+// GroupConversationID 群聊会话 ID
+func GroupConversationID(groupID int64) string {
+	return groupConversationPrefix + strconv.FormatInt(groupID, 10)
+}
 
-	// 1. 业务校验：不能为空
-	if content == "" {
-		return nil, fmt.Errorf("content is empty")
+// ParseGroupConversationID 从群聊会话 ID 中解析群组 ID，不是群聊时返回 false
+func ParseGroupConversationID(id string) (int64, bool) {
+	if !strings.HasPrefix(id, groupConversationPrefix) {
+		return 0, false
 	}
+	groupID, err := strconv.ParseInt(strings.TrimPrefix(id, groupConversationPrefix), 10, 64)
+	return groupID, err == nil
+}
 
-	// 2. 业务校验：禁止给自己发消息
+// ProcessMessage 处理并存储单聊消息，返回的消息只填充公开附件的访问 URL，私有附件通过历史消息接口获取
+func (uc *ChatUseCase) ProcessMessage(ctx context.Context, from, to int64, msgType MessageType, content string) (*Message, error) {
+	// 1. 业务校验：禁止给自己发消息，接收者必须存在
 	if from == to {
-		return nil, fmt.Errorf("cannot send message to yourself")
+		return nil, ErrChatToSelf
+	}
+	if _, err := uc.user.GetUserByID(ctx, to); err != nil {
+		return nil, err
 	}
 
-	// 3. 敏感词过滤 (示例逻辑)
-	filteredContent := uc.filterSensitiveWords(content)
+	// 2. 构造消息实体
+	msg, err := uc.newMessage(ctx, from, msgType, content)
+	if err != nil {
+		return nil, err
+	}
+	msg.ConversationID = PrivateConversationID(from, to)
+	msg.ReceiverID = to
+
+	// 3. 持久化到数据库 (调用 data 层)
+	if err := uc.repo.SaveMessage(ctx, msg); err != nil {
+		uc.log.WithContext(ctx).Errorf("failed to save message: %v", err)
+		return nil, err
+	}
 
-	// 4. 构造消息实体
-	msg := &Message{
-		ID:        generateMsgID(), // 自行实现唯一 ID 生成
-		FromUID:   from,
-		ToUID:     to,
-		Content:   filteredContent,
-		Status:    0,
+	// 单聊消息经信箱可靠投递，保存时间远超签名 URL 的有效期，私有附件只返回 key
+	return uc.render(ctx, msg, false), nil
+}
+
+// ProcessGroupMessage 处理并存储群消息，每次发送都校验成员身份及禁言，返回消息及需要投递的群成员
+func (uc *ChatUseCase) ProcessGroupMessage(ctx context.Context, from, groupID int64, msgType MessageType, content string) (*Message, []int64, error) {
+	// 1. 业务校验：必须是群成员且未被禁言
+	if err := uc.group.CheckSend(ctx, groupID, from); err != nil {
+		return nil, nil, err
+	}
+
+	// 2. 构造消息实体
+	msg, err := uc.newMessage(ctx, from, msgType, content)
+	if err != nil {
+		return nil, nil, err
+	}
+	msg.ConversationID = GroupConversationID(groupID)
+	msg.GroupID = groupID
+
+	// 3. 持久化到数据库
+	if err := uc.repo.SaveGroupMessage(ctx, msg); err != nil {
+		uc.log.WithContext(ctx).Errorf("failed to save group message: %v", err)
+		return nil, nil, err
+	}
+
+	members, err := uc.group.MemberIDs(ctx, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return uc.render(ctx, msg, true), members, nil
+}
+
+// newMessage 按类型校验内容并构造消息，未指定类型时为文本
+func (uc *ChatUseCase) newMessage(ctx context.Context, from int64, msgType MessageType, content string) (*Message, error) {
+	if msgType == "" {
+		msgType = MessageTypeText
+	}
+	content, err := uc.buildContent(ctx, from, msgType, content)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uc.idgen.NextID()
+	if err != nil {
+		return nil, err
+	}
+	return &Message{
+		ID:        id,
+		SenderID:  from,
+		Type:      msgType,
+		Content:   content,
 		CreatedAt: time.Now(),
+	}, nil
+}
+
+// validateContent 不能为空、不能过长
+func validateContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return ErrChatContentEmpty
+	}
+	if len([]rune(content)) > chatMaxContentLength {
+		return ErrChatContentTooLong
+	}
+	return nil
+}
+
+// ListConversations 当前用户的会话列表
+func (uc *ChatUseCase) ListConversations(ctx context.Context, page, pageSize int) ([]*Conversation, int64, error) {
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if page <= 0 {
+		page = 1
+	}
+	pageSize = normalizeChatPageSize(pageSize)
+	list, total, err := uc.repo.ListConversations(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, c := range list {
+		c.LastMessage = uc.render(ctx, c.LastMessage, true)
+	}
+	return list, total, nil
+}
+
+// ListMessages 按游标倒序分页查询会话的历史消息，返回下一页游标，没有更多时为 0
+func (uc *ChatUseCase) ListMessages(ctx context.Context, conversationID string, beforeID int64, limit int) ([]*Message, int64, error) {
+	if _, err := uc.currentConversation(ctx, conversationID); err != nil {
+		return nil, 0, err
+	}
+	limit = normalizeChatPageSize(limit)
+	// 多取一条判断是否还有更多
+	list, err := uc.repo.ListMessages(ctx, &MessageQuery{
+		ConversationID: conversationID,
+		BeforeID:       beforeID,
+		Limit:          limit + 1,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	var next int64
+	if len(list) > limit {
+		list = list[:limit]
+		next = list[limit-1].ID
+	}
+	return uc.renderAll(ctx, list), next, nil
+}
+
+// CurrentUserID 当前登录用户
+func (uc *ChatUseCase) CurrentUserID(ctx context.Context) (int64, error) {
+	return uc.auth.GetUserIDFromContext(ctx)
+}
+
+// MarkConversationRead 将用户的会话标记为已读，返回需要推送的已读回执，没有新的已读消息时为 nil
+func (uc *ChatUseCase) MarkConversationRead(ctx context.Context, userID int64, conversationID string) (*ReadReceipt, error) {
+	if !validConversationID(conversationID) {
+		return nil, ErrConversationIDInvalid
+	}
+	if _, err := uc.repo.GetConversation(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+	before, after, err := uc.repo.MarkConversationRead(ctx, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if after <= before {
+		return nil, nil
 	}
+	senders, err := uc.repo.ListSenders(ctx, conversationID, before, after, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadReceipt{
+		ConversationID:    conversationID,
+		ReaderID:          userID,
+		LastReadMessageID: after,
+		NotifyUserIDs:     append(senders, userID),
+	}, nil
+}
 
-	// 5. 持久化到数据库 (调用 data 层)
-	err := uc.repo.SaveMessage(ctx, msg)
+// GetMessageReceipt 消息的已读情况，单聊为对方是否已读，群聊为已读、未读成员数
+func (uc *ChatUseCase) GetMessageReceipt(ctx context.Context, messageID int64) (*MessageReceipt, error) {
+	msg, err := uc.currentMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	receipt := &MessageReceipt{MessageID: msg.ID}
+	if msg.GroupID == 0 {
+		if msg.Status == MessageStatusRead {
+			receipt.ReadCount = 1
+		} else {
+			receipt.UnreadCount = 1
+		}
+		return receipt, nil
+	}
+	read, total, err := uc.repo.CountReads(ctx, msg.ConversationID, msg.ID, msg.SenderID)
 	if err != nil {
-		uc.log.Errorf("failed to save message: %v", err)
 		return nil, err
 	}
+	receipt.ReadCount = read
+	receipt.UnreadCount = total - read
+	return receipt, nil
+}
 
+// RecallMessage 发送者在可撤回时间内撤回消息，返回撤回后的消息及需要通知的会话参与者
+func (uc *ChatUseCase) RecallMessage(ctx context.Context, userID, messageID int64) (*Message, []int64, error) {
+	msg, err := uc.ownMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	if now.Sub(msg.CreatedAt) > uc.recallWindow {
+		return nil, nil, ErrMessageRecallExpired
+	}
+	if err := uc.repo.RecallMessage(ctx, msg.ID, now); err != nil {
+		return nil, nil, err
+	}
+	msg.Content = ""
+	msg.RecalledAt = &now
+	participants, err := uc.participants(ctx, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, participants, nil
+}
+
+// EditMessage 发送者在可编辑时间内修改文本消息，编辑前的内容记入历史，返回修改后的消息及需要通知的会话参与者
+func (uc *ChatUseCase) EditMessage(ctx context.Context, userID, messageID int64, content string) (*Message, []int64, error) {
+	if err := validateContent(content); err != nil {
+		return nil, nil, err
+	}
+	msg, err := uc.ownMessage(ctx, userID, messageID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if msg.Type != MessageTypeText {
+		return nil, nil, ErrMessageNotEditable
+	}
+	now := time.Now()
+	if now.Sub(msg.CreatedAt) > uc.editWindow {
+		return nil, nil, ErrMessageEditExpired
+	}
+	content, err = uc.sensitive.CheckText(ctx, SensitiveSceneChat, userID, content)
+	if err != nil {
+		return nil, nil, err
+	}
+	if content == msg.Content {
+		return msg, nil, nil
+	}
+	if err := uc.repo.EditMessage(ctx, msg.ID, content, now); err != nil {
+		return nil, nil, err
+	}
+	msg.Content = content
+	msg.EditedAt = &now
+	participants, err := uc.participants(ctx, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, participants, nil
+}
+
+// ListMessageEdits 会话参与者查看消息的编辑历史，撤回后不再可见
+func (uc *ChatUseCase) ListMessageEdits(ctx context.Context, messageID int64) ([]*MessageEdit, error) {
+	msg, err := uc.currentMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Recalled() {
+		return nil, ErrMessageRecalled
+	}
+	return uc.repo.ListMessageEdits(ctx, msg.ID)
+}
+
+// currentMessage 当前用户参与会话中的消息，未参与时返回 ErrMessageNotFound
+func (uc *ChatUseCase) currentMessage(ctx context.Context, messageID int64) (*Message, error) {
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := uc.repo.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.repo.GetConversation(ctx, userID, msg.ConversationID); err != nil {
+		if kerrors.Is(err, ErrConversationNotFound) {
+			return nil, ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return msg, nil
+}
+
+// ownMessage 用户自己发送且未撤回的消息
+func (uc *ChatUseCase) ownMessage(ctx context.Context, userID, messageID int64) (*Message, error) {
+	msg, err := uc.repo.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.SenderID != userID {
+		return nil, ErrMessageNotSender
+	}
+	if msg.Recalled() {
+		return nil, ErrMessageRecalled
+	}
+	// 群消息：被移出群后不能再撤回、编辑
+	if msg.GroupID > 0 {
+		if err := uc.group.CheckMember(ctx, msg.GroupID, userID); err != nil {
+			return nil, err
+		}
+	}
 	return msg, nil
 }
 
-// 模拟敏感词过滤
-func (uc *ChatUseCase) filterSensitiveWords(content string) string {
-	// 实际开发中可以接入之前提到的 DFA 算法过滤工具
-	return content
+// participants 消息所在会话的参与者
+func (uc *ChatUseCase) participants(ctx context.Context, msg *Message) ([]int64, error) {
+	if msg.GroupID > 0 {
+		return uc.group.MemberIDs(ctx, msg.GroupID)
+	}
+	return []int64{msg.SenderID, msg.ReceiverID}, nil
 }
 
-func generateMsgID() string {
-	// 实际应使用 Snowflake 或 UUID
-	return time.Now().Format("20060102150405")
+// currentConversation 当前用户参与的会话，未参与时返回 ErrConversationNotFound
+func (uc *ChatUseCase) currentConversation(ctx context.Context, conversationID string) (*Conversation, error) {
+	if !validConversationID(conversationID) {
+		return nil, ErrConversationIDInvalid
+	}
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.GetConversation(ctx, userID, conversationID)
+}
+
+func validConversationID(id string) bool {
+	if strings.HasPrefix(id, groupConversationPrefix) {
+		_, ok := ParseGroupConversationID(id)
+		return ok
+	}
+	parts := strings.Split(strings.TrimPrefix(id, privateConversationPrefix), "_")
+	if !strings.HasPrefix(id, privateConversationPrefix) || len(parts) != 2 {
+		return false
+	}
+	for _, p := range parts {
+		if _, err := strconv.ParseInt(p, 10, 64); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeChatPageSize(size int) int {
+	if size <= 0 {
+		return chatDefaultPageSize
+	}
+	if size > chatMaxPageSize {
+		return chatMaxPageSize
+	}
+	return size
 }
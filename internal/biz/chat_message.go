@@ -0,0 +1,264 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+)
+
+var (
+	ErrChatMessageTypeInvalid = kerrors.BadRequest("CHAT_MESSAGE_TYPE_INVALID", "不支持的消息类型")
+	ErrChatContentInvalid     = kerrors.BadRequest("CHAT_CONTENT_INVALID", "消息内容格式错误")
+	ErrChatAttachmentInvalid  = kerrors.BadRequest("CHAT_ATTACHMENT_INVALID", "附件类型与消息类型不符")
+	ErrMessageNotEditable     = kerrors.BadRequest("MESSAGE_NOT_EDITABLE", "只能编辑文本消息")
+)
+
+const (
+	MessageTypeImage    MessageType = "image"
+	MessageTypeFile     MessageType = "file"
+	MessageTypeVoice    MessageType = "voice"
+	MessageTypeLocation MessageType = "location"
+	MessageTypeCard     MessageType = "card" // 自定义 JSON 卡片，由客户端约定结构
+)
+
+const (
+	chatMaxVoiceDuration = 300 // 语音最长秒数
+	chatMaxLocationText  = 200 // 位置名称、地址最大字符数
+)
+
+// ImageContent 图片消息内容，尺寸取自上传记录
+type ImageContent struct {
+	Key              string `json:"key"`
+	URL              string `json:"url,omitempty"`
+	ThumbnailKey     string `json:"thumbnail_key,omitempty"`
+	ThumbnailURL     string `json:"thumbnail_url,omitempty"`
+	Width            int32  `json:"width"`
+	Height           int32  `json:"height"`
+	Size             int64  `json:"size"`
+	Private          bool   `json:"private"`
+	ThumbnailPrivate bool   `json:"thumbnail_private,omitempty"`
+}
+
+// FileContent 文件消息内容
+type FileContent struct {
+	Key         string `json:"key"`
+	URL         string `json:"url,omitempty"`
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Private     bool   `json:"private"`
+}
+
+// VoiceContent 语音消息内容
+type VoiceContent struct {
+	Key      string `json:"key"`
+	URL      string `json:"url,omitempty"`
+	Duration int32  `json:"duration"` // 秒
+	Size     int64  `json:"size"`
+	Private  bool   `json:"private"`
+}
+
+// LocationContent 位置消息内容
+type LocationContent struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name,omitempty"`
+	Address   string  `json:"address,omitempty"`
+}
+
+// buildContent 按消息类型校验内容，返回存储的内容：文本原样，其余为 JSON；
+// 附件必须是发送者自己上传的文件，大小、尺寸等以上传记录为准
+func (uc *ChatUseCase) buildContent(ctx context.Context, from int64, msgType MessageType, content string) (string, error) {
+	if msgType == MessageTypeText {
+		if err := validateContent(content); err != nil {
+			return "", err
+		}
+		return uc.sensitive.CheckText(ctx, SensitiveSceneChat, from, content)
+	}
+	if strings.TrimSpace(content) == "" {
+		return "", ErrChatContentEmpty
+	}
+	if len(content) > chatMaxContentLength {
+		return "", ErrChatContentTooLong
+	}
+
+	var body interface{}
+	switch msgType {
+	case MessageTypeImage:
+		var c ImageContent
+		if err := decodeContent(content, &c); err != nil {
+			return "", err
+		}
+		f, err := uc.attachment(ctx, from, c.Key, "image/")
+		if err != nil {
+			return "", err
+		}
+		c = ImageContent{Key: f.FileKey, Width: f.Width, Height: f.Height, Size: f.Size, Private: f.IsPrivate, ThumbnailKey: c.ThumbnailKey}
+		if c.ThumbnailKey != "" {
+			thumb, err := uc.attachment(ctx, from, c.ThumbnailKey, "image/")
+			if err != nil {
+				return "", err
+			}
+			c.ThumbnailPrivate = thumb.IsPrivate
+		}
+		body = c
+	case MessageTypeFile:
+		var c FileContent
+		if err := decodeContent(content, &c); err != nil {
+			return "", err
+		}
+		f, err := uc.attachment(ctx, from, c.Key, "")
+		if err != nil {
+			return "", err
+		}
+		// 允许发送者重命名，默认使用上传时的文件名
+		name := f.Name
+		if n := strings.TrimSpace(filepath.Base(c.Name)); c.Name != "" && n != "." && n != "/" {
+			name = n
+		}
+		if err := uc.sensitive.CheckName(ctx, SensitiveSceneChat, from, name); err != nil {
+			return "", err
+		}
+		body = FileContent{Key: f.FileKey, Name: name, ContentType: f.ContentType, Size: f.Size, Private: f.IsPrivate}
+	case MessageTypeVoice:
+		var c VoiceContent
+		if err := decodeContent(content, &c); err != nil {
+			return "", err
+		}
+		if c.Duration <= 0 || c.Duration > chatMaxVoiceDuration {
+			return "", ErrChatContentInvalid
+		}
+		f, err := uc.attachment(ctx, from, c.Key, "audio/")
+		if err != nil {
+			return "", err
+		}
+		body = VoiceContent{Key: f.FileKey, Duration: c.Duration, Size: f.Size, Private: f.IsPrivate}
+	case MessageTypeLocation:
+		var c LocationContent
+		if err := decodeContent(content, &c); err != nil {
+			return "", err
+		}
+		if c.Latitude < -90 || c.Latitude > 90 || c.Longitude < -180 || c.Longitude > 180 ||
+			len([]rune(c.Name)) > chatMaxLocationText || len([]rune(c.Address)) > chatMaxLocationText {
+			return "", ErrChatContentInvalid
+		}
+		var err error
+		if c.Name, err = uc.sensitive.CheckText(ctx, SensitiveSceneChat, from, c.Name); err != nil {
+			return "", err
+		}
+		if c.Address, err = uc.sensitive.CheckText(ctx, SensitiveSceneChat, from, c.Address); err != nil {
+			return "", err
+		}
+		body = c
+	case MessageTypeCard:
+		// 卡片结构由客户端约定，只要求是 JSON 对象；掩码可能破坏 JSON 结构，命中即拒绝
+		var c map[string]json.RawMessage
+		if err := decodeContent(content, &c); err != nil {
+			return "", err
+		}
+		if c == nil {
+			return "", ErrChatContentInvalid
+		}
+		if err := uc.sensitive.CheckName(ctx, SensitiveSceneChat, from, content); err != nil {
+			return "", err
+		}
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, []byte(content)); err != nil {
+			return "", ErrChatContentInvalid
+		}
+		return buf.String(), nil
+	default:
+		return "", ErrChatMessageTypeInvalid
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// attachment 发送者自己上传的文件，typePrefix 非空时要求文件类型匹配
+func (uc *ChatUseCase) attachment(ctx context.Context, from int64, key, typePrefix string) (*UploadedFile, error) {
+	if key == "" {
+		return nil, ErrChatContentInvalid
+	}
+	f, err := uc.upload.OwnedFile(ctx, from, key)
+	if err != nil {
+		return nil, err
+	}
+	if typePrefix != "" && !strings.HasPrefix(f.ContentType, typePrefix) {
+		return nil, ErrChatAttachmentInvalid
+	}
+	return f, nil
+}
+
+// render 为附件消息填充访问 URL，存储的内容不变；signPrivate 为 false 时私有文件不生成临时签名 URL，
+// 用于会长期保存的推送（如离线补发的 new_chat），客户端收到后通过历史消息接口获取
+func (uc *ChatUseCase) render(ctx context.Context, msg *Message, signPrivate bool) *Message {
+	if msg == nil || msg.Recalled() || msg.Content == "" {
+		return msg
+	}
+	url := func(key string, private bool) string {
+		if private && !signPrivate {
+			return ""
+		}
+		return uc.upload.GenerateURL(ctx, key, private)
+	}
+	var body interface{}
+	switch msg.Type {
+	case MessageTypeImage:
+		var c ImageContent
+		if json.Unmarshal([]byte(msg.Content), &c) != nil {
+			return msg
+		}
+		c.URL = url(c.Key, c.Private)
+		if c.ThumbnailKey != "" {
+			c.ThumbnailURL = url(c.ThumbnailKey, c.ThumbnailPrivate)
+		}
+		body = c
+	case MessageTypeFile:
+		var c FileContent
+		if json.Unmarshal([]byte(msg.Content), &c) != nil {
+			return msg
+		}
+		c.URL = url(c.Key, c.Private)
+		body = c
+	case MessageTypeVoice:
+		var c VoiceContent
+		if json.Unmarshal([]byte(msg.Content), &c) != nil {
+			return msg
+		}
+		c.URL = url(c.Key, c.Private)
+		body = c
+	default:
+		return msg
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return msg
+	}
+	rendered := *msg
+	rendered.Content = string(b)
+	return &rendered
+}
+
+// renderAll 批量填充附件访问 URL
+func (uc *ChatUseCase) renderAll(ctx context.Context, list []*Message) []*Message {
+	for i, m := range list {
+		list[i] = uc.render(ctx, m, true)
+	}
+	return list
+}
+
+// decodeContent 解析 JSON 内容，格式错误时返回 ErrChatContentInvalid
+func decodeContent(content string, v interface{}) error {
+	if err := json.Unmarshal([]byte(content), v); err != nil {
+		return ErrChatContentInvalid
+	}
+	return nil
+}
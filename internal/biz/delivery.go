@@ -0,0 +1,201 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/email"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/sms"
+)
+
+type DeliveryChannel string
+
+const (
+	DeliveryChannelSms   DeliveryChannel = "sms"
+	DeliveryChannelEmail DeliveryChannel = "email"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusSent        DeliveryStatus = "sent"        // 供应商已受理
+	DeliveryStatusFailed      DeliveryStatus = "failed"      // 提交供应商失败
+	DeliveryStatusDelivered   DeliveryStatus = "delivered"   // 回执：已送达
+	DeliveryStatusUndelivered DeliveryStatus = "undelivered" // 回执：送达失败
+)
+
+const (
+	deliveryDefaultPageSize = 20
+	deliveryMaxPageSize     = 100
+)
+
+// Delivery 一次短信/邮件投递记录
+type Delivery struct {
+	ID                int64
+	Channel           DeliveryChannel
+	Receiver          string // 脱敏后的接收方
+	ReceiverHash      string
+	Template          string
+	Provider          string
+	ProviderMessageID string // 供应商消息 ID，用于匹配回执
+	ProviderRequestID string // 供应商请求 ID，用于排查问题
+	Status            DeliveryStatus
+	Error             string
+	ReportedAt        *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// DeliveryQuery 投递记录查询条件
+type DeliveryQuery struct {
+	Channel      DeliveryChannel
+	ReceiverHash string
+	Start        time.Time
+	End          time.Time
+	Page         int
+	PageSize     int
+}
+
+// DeliveryReport 供应商回执，用于更新投递状态
+type DeliveryReport struct {
+	Provider          string
+	ProviderMessageID string
+	Status            DeliveryStatus
+	Error             string
+	ReportedAt        time.Time
+}
+
+type DeliveryRepo interface {
+	CreateDelivery(ctx context.Context, d *Delivery) error
+	// UpdateDeliveryReport 按供应商及消息 ID 更新回执状态，返回受影响的记录数
+	UpdateDeliveryReport(ctx context.Context, r *DeliveryReport) (int64, error)
+	ListDeliveries(ctx context.Context, q *DeliveryQuery) ([]*Delivery, int64, error)
+}
+
+type DeliveryUseCase struct {
+	repo DeliveryRepo
+	log  *log.Helper
+}
+
+func NewDeliveryUseCase(repo DeliveryRepo, logger log.Logger) *DeliveryUseCase {
+	return &DeliveryUseCase{repo: repo, log: log.NewHelper(log.With(logger, "module", "biz/delivery"))}
+}
+
+// NewSmsRecorder 短信发送结果落库
+func NewSmsRecorder(uc *DeliveryUseCase) sms.Recorder {
+	return sms.RecorderFunc(uc.RecordSms)
+}
+
+// NewEmailRecorder 邮件发送结果落库
+func NewEmailRecorder(uc *DeliveryUseCase) email.Recorder {
+	return email.RecorderFunc(uc.RecordEmail)
+}
+
+// RecordSms 记录一次短信供应商发送尝试，记录失败不影响发送流程
+func (uc *DeliveryUseCase) RecordSms(ctx context.Context, a *sms.Attempt) {
+	d := &Delivery{
+		Channel:      DeliveryChannelSms,
+		Receiver:     sms.MaskPhone(a.Phone),
+		ReceiverHash: ReceiverHash(a.Phone),
+		Template:     a.Template,
+		Provider:     a.Provider,
+		Status:       DeliveryStatusSent,
+	}
+	if a.Receipt != nil {
+		d.ProviderMessageID = a.Receipt.MessageID
+		d.ProviderRequestID = a.Receipt.RequestID
+	}
+	if a.Err != nil {
+		d.Status = DeliveryStatusFailed
+		d.Error = a.Err.Error()
+		uc.log.WithContext(ctx).Warnf("短信发送失败: phone=%s template=%s provider=%s cost=%v err=%v",
+			d.Receiver, a.Template, a.Provider, a.Cost, a.Err)
+	}
+	uc.save(ctx, d)
+}
+
+// RecordEmail 记录一次邮件发送结果，记录失败不影响发送流程
+func (uc *DeliveryUseCase) RecordEmail(ctx context.Context, a *email.Attempt) {
+	d := &Delivery{
+		Channel:      DeliveryChannelEmail,
+		Receiver:     email.MaskEmail(a.To),
+		ReceiverHash: ReceiverHash(a.To),
+		Template:     a.Template,
+		Provider:     a.Provider,
+		Status:       DeliveryStatusSent,
+	}
+	if a.Err != nil {
+		d.Status = DeliveryStatusFailed
+		d.Error = a.Err.Error()
+		uc.log.WithContext(ctx).Warnf("邮件发送失败: to=%s template=%s provider=%s cost=%v err=%v",
+			d.Receiver, a.Template, a.Provider, a.Cost, a.Err)
+	}
+	uc.save(ctx, d)
+}
+
+func (uc *DeliveryUseCase) save(ctx context.Context, d *Delivery) {
+	if err := uc.repo.CreateDelivery(ctx, d); err != nil {
+		uc.log.WithContext(ctx).Errorf("保存投递记录失败: channel=%s receiver=%s err=%v", d.Channel, d.Receiver, err)
+	}
+}
+
+// ApplySmsReports 处理供应商推送的短信状态回执
+func (uc *DeliveryUseCase) ApplySmsReports(ctx context.Context, reports []*sms.Report) error {
+	for _, r := range reports {
+		if r.MessageID == "" {
+			continue
+		}
+		report := &DeliveryReport{
+			Provider:          r.Provider,
+			ProviderMessageID: r.MessageID,
+			Status:            DeliveryStatusDelivered,
+			ReportedAt:        r.ReportTime,
+		}
+		if !r.Success {
+			report.Status = DeliveryStatusUndelivered
+			report.Error = strings.TrimSpace(r.ErrCode + " " + r.ErrMsg)
+		}
+		n, err := uc.repo.UpdateDeliveryReport(ctx, report)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			uc.log.WithContext(ctx).Warnf("未找到回执对应的投递记录: provider=%s message_id=%s", r.Provider, r.MessageID)
+		}
+	}
+	return nil
+}
+
+// ListDeliveries 按接收方及时间范围查询投递记录，receiver 为原始手机号或邮箱
+func (uc *DeliveryUseCase) ListDeliveries(ctx context.Context, channel DeliveryChannel, receiver string, start, end time.Time, page, pageSize int) ([]*Delivery, int64, error) {
+	q := &DeliveryQuery{
+		Channel:  channel,
+		Start:    start,
+		End:      end,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if receiver != "" {
+		q.ReceiverHash = ReceiverHash(receiver)
+	}
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+	if q.PageSize <= 0 {
+		q.PageSize = deliveryDefaultPageSize
+	}
+	if q.PageSize > deliveryMaxPageSize {
+		q.PageSize = deliveryMaxPageSize
+	}
+	return uc.repo.ListDeliveries(ctx, q)
+}
+
+// ReceiverHash 接收方哈希，脱敏存储的同时支持精确查询
+func ReceiverHash(receiver string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(receiver))))
+	return hex.EncodeToString(sum[:])
+}
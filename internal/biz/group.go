@@ -0,0 +1,440 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
+)
+
+var (
+	ErrGroupNotFound         = kerrors.NotFound("GROUP_NOT_FOUND", "群组不存在或已解散")
+	ErrGroupNameInvalid      = kerrors.BadRequest("GROUP_NAME_INVALID", "群名称长度为 1-64 个字符")
+	ErrGroupFull             = kerrors.BadRequest("GROUP_FULL", "群成员已达上限")
+	ErrGroupRoleInvalid      = kerrors.BadRequest("GROUP_ROLE_INVALID", "角色只能设置为 admin 或 member")
+	ErrGroupOwnerCannotLeave = kerrors.BadRequest("GROUP_OWNER_CANNOT_LEAVE", "群主不能退出群组，请解散群组")
+	ErrNotGroupMember        = kerrors.Forbidden("GROUP_NOT_MEMBER", "不是群成员")
+	ErrGroupMemberNotFound   = kerrors.NotFound("GROUP_MEMBER_NOT_FOUND", "该用户不是群成员")
+	ErrGroupPermissionDenied = kerrors.Forbidden("GROUP_PERMISSION_DENIED", "没有权限执行该操作")
+	ErrGroupMemberMuted      = kerrors.Forbidden("GROUP_MEMBER_MUTED", "你已被禁言")
+)
+
+type GroupRole string
+
+const (
+	GroupRoleOwner  GroupRole = "owner"
+	GroupRoleAdmin  GroupRole = "admin"
+	GroupRoleMember GroupRole = "member"
+)
+
+// rank 角色等级，高等级可管理低等级成员
+func (r GroupRole) rank() int {
+	switch r {
+	case GroupRoleOwner:
+		return 3
+	case GroupRoleAdmin:
+		return 2
+	default:
+		return 1
+	}
+}
+
+const (
+	// ActionGroupEvent 群组变更的 WebSocket 推送动作
+	ActionGroupEvent = "group_event"
+
+	groupDefaultMaxMembers = 500
+	groupMaxNameLength     = 64
+)
+
+// 群组事件
+const (
+	GroupEventCreated     = "created"
+	GroupEventJoined      = "joined"
+	GroupEventKicked      = "kicked"
+	GroupEventLeft        = "left"
+	GroupEventDisbanded   = "disbanded"
+	GroupEventRoleChanged = "role_changed"
+	GroupEventMuted       = "muted"
+)
+
+// Group 群组
+type Group struct {
+	ID          int64
+	Name        string
+	OwnerID     int64
+	MemberCount int32
+	MaxMembers  int32
+	CreatedAt   time.Time
+}
+
+// GroupMember 群成员
+type GroupMember struct {
+	GroupID    int64
+	UserID     int64
+	Role       GroupRole
+	MutedUntil *time.Time
+	JoinedAt   time.Time
+}
+
+// Muted 当前是否处于禁言中
+func (m *GroupMember) Muted(now time.Time) bool {
+	return m.MutedUntil != nil && m.MutedUntil.After(now)
+}
+
+// groupEvent 群组事件的推送内容
+type groupEvent struct {
+	GroupID    int64   `json:"group_id,string"`
+	Event      string  `json:"event"`
+	UserIDs    []int64 `json:"user_ids,omitempty"`
+	OperatorID int64   `json:"operator_id,string"`
+	Role       string  `json:"role,omitempty"`
+	MutedUntil int64   `json:"muted_until,omitempty"`
+}
+
+type GroupRepo interface {
+	// CreateGroup 创建群组及初始成员，并为每个成员创建会话
+	CreateGroup(ctx context.Context, g *Group, members []*GroupMember) error
+	GetGroup(ctx context.Context, id int64) (*Group, error)
+	// DeleteGroup 解散群组，删除成员及其会话
+	DeleteGroup(ctx context.Context, id int64) error
+	GetMember(ctx context.Context, groupID, userID int64) (*GroupMember, error)
+	ListMembers(ctx context.Context, groupID int64) ([]*GroupMember, error)
+	// AddMembers 加入成员并创建会话，已是成员的忽略；超出成员上限时返回 ErrGroupFull；返回实际加入的用户
+	AddMembers(ctx context.Context, groupID int64, members []*GroupMember) ([]int64, error)
+	// RemoveMember 移除成员及其会话
+	RemoveMember(ctx context.Context, groupID, userID int64) error
+	UpdateMemberRole(ctx context.Context, groupID, userID int64, role GroupRole) error
+	UpdateMemberMute(ctx context.Context, groupID, userID int64, until *time.Time) error
+}
+
+// GroupRooms 群组的实时订阅房间，成员资格失效时将用户的在线连接移出，集群模式下包括其他实例上的连接
+type GroupRooms interface {
+	Leave(groupID int64, userIDs []int64)
+}
+
+type GroupUseCase struct {
+	repo       GroupRepo
+	user       UserRepo
+	auth       auth.TokenService
+	pusher     NotificationPusher
+	rooms      GroupRooms
+	maxMembers int32
+	log        *log.Helper
+}
+
+func NewGroupUseCase(repo GroupRepo, user UserRepo, auth auth.TokenService, pusher NotificationPusher, rooms GroupRooms, c *conf.App, logger log.Logger) *GroupUseCase {
+	uc := &GroupUseCase{
+		repo:       repo,
+		user:       user,
+		auth:       auth,
+		pusher:     pusher,
+		rooms:      rooms,
+		maxMembers: groupDefaultMaxMembers,
+		log:        log.NewHelper(log.With(logger, "module", "usecase/group")),
+	}
+	if c.Chat != nil && c.Chat.GroupMaxMembers > 0 {
+		uc.maxMembers = c.Chat.GroupMaxMembers
+	}
+	return uc
+}
+
+// CreateGroup 当前用户创建群组并成为群主
+func (uc *GroupUseCase) CreateGroup(ctx context.Context, name string, memberIDs []int64) (*Group, error) {
+	ownerID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > groupMaxNameLength {
+		return nil, ErrGroupNameInvalid
+	}
+	memberIDs = uniqueIDs(memberIDs, ownerID)
+	if int32(len(memberIDs)+1) > uc.maxMembers {
+		return nil, ErrGroupFull
+	}
+	if err := uc.checkUsers(ctx, memberIDs); err != nil {
+		return nil, err
+	}
+
+	g := &Group{
+		Name:        name,
+		OwnerID:     ownerID,
+		MemberCount: int32(len(memberIDs) + 1),
+		MaxMembers:  uc.maxMembers,
+	}
+	members := []*GroupMember{{UserID: ownerID, Role: GroupRoleOwner}}
+	for _, id := range memberIDs {
+		members = append(members, &GroupMember{UserID: id, Role: GroupRoleMember})
+	}
+	if err := uc.repo.CreateGroup(ctx, g, members); err != nil {
+		return nil, err
+	}
+	uc.notify(append(memberIDs, ownerID), &groupEvent{GroupID: g.ID, Event: GroupEventCreated, UserIDs: memberIDs, OperatorID: ownerID})
+	return g, nil
+}
+
+// GetGroup 群成员查看群组信息
+func (uc *GroupUseCase) GetGroup(ctx context.Context, groupID int64) (*Group, error) {
+	if _, err := uc.currentMember(ctx, groupID); err != nil {
+		return nil, err
+	}
+	return uc.repo.GetGroup(ctx, groupID)
+}
+
+// DisbandGroup 群主解散群组
+func (uc *GroupUseCase) DisbandGroup(ctx context.Context, groupID int64) error {
+	op, err := uc.currentMember(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if op.Role != GroupRoleOwner {
+		return ErrGroupPermissionDenied
+	}
+	members, err := uc.MemberIDs(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteGroup(ctx, groupID); err != nil {
+		return err
+	}
+	uc.rooms.Leave(groupID, members)
+	uc.notify(members, &groupEvent{GroupID: groupID, Event: GroupEventDisbanded, OperatorID: op.UserID})
+	return nil
+}
+
+// ListMembers 群成员查看成员列表
+func (uc *GroupUseCase) ListMembers(ctx context.Context, groupID int64) ([]*GroupMember, error) {
+	if _, err := uc.currentMember(ctx, groupID); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListMembers(ctx, groupID)
+}
+
+// InviteMembers 群成员邀请用户入群，返回实际加入的用户
+func (uc *GroupUseCase) InviteMembers(ctx context.Context, groupID int64, userIDs []int64) ([]int64, error) {
+	op, err := uc.currentMember(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	userIDs = uniqueIDs(userIDs, op.UserID)
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	if err := uc.checkUsers(ctx, userIDs); err != nil {
+		return nil, err
+	}
+	members := make([]*GroupMember, 0, len(userIDs))
+	for _, id := range userIDs {
+		members = append(members, &GroupMember{GroupID: groupID, UserID: id, Role: GroupRoleMember})
+	}
+	added, err := uc.repo.AddMembers(ctx, groupID, members)
+	if err != nil || len(added) == 0 {
+		return added, err
+	}
+	if all, err := uc.MemberIDs(ctx, groupID); err == nil {
+		uc.notify(all, &groupEvent{GroupID: groupID, Event: GroupEventJoined, UserIDs: added, OperatorID: op.UserID})
+	}
+	return added, nil
+}
+
+// KickMember 群主、管理员移出角色低于自己的成员
+func (uc *GroupUseCase) KickMember(ctx context.Context, groupID, userID int64) error {
+	op, target, err := uc.manage(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.RemoveMember(ctx, groupID, target.UserID); err != nil {
+		return err
+	}
+	uc.rooms.Leave(groupID, []int64{target.UserID})
+	uc.notifyGroup(ctx, groupID, []int64{target.UserID}, &groupEvent{GroupID: groupID, Event: GroupEventKicked, UserIDs: []int64{target.UserID}, OperatorID: op.UserID})
+	return nil
+}
+
+// LeaveGroup 当前用户退出群组，群主只能解散
+func (uc *GroupUseCase) LeaveGroup(ctx context.Context, groupID int64) error {
+	m, err := uc.currentMember(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if m.Role == GroupRoleOwner {
+		return ErrGroupOwnerCannotLeave
+	}
+	if err := uc.repo.RemoveMember(ctx, groupID, m.UserID); err != nil {
+		return err
+	}
+	uc.rooms.Leave(groupID, []int64{m.UserID})
+	uc.notifyGroup(ctx, groupID, []int64{m.UserID}, &groupEvent{GroupID: groupID, Event: GroupEventLeft, UserIDs: []int64{m.UserID}, OperatorID: m.UserID})
+	return nil
+}
+
+// SetMemberRole 群主设置或取消管理员
+func (uc *GroupUseCase) SetMemberRole(ctx context.Context, groupID, userID int64, role GroupRole) error {
+	if role != GroupRoleAdmin && role != GroupRoleMember {
+		return ErrGroupRoleInvalid
+	}
+	op, target, err := uc.manage(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if op.Role != GroupRoleOwner {
+		return ErrGroupPermissionDenied
+	}
+	if target.Role == role {
+		return nil
+	}
+	if err := uc.repo.UpdateMemberRole(ctx, groupID, userID, role); err != nil {
+		return err
+	}
+	uc.notifyGroup(ctx, groupID, nil, &groupEvent{GroupID: groupID, Event: GroupEventRoleChanged, UserIDs: []int64{userID}, OperatorID: op.UserID, Role: string(role)})
+	return nil
+}
+
+// MuteMember 群主、管理员禁言角色低于自己的成员，duration 为 0 时解除禁言
+func (uc *GroupUseCase) MuteMember(ctx context.Context, groupID, userID int64, duration time.Duration) error {
+	op, _, err := uc.manage(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	var until *time.Time
+	if duration > 0 {
+		t := time.Now().Add(duration)
+		until = &t
+	}
+	if err := uc.repo.UpdateMemberMute(ctx, groupID, userID, until); err != nil {
+		return err
+	}
+	event := &groupEvent{GroupID: groupID, Event: GroupEventMuted, UserIDs: []int64{userID}, OperatorID: op.UserID}
+	if until != nil {
+		event.MutedUntil = until.Unix()
+	}
+	uc.notifyGroup(ctx, groupID, nil, event)
+	return nil
+}
+
+// CheckSend 发送群消息前的成员检查：必须是群成员且未被禁言
+func (uc *GroupUseCase) CheckSend(ctx context.Context, groupID, userID int64) error {
+	m, err := uc.member(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if m.Muted(time.Now()) {
+		return ErrGroupMemberMuted
+	}
+	return nil
+}
+
+// CheckMember 用户必须是群成员，否则返回 ErrNotGroupMember
+func (uc *GroupUseCase) CheckMember(ctx context.Context, groupID, userID int64) error {
+	_, err := uc.member(ctx, groupID, userID)
+	return err
+}
+
+// IsMember 用户是否为群成员
+func (uc *GroupUseCase) IsMember(ctx context.Context, groupID, userID int64) (bool, error) {
+	_, err := uc.member(ctx, groupID, userID)
+	if err == nil {
+		return true, nil
+	}
+	if kerrors.Is(err, ErrNotGroupMember) {
+		return false, nil
+	}
+	return false, err
+}
+
+// MemberIDs 群成员的用户 ID
+func (uc *GroupUseCase) MemberIDs(ctx context.Context, groupID int64) ([]int64, error) {
+	members, err := uc.repo.ListMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.UserID)
+	}
+	return ids, nil
+}
+
+// currentMember 当前用户在群中的成员信息，不是成员时返回 ErrNotGroupMember
+func (uc *GroupUseCase) currentMember(ctx context.Context, groupID int64) (*GroupMember, error) {
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.member(ctx, groupID, userID)
+}
+
+func (uc *GroupUseCase) member(ctx context.Context, groupID, userID int64) (*GroupMember, error) {
+	m, err := uc.repo.GetMember(ctx, groupID, userID)
+	if err != nil {
+		if kerrors.Is(err, ErrGroupMemberNotFound) {
+			return nil, ErrNotGroupMember
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+// manage 当前用户管理目标成员的权限检查：角色须高于目标
+func (uc *GroupUseCase) manage(ctx context.Context, groupID, userID int64) (*GroupMember, *GroupMember, error) {
+	op, err := uc.currentMember(ctx, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := uc.repo.GetMember(ctx, groupID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if op.Role.rank() <= target.Role.rank() {
+		return nil, nil, ErrGroupPermissionDenied
+	}
+	return op, target, nil
+}
+
+// checkUsers 校验用户均存在
+func (uc *GroupUseCase) checkUsers(ctx context.Context, userIDs []int64) error {
+	for _, id := range userIDs {
+		if _, err := uc.user.GetUserByID(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// notifyGroup 推送给当前成员及 extra（如已被移出的成员）
+func (uc *GroupUseCase) notifyGroup(ctx context.Context, groupID int64, extra []int64, event *groupEvent) {
+	members, err := uc.MemberIDs(ctx, groupID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("查询群成员失败: group_id=%d err=%v", groupID, err)
+		return
+	}
+	uc.notify(append(members, extra...), event)
+}
+
+func (uc *GroupUseCase) notify(userIDs []int64, event *groupEvent) {
+	for _, id := range userIDs {
+		uc.pusher.PushToUser(id, ActionGroupEvent, event)
+	}
+}
+
+// uniqueIDs 去重并排除 exclude 与非法 ID
+func uniqueIDs(ids []int64, exclude int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 || id == exclude {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
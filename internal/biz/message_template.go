@@ -0,0 +1,338 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/email"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/msgtpl"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/sms"
+)
+
+var (
+	ErrMessageTemplateNotFound  = kerrors.NotFound("MESSAGE_TEMPLATE_NOT_FOUND", "模板不存在")
+	ErrMessageTemplateExists    = kerrors.Conflict("MESSAGE_TEMPLATE_EXISTS", "相同渠道、名称、语言及供应商的模板已存在")
+	ErrMessageTemplateConflict  = kerrors.Conflict("MESSAGE_TEMPLATE_VERSION_CONFLICT", "模板已被修改，请刷新后重试")
+	ErrorMessageTemplateInvalid = kerrors.BadRequest("MESSAGE_TEMPLATE_INVALID", "模板内容错误")
+)
+
+const (
+	messageTemplateDefaultPageSize = 20
+	messageTemplateMaxPageSize     = 100
+	// 各实例检查模板版本的间隔，修改后最迟在该间隔后全部生效
+	messageTemplateWatchInterval = 10 * time.Second
+)
+
+// MessageTemplate 数据库中维护的短信/邮件模板
+type MessageTemplate struct {
+	ID         int64
+	Channel    DeliveryChannel
+	Name       string
+	Locale     string // 邮件为语言标签；短信为空（大陆）或 intl（国际/港澳台）
+	Provider   string // 短信供应商，为空时对所有供应商生效
+	Subject    string
+	Body       string
+	TextBody   string
+	VendorCode string
+	Version    int64
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// MessageTemplateQuery 模板查询条件
+type MessageTemplateQuery struct {
+	Channel  DeliveryChannel
+	Name     string
+	Page     int
+	PageSize int
+}
+
+// MessageTemplateRendered 模板渲染结果
+type MessageTemplateRendered struct {
+	Locale  string
+	Source  string // database / embedded / config
+	Subject string
+	HTML    string
+	Text    string
+	// VendorCodes 短信：各供应商实际使用的模板编号，按供应商优先级排列
+	VendorCodes []*sms.TemplateCode
+}
+
+type MessageTemplateRepo interface {
+	// CreateTemplate 唯一键冲突时返回 ErrMessageTemplateExists
+	CreateTemplate(ctx context.Context, t *MessageTemplate) error
+	// UpdateTemplate 按 ID 及版本号更新，版本不一致时返回 ErrMessageTemplateConflict
+	UpdateTemplate(ctx context.Context, t *MessageTemplate) error
+	DeleteTemplate(ctx context.Context, id int64) error
+	GetTemplate(ctx context.Context, id int64) (*MessageTemplate, error)
+	ListTemplates(ctx context.Context, q *MessageTemplateQuery) ([]*MessageTemplate, int64, error)
+	ListAllTemplates(ctx context.Context) ([]*MessageTemplate, error)
+	// GetTemplateRevision 模板整体修订号，任一模板变更后递增，各实例据此判断是否需要重新加载
+	GetTemplateRevision(ctx context.Context) (int64, error)
+	IncrTemplateRevision(ctx context.Context) (int64, error)
+}
+
+type MessageTemplateUseCase struct {
+	repo      MessageTemplateRepo
+	registry  *msgtpl.Registry
+	templates *email.Templates
+	sms       *conf.Data_Sms
+	loaded    atomic.Bool
+	log       *log.Helper
+}
+
+func NewMessageTemplateUseCase(repo MessageTemplateRepo, registry *msgtpl.Registry, templates *email.Templates, c *conf.Data, logger log.Logger) *MessageTemplateUseCase {
+	return &MessageTemplateUseCase{
+		repo:      repo,
+		registry:  registry,
+		templates: templates,
+		sms:       c.Sms,
+		log:       log.NewHelper(log.With(logger, "module", "biz/message_template")),
+	}
+}
+
+// Load 从数据库加载全部模板并整体替换缓存
+func (uc *MessageTemplateUseCase) Load(ctx context.Context) error {
+	// 先取修订号再取模板，加载期间发生的修改会在下次检查时重新加载
+	revision, err := uc.repo.GetTemplateRevision(ctx)
+	if err != nil {
+		return err
+	}
+	list, err := uc.repo.ListAllTemplates(ctx)
+	if err != nil {
+		return err
+	}
+	templates := make([]*msgtpl.Template, 0, len(list))
+	for _, t := range list {
+		templates = append(templates, t.toMsgtpl())
+	}
+	if err := uc.registry.Replace(templates, revision); err != nil {
+		return err
+	}
+	uc.loaded.Store(true)
+	uc.log.WithContext(ctx).Infof("已加载短信/邮件模板: count=%d revision=%d", len(templates), revision)
+	return nil
+}
+
+// Watch 后台任务：启动时立即加载，之后定期检查修订号，其他实例修改模板后重新加载
+func (uc *MessageTemplateUseCase) Watch(ctx context.Context, _ string) error {
+	if !uc.loaded.Load() {
+		return uc.Load(ctx)
+	}
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-time.After(messageTemplateWatchInterval):
+	}
+	revision, err := uc.repo.GetTemplateRevision(ctx)
+	if err != nil {
+		return err
+	}
+	if revision == uc.registry.Version() {
+		return nil
+	}
+	return uc.Load(ctx)
+}
+
+func (uc *MessageTemplateUseCase) ListTemplates(ctx context.Context, channel DeliveryChannel, name string, page, pageSize int) ([]*MessageTemplate, int64, error) {
+	q := &MessageTemplateQuery{
+		Channel:  channel,
+		Name:     name,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+	if q.PageSize <= 0 {
+		q.PageSize = messageTemplateDefaultPageSize
+	}
+	if q.PageSize > messageTemplateMaxPageSize {
+		q.PageSize = messageTemplateMaxPageSize
+	}
+	return uc.repo.ListTemplates(ctx, q)
+}
+
+func (uc *MessageTemplateUseCase) CreateTemplate(ctx context.Context, t *MessageTemplate) (*MessageTemplate, error) {
+	if err := t.normalize(); err != nil {
+		return nil, err
+	}
+	t.Version = 1
+	if err := uc.repo.CreateTemplate(ctx, t); err != nil {
+		return nil, err
+	}
+	uc.changed(ctx)
+	return t, nil
+}
+
+// UpdateTemplate 修改模板内容，t.Version 为修改前的版本号；渠道、名称、语言及供应商不可修改
+func (uc *MessageTemplateUseCase) UpdateTemplate(ctx context.Context, t *MessageTemplate) (*MessageTemplate, error) {
+	old, err := uc.repo.GetTemplate(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	if old.Version != t.Version {
+		return nil, ErrMessageTemplateConflict
+	}
+	t.Channel, t.Name, t.Locale, t.Provider = old.Channel, old.Name, old.Locale, old.Provider
+	if err := t.normalize(); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateTemplate(ctx, t); err != nil {
+		return nil, err
+	}
+	uc.changed(ctx)
+	return uc.repo.GetTemplate(ctx, t.ID)
+}
+
+func (uc *MessageTemplateUseCase) DeleteTemplate(ctx context.Context, id int64) error {
+	if _, err := uc.repo.GetTemplate(ctx, id); err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteTemplate(ctx, id); err != nil {
+		return err
+	}
+	uc.changed(ctx)
+	return nil
+}
+
+// changed 模板变更后递增修订号并立即重新加载本实例，其他实例由 Watch 感知
+func (uc *MessageTemplateUseCase) changed(ctx context.Context) {
+	if _, err := uc.repo.IncrTemplateRevision(ctx); err != nil {
+		uc.log.WithContext(ctx).Errorf("递增模板修订号失败: %v", err)
+	}
+	if err := uc.Load(ctx); err != nil {
+		uc.log.WithContext(ctx).Errorf("重新加载模板失败: %v", err)
+	}
+}
+
+// Preview 使用示例参数渲染未保存的模板，用于编辑时预览
+func (uc *MessageTemplateUseCase) Preview(_ context.Context, t *MessageTemplate, params map[string]string) (*MessageTemplateRendered, error) {
+	if err := t.normalize(); err != nil {
+		return nil, err
+	}
+	c, err := msgtpl.Compile(t.toMsgtpl())
+	if err != nil {
+		return nil, invalidTemplate(err.Error())
+	}
+	r, err := c.Render(params)
+	if err != nil {
+		return nil, invalidTemplate(err.Error())
+	}
+	rendered := &MessageTemplateRendered{
+		Locale:  t.Locale,
+		Subject: r.Subject,
+		HTML:    r.HTML,
+		Text:    r.Text,
+	}
+	if t.Channel == DeliveryChannelSms {
+		rendered.VendorCodes = []*sms.TemplateCode{{Provider: t.Provider, Code: t.VendorCode, Source: sms.SourceDatabase}}
+	}
+	return rendered, nil
+}
+
+// Render 按实际发送时的查找规则渲染模板：邮件按语言回退链选择数据库或内嵌模板；
+// 短信列出各供应商使用的模板编号，locales 中包含 intl 时按国际号码查找
+func (uc *MessageTemplateUseCase) Render(_ context.Context, channel DeliveryChannel, name string, locales []string, params map[string]string) (*MessageTemplateRendered, error) {
+	if channel == DeliveryChannelEmail {
+		c, err := uc.templates.Render(name, locales, params)
+		if err != nil {
+			return nil, err
+		}
+		return &MessageTemplateRendered{Locale: c.Locale, Source: c.Source, Subject: c.Subject, HTML: c.HTML, Text: c.Text}, nil
+	}
+
+	intl := false
+	for _, l := range locales {
+		if strings.EqualFold(l, msgtpl.LocaleIntl) {
+			intl = true
+		}
+	}
+	codes := sms.LookupTemplateCodes(uc.sms, uc.registry, name, intl)
+	if len(codes) == 0 {
+		return nil, sms.ErrorTemplateNotConfigured
+	}
+	rendered := &MessageTemplateRendered{Source: codes[0].Source, VendorCodes: codes}
+	if intl {
+		rendered.Locale = msgtpl.LocaleIntl
+	}
+	// 短信正文由供应商侧模板决定，数据库中维护了展示正文时渲染优先级最高的一条
+	for _, code := range codes {
+		t, ok := uc.registry.Sms(code.Provider, name, intl)
+		if !ok || t.Body == "" {
+			continue
+		}
+		c, err := msgtpl.Compile(t)
+		if err != nil {
+			return nil, invalidTemplate(err.Error())
+		}
+		r, err := c.Render(params)
+		if err != nil {
+			return nil, invalidTemplate(err.Error())
+		}
+		rendered.Text = r.Text
+		break
+	}
+	return rendered, nil
+}
+
+// normalize 校验模板字段并检查模板语法
+func (t *MessageTemplate) normalize() error {
+	t.Name = strings.TrimSpace(t.Name)
+	t.Locale = strings.TrimSpace(t.Locale)
+	t.Provider = strings.TrimSpace(t.Provider)
+	t.VendorCode = strings.TrimSpace(t.VendorCode)
+	if t.Name == "" {
+		return invalidTemplate("name 字段无效")
+	}
+	switch t.Channel {
+	case DeliveryChannelEmail:
+		if t.Locale == "" {
+			return invalidTemplate("locale 字段无效")
+		}
+		if t.Body == "" {
+			return invalidTemplate("body 字段无效")
+		}
+		t.Provider, t.VendorCode = "", ""
+	case DeliveryChannelSms:
+		if t.Locale != "" && !strings.EqualFold(t.Locale, msgtpl.LocaleIntl) {
+			return invalidTemplate("locale 字段无效")
+		}
+		t.Locale = strings.ToLower(t.Locale)
+		if t.VendorCode == "" {
+			return invalidTemplate("vendor_code 字段无效")
+		}
+		t.Subject, t.TextBody = "", ""
+	default:
+		return invalidTemplate("channel 字段无效")
+	}
+	if _, err := msgtpl.Compile(t.toMsgtpl()); err != nil {
+		return invalidTemplate(err.Error())
+	}
+	return nil
+}
+
+func (t *MessageTemplate) toMsgtpl() *msgtpl.Template {
+	return &msgtpl.Template{
+		Channel:    string(t.Channel),
+		Name:       t.Name,
+		Locale:     t.Locale,
+		Provider:   t.Provider,
+		Subject:    t.Subject,
+		Body:       t.Body,
+		TextBody:   t.TextBody,
+		VendorCode: t.VendorCode,
+		Version:    t.Version,
+	}
+}
+
+// invalidTemplate 模板校验失败，消息中带上具体原因便于管理员修改
+func invalidTemplate(detail string) error {
+	return kerrors.BadRequest(ErrorMessageTemplateInvalid.Reason, fmt.Sprintf("%s: %s", ErrorMessageTemplateInvalid.Message, detail))
+}
@@ -0,0 +1,453 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
+)
+
+var (
+	ErrNotificationTypeNotFound   = kerrors.BadRequest("NOTIFICATION_TYPE_NOT_FOUND", "通知类型错误")
+	ErrNotificationChannelInvalid = kerrors.BadRequest("NOTIFICATION_CHANNEL_INVALID", "该通知类型不支持所选渠道")
+	ErrQuietHoursInvalid          = kerrors.BadRequest("NOTIFICATION_QUIET_HOURS_INVALID", "免打扰时段或时区格式错误")
+)
+
+type NotificationChannel string
+
+const (
+	NotificationChannelInApp NotificationChannel = "in_app" // 站内通知，落库并通过 WebSocket 推送
+	NotificationChannelEmail NotificationChannel = "email"
+	NotificationChannelSms   NotificationChannel = "sms"
+)
+
+const (
+	// ActionNotification 站内通知的 WebSocket 推送动作
+	ActionNotification = "notification"
+	// NotificationTypeLoginAlert 登录提醒的通知类型，需在 app.notification.types 中配置
+	NotificationTypeLoginAlert = "login_alert"
+
+	notificationDefaultTimezone = "Asia/Shanghai"
+	notificationDefaultPageSize = 20
+	notificationMaxPageSize     = 100
+	quietHoursLayout            = "15:04"
+)
+
+// Notification 一条通知
+type Notification struct {
+	ID      int64
+	UserID  int64
+	Type    string
+	Title   string
+	Content string
+	// Params 业务参数：站内通知随通知保存用于客户端跳转，邮件、短信作为模板参数
+	Params    map[string]string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// notificationPush 站内通知的推送内容
+type notificationPush struct {
+	ID        int64             `json:"id,string"`
+	Type      string            `json:"type"`
+	Title     string            `json:"title"`
+	Content   string            `json:"content"`
+	Data      map[string]string `json:"data,omitempty"`
+	CreatedAt int64             `json:"created_at"`
+}
+
+// NotificationPreference 用户通知偏好
+type NotificationPreference struct {
+	UserID int64
+	// Channels 通知类型 -> 接收渠道，未设置的类型使用默认渠道，空列表表示退订
+	Channels        map[string][]NotificationChannel
+	QuietHoursStart string // 免打扰开始时间，如 22:00，为空表示不开启
+	QuietHoursEnd   string
+	Timezone        string
+}
+
+// NotificationType 已配置的通知类型
+type NotificationType struct {
+	Name      string
+	Channels  []NotificationChannel // 可选渠道，同时也是默认渠道
+	Template  string                // 邮件/短信逻辑模板名
+	Mandatory bool
+}
+
+// NotificationQuery 站内通知查询条件
+type NotificationQuery struct {
+	UserID     int64
+	UnreadOnly bool
+	Page       int
+	PageSize   int
+}
+
+type NotificationRepo interface {
+	CreateNotification(ctx context.Context, n *Notification) error
+	ListNotifications(ctx context.Context, q *NotificationQuery) ([]*Notification, int64, error)
+	// MarkRead 标记已读，ids 为空时标记该用户全部未读通知，返回受影响的条数
+	MarkRead(ctx context.Context, userID int64, ids []int64, at time.Time) (int64, error)
+	CountUnread(ctx context.Context, userID int64) (int64, error)
+	// GetPreference 获取用户通知偏好，未设置时返回空偏好
+	GetPreference(ctx context.Context, userID int64) (*NotificationPreference, error)
+	SavePreference(ctx context.Context, p *NotificationPreference) error
+}
+
+// NotificationPusher 向用户的在线连接推送消息，用户不在线时忽略
+type NotificationPusher interface {
+	PushToUser(userID int64, action string, data interface{})
+}
+
+type NotificationUseCase struct {
+	repo     NotificationRepo
+	user     UserRepo
+	auth     auth.TokenService
+	pusher   NotificationPusher
+	sms      SmsSender
+	email    EmailSender
+	types    map[string]*NotificationType
+	location *time.Location
+	log      *log.Helper
+}
+
+func NewNotificationUseCase(
+	repo NotificationRepo,
+	user UserRepo,
+	auth auth.TokenService,
+	pusher NotificationPusher,
+	sms SmsSender,
+	email EmailSender,
+	c *conf.App,
+	logger log.Logger,
+) *NotificationUseCase {
+	uc := &NotificationUseCase{
+		repo:     repo,
+		user:     user,
+		auth:     auth,
+		pusher:   pusher,
+		sms:      sms,
+		email:    email,
+		types:    make(map[string]*NotificationType),
+		location: time.Local,
+		log:      log.NewHelper(log.With(logger, "module", "biz/notification")),
+	}
+	timezone := notificationDefaultTimezone
+	if n := c.Notification; n != nil {
+		if n.DefaultTimezone != "" {
+			timezone = n.DefaultTimezone
+		}
+		for name, t := range n.Types {
+			nt := &NotificationType{Name: name, Template: t.Template, Mandatory: t.Mandatory}
+			if nt.Template == "" {
+				nt.Template = name
+			}
+			for _, ch := range t.Channels {
+				nt.Channels = append(nt.Channels, NotificationChannel(ch))
+			}
+			uc.types[name] = nt
+		}
+	}
+	if loc, err := time.LoadLocation(timezone); err == nil {
+		uc.location = loc
+	} else {
+		uc.log.Warnf("加载通知默认时区失败，使用本地时区: %s %v", timezone, err)
+	}
+	return uc
+}
+
+// Notify 按用户偏好向各渠道发送通知：站内通知始终落库，免打扰时段内不发送邮件、短信（强制通知除外）
+// 单个渠道发送失败不影响其他渠道，全部失败时返回最后一个错误
+func (uc *NotificationUseCase) Notify(ctx context.Context, n *Notification) error {
+	t, ok := uc.types[n.Type]
+	if !ok {
+		return ErrNotificationTypeNotFound
+	}
+	pref, err := uc.repo.GetPreference(ctx, n.UserID)
+	if err != nil {
+		return err
+	}
+
+	channels := t.Channels
+	if !t.Mandatory {
+		channels = pref.channels(t)
+	}
+	quiet := !t.Mandatory && pref.inQuietHours(time.Now(), uc.location)
+
+	var (
+		user    *User
+		lastErr error
+		sent    int
+	)
+	for _, ch := range channels {
+		if ch != NotificationChannelInApp && quiet {
+			uc.log.WithContext(ctx).Infof("免打扰时段，跳过通知: user_id=%d type=%s channel=%s", n.UserID, n.Type, ch)
+			continue
+		}
+		if ch != NotificationChannelInApp && user == nil {
+			if user, err = uc.user.GetUserByID(ctx, n.UserID); err != nil {
+				return err
+			}
+		}
+		if err := uc.send(ctx, ch, t, user, n); err != nil {
+			uc.log.WithContext(ctx).Errorf("发送通知失败: user_id=%d type=%s channel=%s err=%v", n.UserID, n.Type, ch, err)
+			lastErr = err
+			continue
+		}
+		sent++
+	}
+	if sent == 0 && lastErr != nil {
+		return lastErr
+	}
+	return nil
+}
+
+// NotifyLogin 发送登录提醒，登录时间按通知默认时区展示
+func (uc *NotificationUseCase) NotifyLogin(ctx context.Context, userID int64, ip string, at time.Time) error {
+	return uc.Notify(ctx, &Notification{
+		UserID:  userID,
+		Type:    NotificationTypeLoginAlert,
+		Title:   "登录提醒",
+		Content: "您的账号于 " + at.In(uc.location).Format(time.DateTime) + " 在 " + ip + " 登录，如非本人操作请及时修改密码",
+		Params: map[string]string{
+			"ip":   ip,
+			"time": strconv.FormatInt(at.Unix(), 10),
+		},
+	})
+}
+
+func (uc *NotificationUseCase) send(ctx context.Context, ch NotificationChannel, t *NotificationType, user *User, n *Notification) error {
+	switch ch {
+	case NotificationChannelInApp:
+		if err := uc.repo.CreateNotification(ctx, n); err != nil {
+			return err
+		}
+		uc.pusher.PushToUser(n.UserID, ActionNotification, &notificationPush{
+			ID:        n.ID,
+			Type:      n.Type,
+			Title:     n.Title,
+			Content:   n.Content,
+			Data:      n.Params,
+			CreatedAt: n.CreatedAt.Unix(),
+		})
+	case NotificationChannelEmail:
+		if user.Email == "" {
+			return nil
+		}
+		return uc.email.Send(ctx, user.Email, t.Template, n.emailParams())
+	case NotificationChannelSms:
+		if user.Phone == "" {
+			return nil
+		}
+		// 短信模板变量须与供应商侧模板一致，只传业务参数
+		return uc.sms.Send(ctx, user.Phone, t.Template, n.Params)
+	}
+	return nil
+}
+
+// emailParams 邮件模板参数，额外提供 title、content
+func (n *Notification) emailParams() map[string]string {
+	params := make(map[string]string, len(n.Params)+2)
+	params["title"] = n.Title
+	params["content"] = n.Content
+	for k, v := range n.Params {
+		params[k] = v
+	}
+	return params
+}
+
+// ListNotifications 当前用户的站内通知，按时间倒序
+func (uc *NotificationUseCase) ListNotifications(ctx context.Context, unreadOnly bool, page, pageSize int) ([]*Notification, int64, error) {
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	q := &NotificationQuery{
+		UserID:     userID,
+		UnreadOnly: unreadOnly,
+		Page:       page,
+		PageSize:   pageSize,
+	}
+	if q.Page <= 0 {
+		q.Page = 1
+	}
+	if q.PageSize <= 0 {
+		q.PageSize = notificationDefaultPageSize
+	}
+	if q.PageSize > notificationMaxPageSize {
+		q.PageSize = notificationMaxPageSize
+	}
+	return uc.repo.ListNotifications(ctx, q)
+}
+
+// MarkRead 标记当前用户的通知为已读，ids 为空时标记全部
+func (uc *NotificationUseCase) MarkRead(ctx context.Context, ids []int64) (int64, error) {
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uc.repo.MarkRead(ctx, userID, ids, time.Now())
+}
+
+func (uc *NotificationUseCase) UnreadCount(ctx context.Context) (int64, error) {
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uc.repo.CountUnread(ctx, userID)
+}
+
+// Types 已配置的通知类型，按名称排序
+func (uc *NotificationUseCase) Types() []*NotificationType {
+	types := make([]*NotificationType, 0, len(uc.types))
+	for _, t := range uc.types {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	return types
+}
+
+// GetPreference 当前用户的通知偏好，Channels 包含每个通知类型实际生效的渠道
+func (uc *NotificationUseCase) GetPreference(ctx context.Context) (*NotificationPreference, error) {
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pref, err := uc.repo.GetPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	effective := make(map[string][]NotificationChannel, len(uc.types))
+	for name, t := range uc.types {
+		if t.Mandatory {
+			effective[name] = t.Channels
+		} else {
+			effective[name] = pref.channels(t)
+		}
+	}
+	pref.Channels = effective
+	return pref, nil
+}
+
+// UpdatePreference 修改当前用户的通知偏好，未包含的通知类型保持原设置，强制通知的设置被忽略
+func (uc *NotificationUseCase) UpdatePreference(ctx context.Context, p *NotificationPreference) (*NotificationPreference, error) {
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if (p.QuietHoursStart == "") != (p.QuietHoursEnd == "") {
+		return nil, ErrQuietHoursInvalid
+	}
+	if p.QuietHoursStart != "" {
+		if _, err := time.Parse(quietHoursLayout, p.QuietHoursStart); err != nil {
+			return nil, ErrQuietHoursInvalid
+		}
+		if _, err := time.Parse(quietHoursLayout, p.QuietHoursEnd); err != nil {
+			return nil, ErrQuietHoursInvalid
+		}
+	}
+	if p.Timezone != "" {
+		if _, err := time.LoadLocation(p.Timezone); err != nil {
+			return nil, ErrQuietHoursInvalid
+		}
+	}
+
+	old, err := uc.repo.GetPreference(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	channels := old.Channels
+	if channels == nil {
+		channels = make(map[string][]NotificationChannel)
+	}
+	for name, list := range p.Channels {
+		t, ok := uc.types[name]
+		if !ok {
+			return nil, ErrNotificationTypeNotFound
+		}
+		if t.Mandatory {
+			continue
+		}
+		selected := make([]NotificationChannel, 0, len(list))
+		for _, ch := range list {
+			if !t.allows(ch) {
+				return nil, ErrNotificationChannelInvalid.WithMetadata(map[string]string{"type": name, "channel": string(ch)})
+			}
+			selected = append(selected, ch)
+		}
+		channels[name] = selected
+	}
+
+	pref := &NotificationPreference{
+		UserID:          userID,
+		Channels:        channels,
+		QuietHoursStart: p.QuietHoursStart,
+		QuietHoursEnd:   p.QuietHoursEnd,
+		Timezone:        p.Timezone,
+	}
+	if err := uc.repo.SavePreference(ctx, pref); err != nil {
+		return nil, err
+	}
+	return uc.GetPreference(ctx)
+}
+
+func (t *NotificationType) allows(ch NotificationChannel) bool {
+	for _, c := range t.Channels {
+		if c == ch {
+			return true
+		}
+	}
+	return false
+}
+
+// channels 用户选择的渠道与类型可选渠道的交集，按类型配置的顺序排列；未设置时使用默认渠道
+func (p *NotificationPreference) channels(t *NotificationType) []NotificationChannel {
+	selected, ok := p.Channels[t.Name]
+	if !ok {
+		return t.Channels
+	}
+	result := make([]NotificationChannel, 0, len(selected))
+	for _, ch := range t.Channels {
+		for _, s := range selected {
+			if s == ch {
+				result = append(result, ch)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// inQuietHours now 是否处于用户的免打扰时段，支持跨零点（如 22:00 - 08:00）
+func (p *NotificationPreference) inQuietHours(now time.Time, def *time.Location) bool {
+	if p.QuietHoursStart == "" || p.QuietHoursEnd == "" {
+		return false
+	}
+	start, err1 := time.Parse(quietHoursLayout, p.QuietHoursStart)
+	end, err2 := time.Parse(quietHoursLayout, p.QuietHoursEnd)
+	if err1 != nil || err2 != nil {
+		return false
+	}
+	loc := def
+	if p.Timezone != "" {
+		if l, err := time.LoadLocation(p.Timezone); err == nil {
+			loc = l
+		}
+	}
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+	switch {
+	case from == to:
+		return false
+	case from < to:
+		return minute >= from && minute < to
+	default:
+		return minute >= from || minute < to
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/debug"
 )
@@ -18,6 +19,7 @@ const (
 	otpIntervalKeyPattern = "otp:interval:%s:%s:%s"
 	otpCodeKeyPattern     = "otp:code:%s:%s:%s"
 	otpFailKeyPattern     = "otp:fail:%s:%s:%s"
+	otpSendKeyPattern     = "otp:send:%s:%s:%s:%s" // 出站队列幂等键，末段为每次发送的随机数；键会落库，不能包含验证码
 	otpMaxFailCount       = 5
 	otpFailExpiration     = time.Hour
 )
@@ -81,7 +83,7 @@ func (uc *OtpUseCase) SendPhoneOtp(ctx context.Context, phone, scene string) (in
 		return 0, ErrorSceneNotFound
 	}
 
-	return uc.process(ctx, kindPhone, scene, phone, cfg, func(code string) error {
+	return uc.process(ctx, kindPhone, scene, phone, cfg, func(ctx context.Context, code string) error {
 		return uc.sms.Send(ctx, phone, cfg.TemplateName, map[string]string{"code": code})
 	})
 }
@@ -93,13 +95,13 @@ func (uc *OtpUseCase) SendEmailOtp(ctx context.Context, email, scene string) (in
 		return 0, ErrorSceneNotFound
 	}
 
-	return uc.process(ctx, kindEmail, scene, email, cfg, func(code string) error {
+	return uc.process(ctx, kindEmail, scene, email, cfg, func(ctx context.Context, code string) error {
 		return uc.email.Send(ctx, email, cfg.TemplateName, map[string]string{"code": code})
 	})
 }
 
 // 内部抽象流程
-func (uc *OtpUseCase) process(ctx context.Context, kind, scene, receiver string, cfg *conf.App_Otp_Scene, sendFn func(code string) error) (int64, error) {
+func (uc *OtpUseCase) process(ctx context.Context, kind, scene, receiver string, cfg *conf.App_Otp_Scene, sendFn func(ctx context.Context, code string) error) (int64, error) {
 	intervalKey := fmt.Sprintf(otpIntervalKeyPattern, kind, scene, receiver)
 	codeKey := fmt.Sprintf(otpCodeKeyPattern, kind, scene, receiver)
 
@@ -116,7 +118,8 @@ func (uc *OtpUseCase) process(ctx context.Context, kind, scene, receiver string,
 
 	code := uc.generateCode(cfg.CodeLength)
 
-	if err := sendFn(code); err != nil {
+	sendCtx := WithOutboundKey(ctx, fmt.Sprintf(otpSendKeyPattern, kind, scene, receiver, uuid.NewString()))
+	if err := sendFn(sendCtx, code); err != nil {
 		uc.log.Errorf("发送%s验证码失败: %v", kind, err)
 		return 0, ErrorOtpSendError
 	}
@@ -0,0 +1,308 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/email"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/idgen"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/sms"
+)
+
+var ErrorOutboundEnqueueFailed = errors.InternalServer("OUTBOUND_ENQUEUE_FAILED", "消息发送失败")
+
+const (
+	outboundDefaultWorkers     = 4
+	outboundDefaultBatchSize   = 10
+	outboundDefaultMaxAttempts = 5
+	outboundDefaultBaseBackoff = 2 * time.Second
+	outboundDefaultMaxBackoff  = 5 * time.Minute
+	// 队列为空时单次拉取的最长等待时间
+	outboundPollWait = 2 * time.Second
+	// 关闭队列时在请求协程中同步发送，失败后有限次重试，间隔 1s、2s
+	outboundSyncMaxAttempts = 3
+	outboundSyncBaseBackoff = time.Second
+)
+
+// OutboundMessage 待发送的短信或邮件
+type OutboundMessage struct {
+	Key         string // 幂等键，同一个键只会入队并发送一次
+	Channel     DeliveryChannel
+	To          string
+	Template    string
+	Params      map[string]string
+	Locales     []string // 邮件：收件人偏好语言，入队时从请求中解析
+	Attachments []*email.Attachment
+	Inline      []*email.Attachment
+	Attempts    int    // 已发送次数
+	LastError   string // 最近一次失败原因
+	CreatedAt   time.Time
+	Receipt     string // 队列内部的消息回执，确认、重试时使用
+}
+
+// OutboundQueue 出站消息队列，至少投递一次，重复投递由幂等键兜底
+type OutboundQueue interface {
+	// Enqueue 入队，幂等键已存在时不重复入队并返回 false
+	Enqueue(ctx context.Context, m *OutboundMessage) (bool, error)
+	// Dequeue 拉取到期的消息（含超时未确认的消息），无消息时最多等待 wait
+	Dequeue(ctx context.Context, consumer string, count int, wait time.Duration) ([]*OutboundMessage, error)
+	// IsSent 幂等键对应的消息是否已发送成功
+	IsSent(ctx context.Context, key string) (bool, error)
+	// Ack 发送成功
+	Ack(ctx context.Context, m *OutboundMessage) error
+	// Retry 发送失败，在 at 时刻重新投递
+	Retry(ctx context.Context, m *OutboundMessage, at time.Time) error
+	// DeadLetter 重试耗尽，移入死信
+	DeadLetter(ctx context.Context, m *OutboundMessage) error
+}
+
+type OutboundUseCase struct {
+	queue       OutboundQueue
+	sms         sms.Sender
+	email       email.Sender
+	idGen       idgen.IDGenerator
+	enabled     bool
+	workers     int
+	batchSize   int
+	maxAttempts int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	log         *log.Helper
+}
+
+func NewOutboundUseCase(queue OutboundQueue, smsSender sms.Sender, emailSender email.Sender, idGen idgen.IDGenerator, c *conf.App, logger log.Logger) *OutboundUseCase {
+	uc := &OutboundUseCase{
+		queue:       queue,
+		sms:         smsSender,
+		email:       emailSender,
+		idGen:       idGen,
+		workers:     outboundDefaultWorkers,
+		batchSize:   outboundDefaultBatchSize,
+		maxAttempts: outboundDefaultMaxAttempts,
+		baseBackoff: outboundDefaultBaseBackoff,
+		maxBackoff:  outboundDefaultMaxBackoff,
+		log:         log.NewHelper(log.With(logger, "module", "biz/outbound")),
+	}
+	cfg := c.Outbound
+	if cfg == nil {
+		return uc
+	}
+	uc.enabled = cfg.Enabled
+	if cfg.Workers > 0 {
+		uc.workers = int(cfg.Workers)
+	}
+	if cfg.BatchSize > 0 {
+		uc.batchSize = int(cfg.BatchSize)
+	}
+	if cfg.MaxAttempts > 0 {
+		uc.maxAttempts = int(cfg.MaxAttempts)
+	}
+	if cfg.BaseBackoff != nil && cfg.BaseBackoff.AsDuration() > 0 {
+		uc.baseBackoff = cfg.BaseBackoff.AsDuration()
+	}
+	if cfg.MaxBackoff != nil && cfg.MaxBackoff.AsDuration() > 0 {
+		uc.maxBackoff = cfg.MaxBackoff.AsDuration()
+	}
+	return uc
+}
+
+// Enabled 是否启用异步发送
+func (uc *OutboundUseCase) Enabled() bool {
+	return uc.enabled
+}
+
+// Workers 消费协程数
+func (uc *OutboundUseCase) Workers() int {
+	return uc.workers
+}
+
+type outboundKey struct{}
+
+// WithOutboundKey 为本次发送指定幂等键，未指定时每次发送生成新的键
+func WithOutboundKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, outboundKey{}, key)
+}
+
+// SendSms 发送短信，启用队列时仅入队
+func (uc *OutboundUseCase) SendSms(ctx context.Context, phone, template string, params map[string]string) error {
+	if !uc.enabled {
+		return uc.sendSync(ctx, func() error {
+			return uc.sms.Send(ctx, phone, template, params)
+		})
+	}
+	return uc.enqueue(ctx, &OutboundMessage{
+		Channel:  DeliveryChannelSms,
+		To:       phone,
+		Template: template,
+		Params:   params,
+	})
+}
+
+// SendMail 发送邮件，启用队列时仅入队
+func (uc *OutboundUseCase) SendMail(ctx context.Context, m *email.Mail) error {
+	if !uc.enabled {
+		return uc.sendSync(ctx, func() error {
+			return uc.email.SendMail(ctx, m)
+		})
+	}
+	locales := m.Locales
+	if len(locales) == 0 {
+		locales = email.PreferredLocales(ctx)
+	}
+	return uc.enqueue(ctx, &OutboundMessage{
+		Channel:     DeliveryChannelEmail,
+		To:          m.To,
+		Template:    m.Template,
+		Params:      m.Params,
+		Locales:     locales,
+		Attachments: m.Attachments,
+		Inline:      m.Inline,
+	})
+}
+
+// sendSync 同步发送，失败时按退避有限次重试，等待期间响应 ctx 取消
+func (uc *OutboundUseCase) sendSync(ctx context.Context, send func() error) error {
+	backoff := outboundSyncBaseBackoff
+	for attempt := 1; ; attempt++ {
+		err := send()
+		if err == nil || attempt >= outboundSyncMaxAttempts {
+			return err
+		}
+		uc.log.WithContext(ctx).Warnf("同步发送失败，%v 后重试: attempt=%d/%d err=%v", backoff, attempt, outboundSyncMaxAttempts, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (uc *OutboundUseCase) enqueue(ctx context.Context, m *OutboundMessage) error {
+	m.Key, _ = ctx.Value(outboundKey{}).(string)
+	if m.Key == "" {
+		id, err := uc.idGen.NextID()
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("生成消息ID失败: %v", err)
+			return ErrorOutboundEnqueueFailed
+		}
+		m.Key = fmt.Sprintf("%s:%d", m.Channel, id)
+	}
+	m.CreatedAt = time.Now()
+
+	ok, err := uc.queue.Enqueue(ctx, m)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("消息入队失败: channel=%s key=%s err=%v", m.Channel, m.Key, err)
+		return ErrorOutboundEnqueueFailed
+	}
+	if !ok {
+		uc.log.WithContext(ctx).Infof("消息已入队，忽略重复请求: channel=%s key=%s", m.Channel, m.Key)
+	}
+	return nil
+}
+
+// Consume 拉取一批消息并发送，由后台协程循环调用
+func (uc *OutboundUseCase) Consume(ctx context.Context, consumer string) error {
+	list, err := uc.queue.Dequeue(ctx, consumer, uc.batchSize, outboundPollWait)
+	if err != nil {
+		return err
+	}
+	for _, m := range list {
+		uc.handle(ctx, m)
+	}
+	return nil
+}
+
+func (uc *OutboundUseCase) handle(ctx context.Context, m *OutboundMessage) {
+	// 发送成功但确认失败的消息会被重新投递，此时不再重复发送
+	sent, err := uc.queue.IsSent(ctx, m.Key)
+	if err != nil {
+		uc.log.Errorf("查询消息发送状态失败: key=%s err=%v", m.Key, err)
+		return
+	}
+	if sent {
+		uc.ack(ctx, m)
+		return
+	}
+
+	m.Attempts++
+	if err := uc.deliver(ctx, m); err != nil {
+		m.LastError = err.Error()
+		if m.Attempts >= uc.maxAttempts {
+			uc.log.Errorf("消息发送失败，已移入死信: channel=%s key=%s attempts=%d err=%v", m.Channel, m.Key, m.Attempts, err)
+			if err := uc.queue.DeadLetter(ctx, m); err != nil {
+				uc.log.Errorf("移入死信失败: key=%s err=%v", m.Key, err)
+			}
+			return
+		}
+		backoff := uc.backoff(m.Attempts)
+		uc.log.Warnf("消息发送失败，%v 后重试: channel=%s key=%s attempts=%d err=%v", backoff, m.Channel, m.Key, m.Attempts, err)
+		if err := uc.queue.Retry(ctx, m, time.Now().Add(backoff)); err != nil {
+			uc.log.Errorf("消息重新入队失败: key=%s err=%v", m.Key, err)
+		}
+		return
+	}
+	uc.ack(ctx, m)
+}
+
+func (uc *OutboundUseCase) deliver(ctx context.Context, m *OutboundMessage) error {
+	switch m.Channel {
+	case DeliveryChannelSms:
+		return uc.sms.Send(ctx, m.To, m.Template, m.Params)
+	case DeliveryChannelEmail:
+		return uc.email.SendMail(ctx, &email.Mail{
+			To:          m.To,
+			Template:    m.Template,
+			Params:      m.Params,
+			Locales:     m.Locales,
+			Attachments: m.Attachments,
+			Inline:      m.Inline,
+		})
+	default:
+		return fmt.Errorf("unknown channel %q", m.Channel)
+	}
+}
+
+func (uc *OutboundUseCase) ack(ctx context.Context, m *OutboundMessage) {
+	if err := uc.queue.Ack(ctx, m); err != nil {
+		uc.log.Errorf("确认消息失败: key=%s err=%v", m.Key, err)
+	}
+}
+
+// backoff 第 n 次失败后的等待时间：base * 2^(n-1)，不超过 maxBackoff
+func (uc *OutboundUseCase) backoff(attempts int) time.Duration {
+	d := uc.baseBackoff
+	for i := 1; i < attempts && d < uc.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > uc.maxBackoff {
+		d = uc.maxBackoff
+	}
+	return d
+}
+
+// NewOutboundSmsSender 业务层使用的短信发送器，经由出站队列发送
+func NewOutboundSmsSender(uc *OutboundUseCase) SmsSender {
+	return outboundSmsSender{uc}
+}
+
+// NewOutboundEmailSender 业务层使用的邮件发送器，经由出站队列发送
+func NewOutboundEmailSender(uc *OutboundUseCase) EmailSender {
+	return outboundEmailSender{uc}
+}
+
+type outboundSmsSender struct{ uc *OutboundUseCase }
+
+func (s outboundSmsSender) Send(ctx context.Context, phone, templateName string, params map[string]string) error {
+	return s.uc.SendSms(ctx, phone, templateName, params)
+}
+
+type outboundEmailSender struct{ uc *OutboundUseCase }
+
+func (s outboundEmailSender) Send(ctx context.Context, to, templateName string, params map[string]string) error {
+	return s.uc.SendMail(ctx, &email.Mail{To: to, Template: templateName, Params: params})
+}
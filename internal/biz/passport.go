@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/clientinfo"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/phone"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -19,6 +22,7 @@ var (
 	ErrPasswordInvalid    = kerrors.BadRequest("PASSWORD_INVALID", "密码错误")
 	ErrMobileAlreadyBound = kerrors.Conflict("MOBILE_ALREADY_BOUND", "手机号已被绑定")
 	ErrUserDisabled       = kerrors.Forbidden("USER_DISABLED", "账号已被禁用")
+	ErrNicknameInvalid    = kerrors.BadRequest("NICKNAME_INVALID", "昵称不能为空")
 )
 
 type User struct {
@@ -26,6 +30,7 @@ type User struct {
 	Username     string
 	PasswordHash string
 	Phone        string
+	Email        string
 	Nickname     string
 	IsAvailable  bool
 	CreatedAt    time.Time
@@ -39,30 +44,48 @@ type UserRepo interface {
 	GetUserByID(ctx context.Context, id int64) (*User, error)
 	UpdatePassword(ctx context.Context, id int64, passwordHash string) error
 	UpdatePhone(ctx context.Context, id int64, phone string) error
+	UpdateNickname(ctx context.Context, id int64, nickname string) error
 }
 
+// loginAlertTimeout 异步发送登录提醒的超时时间
+const loginAlertTimeout = 30 * time.Second
+
 type PassportUseCase struct {
-	auth auth.TokenService
-	user UserRepo
-	conf *conf.App_Auth_Passport
-	log  *log.Helper
+	auth         auth.TokenService
+	user         UserRepo
+	phone        *phone.Normalizer
+	sensitive    *SensitiveUseCase
+	notification *NotificationUseCase
+	conf         *conf.App_Auth_Passport
+	log          *log.Helper
 }
 
 func NewPassportUseCase(
 	auth auth.TokenService,
 	user UserRepo,
+	phone *phone.Normalizer,
+	sensitive *SensitiveUseCase,
+	notification *NotificationUseCase,
 	conf *conf.App,
 	logger log.Logger,
 ) *PassportUseCase {
 	return &PassportUseCase{
-		auth: auth,
-		user: user,
-		conf: conf.Auth.Passport,
-		log:  log.NewHelper(logger),
+		auth:         auth,
+		user:         user,
+		phone:        phone,
+		sensitive:    sensitive,
+		notification: notification,
+		conf:         conf.Auth.Passport,
+		log:          log.NewHelper(logger),
 	}
 }
 
 func (uc *PassportUseCase) Register(ctx context.Context, username, password, phone string) (string, error) {
+	// 用户名敏感词检查
+	if err := uc.sensitive.CheckName(ctx, SensitiveSceneUsername, 0, username); err != nil {
+		return "", err
+	}
+
 	// 检查用户名是否存在
 	if u, _ := uc.user.GetUserByUsername(ctx, username); u != nil {
 		return "", ErrUserAlreadyExists
@@ -104,8 +127,12 @@ func (uc *PassportUseCase) LoginByPassword(ctx context.Context, username, passwo
 	user, err := uc.user.GetUserByUsername(ctx, username)
 	if err != nil {
 		if errors.Is(err, ErrUserNotFound) {
-			// 如果按用户名未找到，尝试按手机号查找
-			u, errPhone := uc.user.GetUserByPhone(ctx, username)
+			// 如果按用户名未找到，尝试按手机号查找（手机号以 E.164 格式存储）
+			mobile, errPhone := uc.phone.Normalize("", username)
+			if errPhone != nil {
+				return "", ErrUserNotFound
+			}
+			u, errPhone := uc.user.GetUserByPhone(ctx, mobile)
 			if errPhone != nil {
 				if errors.Is(errPhone, ErrUserNotFound) {
 					return "", ErrUserNotFound
@@ -127,7 +154,7 @@ func (uc *PassportUseCase) LoginByPassword(ctx context.Context, username, passwo
 		return "", ErrUserDisabled
 	}
 
-	return uc.auth.GenerateToken(ctx, uc.formatUserID(user.ID))
+	return uc.login(ctx, user)
 }
 
 func (uc *PassportUseCase) LoginByOtp(ctx context.Context, phone string) (string, error) {
@@ -160,7 +187,25 @@ func (uc *PassportUseCase) LoginByOtp(ctx context.Context, phone string) (string
 		return "", ErrUserDisabled
 	}
 
-	return uc.auth.GenerateToken(ctx, uc.formatUserID(user.ID))
+	return uc.login(ctx, user)
+}
+
+// login 签发令牌并异步发送登录提醒，提醒不计入登录耗时，失败不影响登录
+func (uc *PassportUseCase) login(ctx context.Context, user *User) (string, error) {
+	token, err := uc.auth.GenerateToken(ctx, uc.formatUserID(user.ID))
+	if err != nil {
+		return "", err
+	}
+
+	ip, at := clientinfo.IP(ctx), time.Now()
+	go func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, loginAlertTimeout)
+		defer cancel()
+		if err := uc.notification.NotifyLogin(ctx, user.ID, ip, at); err != nil {
+			uc.log.WithContext(ctx).Warnf("发送登录提醒失败: user_id=%d err=%v", user.ID, err)
+		}
+	}(context.WithoutCancel(ctx))
+	return token, nil
 }
 
 func (uc *PassportUseCase) Logout(ctx context.Context) error {
@@ -232,6 +277,23 @@ func (uc *PassportUseCase) UpdateMobile(ctx context.Context, mobile string) erro
 	return uc.user.UpdatePhone(ctx, userId, mobile)
 }
 
+func (uc *PassportUseCase) UpdateNickname(ctx context.Context, nickname string) error {
+	userId, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	nickname = strings.TrimSpace(nickname)
+	if nickname == "" {
+		return ErrNicknameInvalid
+	}
+	if err := uc.sensitive.CheckName(ctx, SensitiveSceneNickname, userId, nickname); err != nil {
+		return err
+	}
+
+	return uc.user.UpdateNickname(ctx, userId, nickname)
+}
+
 // CheckPhoneRegistered 检查手机号是否已注册
 func (uc *PassportUseCase) CheckPhoneRegistered(ctx context.Context, phone string) error {
 	_, err := uc.user.GetUserByPhone(ctx, phone)
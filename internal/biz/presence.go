@@ -0,0 +1,121 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
+)
+
+// ActionPresence 在线状态变化的 WebSocket 推送动作
+const ActionPresence = "presence"
+
+// Presence 用户在线状态
+type Presence struct {
+	UserID   int64
+	Online   bool
+	LastSeen time.Time // 最后活跃时间，无记录时为零值
+	Devices  []string  // 在线的设备类型
+}
+
+// PresenceRepo 在线状态存储，按连接记录心跳，用户的任一连接（任一实例、任一设备）未过期即为在线
+type PresenceRepo interface {
+	// Touch 记录连接活跃并刷新最后活跃时间，返回用户是否因此由离线变为在线
+	Touch(ctx context.Context, userID int64, connID, device string, at time.Time) (bool, error)
+	// Remove 移除连接并刷新最后活跃时间，返回用户是否因此变为离线
+	Remove(ctx context.Context, userID int64, connID, device string, at time.Time) (bool, error)
+	// Get 批量查询，顺序与 userIDs 一致
+	Get(ctx context.Context, userIDs []int64, at time.Time) ([]*Presence, error)
+}
+
+// PresenceUseCase 在线状态只对联系人（有单聊会话或同在一个群组的用户）可见
+type PresenceUseCase struct {
+	repo PresenceRepo
+	chat ChatRepo
+	auth auth.TokenService
+	log  *log.Helper
+}
+
+func NewPresenceUseCase(repo PresenceRepo, chat ChatRepo, auth auth.TokenService, logger log.Logger) *PresenceUseCase {
+	return &PresenceUseCase{
+		repo: repo,
+		chat: chat,
+		auth: auth,
+		log:  log.NewHelper(log.With(logger, "module", "usecase/presence")),
+	}
+}
+
+// Connect 连接建立，用户由离线变为在线时返回新状态，否则返回 nil
+func (uc *PresenceUseCase) Connect(ctx context.Context, userID int64, connID, device string) (*Presence, error) {
+	now := time.Now()
+	changed, err := uc.repo.Touch(ctx, userID, connID, device, now)
+	if err != nil || !changed {
+		return nil, err
+	}
+	return &Presence{UserID: userID, Online: true, LastSeen: now, Devices: []string{device}}, nil
+}
+
+// Heartbeat 连接心跳，续期连接并刷新最后活跃时间
+func (uc *PresenceUseCase) Heartbeat(ctx context.Context, userID int64, connID, device string) (*Presence, error) {
+	// 连接记录已过期（如长时间未收到心跳）时同样视为重新上线
+	return uc.Connect(ctx, userID, connID, device)
+}
+
+// Disconnect 连接断开，用户的最后一个连接断开时返回离线状态，否则返回 nil
+func (uc *PresenceUseCase) Disconnect(ctx context.Context, userID int64, connID, device string) (*Presence, error) {
+	now := time.Now()
+	changed, err := uc.repo.Remove(ctx, userID, connID, device, now)
+	if err != nil || !changed {
+		return nil, err
+	}
+	return &Presence{UserID: userID, Online: false, LastSeen: now}, nil
+}
+
+// GetPresence 批量查询在线状态
+func (uc *PresenceUseCase) GetPresence(ctx context.Context, userIDs []int64) ([]*Presence, error) {
+	viewer, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := uc.visible(ctx, viewer, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(visible) == 0 {
+		return nil, nil
+	}
+	return uc.repo.Get(ctx, visible, time.Now())
+}
+
+// CanView 用户能否查看、订阅目标用户的在线状态
+func (uc *PresenceUseCase) CanView(ctx context.Context, viewer, target int64) (bool, error) {
+	visible, err := uc.visible(ctx, viewer, []int64{target})
+	return len(visible) > 0, err
+}
+
+// visible userIDs 中对 viewer 可见的用户（自己及联系人），保持原有顺序
+func (uc *PresenceUseCase) visible(ctx context.Context, viewer int64, userIDs []int64) ([]int64, error) {
+	others := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != viewer {
+			others = append(others, id)
+		}
+	}
+	contacts, err := uc.chat.ListContacts(ctx, viewer, others)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[int64]struct{}, len(contacts)+1)
+	allowed[viewer] = struct{}{}
+	for _, id := range contacts {
+		allowed[id] = struct{}{}
+	}
+	result := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		if _, ok := allowed[id]; ok {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
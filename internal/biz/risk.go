@@ -0,0 +1,206 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+var ErrorCaptchaRequired = errors.BadRequest("CAPTCHA_REQUIRED", "请完成图形验证码验证")
+
+const (
+	riskAccountFailKeyPattern = "risk:fail:%s:account:%s"
+	riskIPFailKeyPattern      = "risk:fail:%s:ip:%s"
+	riskIPRequestKeyPattern   = "risk:req:%s:ip:%s"
+	riskDeviceKeyPattern      = "risk:device:%s:%s"
+
+	riskDefaultThreshold = 3
+	riskDefaultWindow    = 15 * time.Minute
+	riskDefaultDeviceTTL = 90 * 24 * time.Hour
+)
+
+// 返回给客户端的 metadata，提示需要展示的验证码场景
+const (
+	RiskMetadataCaptchaRequired = "captcha_required"
+	RiskMetadataCaptchaScene    = "captcha_scene"
+)
+
+// RiskAction 需要风险评估的操作，取值与对应的图形验证码场景一致
+type RiskAction string
+
+const (
+	RiskActionLogin  RiskAction = CaptchaSceneLogin
+	RiskActionSmsOtp RiskAction = CaptchaSceneSmsOtp
+)
+
+// RiskSubject 风险评估对象
+type RiskSubject struct {
+	Action  RiskAction
+	Account string // 用户名或规范化后的手机号
+	IP      string
+	Device  string // 设备指纹
+}
+
+// RiskResult 风险评估结果
+type RiskResult struct {
+	Score           int
+	CaptchaRequired bool
+}
+
+type RiskCache interface {
+	Incr(ctx context.Context, key string, expiration time.Duration) (int64, error)
+	// Count 获取计数，不存在时返回 0
+	Count(ctx context.Context, key string) (int64, error)
+	Set(ctx context.Context, key string, value string, expiration time.Duration) error
+	Exists(ctx context.Context, key string) (bool, error)
+	Del(ctx context.Context, key string) error
+}
+
+type RiskUseCase struct {
+	cache     RiskCache
+	captcha   *CaptchaUseCase
+	conf      *conf.App_Risk
+	threshold int
+	window    time.Duration
+	deviceTTL time.Duration
+	log       *log.Helper
+}
+
+func NewRiskUseCase(cache RiskCache, captcha *CaptchaUseCase, c *conf.App, logger log.Logger) *RiskUseCase {
+	uc := &RiskUseCase{
+		cache:     cache,
+		captcha:   captcha,
+		conf:      c.Risk,
+		threshold: riskDefaultThreshold,
+		window:    riskDefaultWindow,
+		deviceTTL: riskDefaultDeviceTTL,
+		log:       log.NewHelper(log.With(logger, "module", "biz/risk")),
+	}
+	if uc.conf == nil {
+		uc.conf = &conf.App_Risk{}
+	}
+	if uc.conf.CaptchaThreshold > 0 {
+		uc.threshold = int(uc.conf.CaptchaThreshold)
+	}
+	if uc.conf.Window != nil && uc.conf.Window.AsDuration() > 0 {
+		uc.window = uc.conf.Window.AsDuration()
+	}
+	if uc.conf.DeviceTtl != nil && uc.conf.DeviceTtl.AsDuration() > 0 {
+		uc.deviceTTL = uc.conf.DeviceTtl.AsDuration()
+	}
+	return uc
+}
+
+// Evaluate 评估风险，只读取计数，不计入请求频率
+func (uc *RiskUseCase) Evaluate(ctx context.Context, s *RiskSubject) *RiskResult {
+	if !uc.conf.Enabled {
+		return &RiskResult{CaptchaRequired: true}
+	}
+
+	score := 0
+	if s.Account != "" {
+		score += int(uc.count(ctx, fmt.Sprintf(riskAccountFailKeyPattern, s.Action, s.Account))) * int(uc.conf.AccountFailureWeight)
+	}
+	if s.IP != "" {
+		score += int(uc.count(ctx, fmt.Sprintf(riskIPFailKeyPattern, s.Action, s.IP))) * int(uc.conf.IpFailureWeight)
+		requests := uc.count(ctx, fmt.Sprintf(riskIPRequestKeyPattern, s.Action, s.IP))
+		if over := requests - int64(uc.conf.VelocityLimit); over > 0 {
+			score += int(over) * int(uc.conf.VelocityWeight)
+		}
+	}
+	switch {
+	case s.Device == "":
+		score += int(uc.conf.MissingDeviceWeight)
+	case s.Account != "":
+		known, err := uc.cache.Exists(ctx, fmt.Sprintf(riskDeviceKeyPattern, s.Account, s.Device))
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("查询可信设备失败: %v", err)
+		}
+		if !known {
+			score += int(uc.conf.NewDeviceWeight)
+		}
+	}
+
+	return &RiskResult{Score: score, CaptchaRequired: score >= uc.threshold}
+}
+
+// Guard 计入请求频率并评估风险，高风险时校验图形验证码，低风险时跳过
+func (uc *RiskUseCase) Guard(ctx context.Context, s *RiskSubject, captchaID, captcha string) error {
+	if uc.conf.Enabled && s.IP != "" {
+		if _, err := uc.cache.Incr(ctx, fmt.Sprintf(riskIPRequestKeyPattern, s.Action, s.IP), uc.window); err != nil {
+			uc.log.WithContext(ctx).Errorf("记录请求频率失败: %v", err)
+		}
+	}
+
+	result := uc.Evaluate(ctx, s)
+	if !result.CaptchaRequired {
+		return nil
+	}
+	if captchaID == "" || captcha == "" {
+		return ErrorCaptchaRequired.WithMetadata(uc.captchaMetadata(s.Action))
+	}
+	if err := uc.captcha.Verify(ctx, string(s.Action), captchaID, captcha); err != nil {
+		uc.RecordFailure(ctx, s)
+		return err
+	}
+	return nil
+}
+
+// RecordFailure 记录一次失败（密码错误、验证码错误等）
+func (uc *RiskUseCase) RecordFailure(ctx context.Context, s *RiskSubject) {
+	if !uc.conf.Enabled {
+		return
+	}
+	if s.Account != "" {
+		if _, err := uc.cache.Incr(ctx, fmt.Sprintf(riskAccountFailKeyPattern, s.Action, s.Account), uc.window); err != nil {
+			uc.log.WithContext(ctx).Errorf("记录账号失败次数失败: %v", err)
+		}
+	}
+	if s.IP != "" {
+		if _, err := uc.cache.Incr(ctx, fmt.Sprintf(riskIPFailKeyPattern, s.Action, s.IP), uc.window); err != nil {
+			uc.log.WithContext(ctx).Errorf("记录 IP 失败次数失败: %v", err)
+		}
+	}
+}
+
+// RecordSuccess 操作成功：清理账号失败计数，并将当前设备记为可信设备
+func (uc *RiskUseCase) RecordSuccess(ctx context.Context, s *RiskSubject) {
+	if !uc.conf.Enabled || s.Account == "" {
+		return
+	}
+	_ = uc.cache.Del(ctx, fmt.Sprintf(riskAccountFailKeyPattern, s.Action, s.Account))
+	if s.Device != "" {
+		if err := uc.cache.Set(ctx, fmt.Sprintf(riskDeviceKeyPattern, s.Account, s.Device), "1", uc.deviceTTL); err != nil {
+			uc.log.WithContext(ctx).Errorf("记录可信设备失败: %v", err)
+		}
+	}
+}
+
+// WithCaptchaHint 失败后若已达到阈值，在错误中提示客户端下次请求需携带图形验证码
+func (uc *RiskUseCase) WithCaptchaHint(ctx context.Context, s *RiskSubject, err error) error {
+	se := errors.FromError(err)
+	if se == nil || !uc.Evaluate(ctx, s).CaptchaRequired {
+		return err
+	}
+	return se.WithMetadata(uc.captchaMetadata(s.Action))
+}
+
+func (uc *RiskUseCase) captchaMetadata(action RiskAction) map[string]string {
+	return map[string]string{
+		RiskMetadataCaptchaRequired: strconv.FormatBool(true),
+		RiskMetadataCaptchaScene:    string(action),
+	}
+}
+
+func (uc *RiskUseCase) count(ctx context.Context, key string) int64 {
+	n, err := uc.cache.Count(ctx, key)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("查询风险计数失败: key=%s err=%v", key, err)
+	}
+	return n
+}
@@ -0,0 +1,134 @@
+package biz
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/sensitive"
+)
+
+var ErrContentSensitive = kerrors.BadRequest("CONTENT_SENSITIVE", "内容包含敏感词")
+
+// SensitiveScene 敏感词检查场景
+type SensitiveScene string
+
+const (
+	SensitiveSceneChat     SensitiveScene = "chat"
+	SensitiveSceneNickname SensitiveScene = "nickname"
+	SensitiveSceneUsername SensitiveScene = "username"
+)
+
+const sensitiveDefaultReloadInterval = time.Minute
+
+// SensitiveReview 命中 review 词条、待人工审核的内容
+type SensitiveReview struct {
+	Scene   SensitiveScene
+	UserID  int64
+	Content string
+	Words   []string
+}
+
+type SensitiveRepo interface {
+	// ListWords 数据库维护的词条
+	ListWords(ctx context.Context) ([]sensitive.Word, error)
+	CreateReview(ctx context.Context, r *SensitiveReview) error
+}
+
+// SensitiveUseCase 敏感词检查，词库由配置文件与数据库合并，定期重新加载并原子替换
+type SensitiveUseCase struct {
+	repo     SensitiveRepo
+	filter   *sensitive.Filter
+	files    *sensitive.FileSource
+	interval time.Duration
+	loaded   atomic.Bool
+	log      *log.Helper
+}
+
+func NewSensitiveUseCase(repo SensitiveRepo, c *conf.App, logger log.Logger) *SensitiveUseCase {
+	sc := c.Sensitive
+	if sc == nil {
+		sc = &conf.App_Sensitive{}
+	}
+	action, ok := sensitive.ParseAction(sc.DefaultAction)
+	if !ok {
+		action = sensitive.ActionMask
+	}
+	uc := &SensitiveUseCase{
+		repo:     repo,
+		filter:   sensitive.NewFilter(sc.Mask),
+		files:    sensitive.NewFileSource(action, sc.Files...),
+		interval: sensitiveDefaultReloadInterval,
+		log:      log.NewHelper(log.With(logger, "module", "usecase/sensitive")),
+	}
+	if sc.ReloadInterval != nil && sc.ReloadInterval.AsDuration() > 0 {
+		uc.interval = sc.ReloadInterval.AsDuration()
+	}
+	return uc
+}
+
+// Load 加载词库，任一来源失败时保留当前词库
+func (uc *SensitiveUseCase) Load(ctx context.Context) error {
+	words, err := sensitive.LoadAll(ctx, uc.files, sensitive.SourceFunc(uc.repo.ListWords))
+	if err != nil {
+		return err
+	}
+	uc.filter.Load(words)
+	if !uc.loaded.Swap(true) {
+		uc.log.Infof("敏感词库已加载: %d 个词条", uc.filter.Len())
+	}
+	return nil
+}
+
+// Watch 启动时加载词库，之后按间隔重新加载，供 worker 循环调用
+func (uc *SensitiveUseCase) Watch(ctx context.Context, _ string) error {
+	if !uc.loaded.Load() {
+		return uc.Load(ctx)
+	}
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-time.After(uc.interval):
+	}
+	return uc.Load(ctx)
+}
+
+// CheckText 检查可掩码的文本（如聊天内容）：reject 拒绝，其余返回 mask 词条掩码后的文本，
+// 命中 review 时记录原文待审核
+func (uc *SensitiveUseCase) CheckText(ctx context.Context, scene SensitiveScene, userID int64, text string) (string, error) {
+	r := uc.filter.Check(text)
+	switch r.Action {
+	case sensitive.ActionReject:
+		return "", ErrContentSensitive
+	case sensitive.ActionReview:
+		uc.review(ctx, scene, userID, text, r)
+	}
+	return r.Text, nil
+}
+
+// CheckName 检查用户名、昵称等不宜掩码的文本：命中 mask、reject 均拒绝，review 放行并记录待审核
+func (uc *SensitiveUseCase) CheckName(ctx context.Context, scene SensitiveScene, userID int64, name string) error {
+	r := uc.filter.Check(name)
+	switch r.Action {
+	case sensitive.ActionReject, sensitive.ActionMask:
+		return ErrContentSensitive
+	case sensitive.ActionReview:
+		uc.review(ctx, scene, userID, name, r)
+	}
+	return nil
+}
+
+// review 记录待审核内容，失败不影响业务
+func (uc *SensitiveUseCase) review(ctx context.Context, scene SensitiveScene, userID int64, content string, r *sensitive.Result) {
+	if err := uc.repo.CreateReview(ctx, &SensitiveReview{
+		Scene:   scene,
+		UserID:  userID,
+		Content: content,
+		Words:   r.Words(),
+	}); err != nil {
+		uc.log.WithContext(ctx).Errorf("记录待审核内容失败: scene=%s user_id=%d err=%v", scene, userID, err)
+	}
+}
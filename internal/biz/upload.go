@@ -1,9 +1,14 @@
 package biz
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"fmt"
+	"image"
+	_ "image/gif"  // 注册 GIF 解码，用于读取图片尺寸
+	_ "image/jpeg" // 注册 JPEG 解码
+	_ "image/png"  // 注册 PNG 解码
 	"io"
 	"net/http"
 	"path/filepath"
@@ -12,6 +17,7 @@ import (
 
 	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
@@ -25,19 +31,49 @@ var (
 	ErrorUploadFileSizeExceeded = kerrors.BadRequest("UPLOAD_FILE_SIZE_EXCEEDED", "文件大小超出限制")
 	// ErrorUploadFileFailed 文件上传失败
 	ErrorUploadFileFailed = kerrors.InternalServer("UPLOAD_FILE_FAILED", "文件上传失败")
+	// ErrorUploadFileNotFound 文件不存在
+	ErrorUploadFileNotFound = kerrors.NotFound("UPLOAD_FILE_NOT_FOUND", "文件不存在")
+	// ErrorUploadFileNotOwned 文件不属于当前用户
+	ErrorUploadFileNotOwned = kerrors.Forbidden("UPLOAD_FILE_NOT_OWNED", "只能引用自己上传的文件")
 )
 
+// 读取图片尺寸时最多预读的字节数，JPEG 的尺寸信息可能位于较大的 EXIF 之后
+const uploadImageHeaderSize = 64 << 10
+
+// UploadedFile 已上传文件的记录，用于校验引用方的归属
+type UploadedFile struct {
+	UserID      int64
+	FileKey     string
+	Scene       string
+	Name        string
+	ContentType string
+	Size        int64
+	IsPrivate   bool
+	Width       int32 // 图片宽度，非图片或无法识别时为 0
+	Height      int32
+	CreatedAt   time.Time
+}
+
+type UploadRepo interface {
+	CreateFile(ctx context.Context, f *UploadedFile) error
+	GetFileByKey(ctx context.Context, key string) (*UploadedFile, error)
+}
+
 // UploadUseCase 文件上传用例
 type UploadUseCase struct {
 	oss    oss.Storage
+	repo   UploadRepo
+	auth   auth.TokenService
 	config *conf.App_Upload
 	log    *log.Helper
 }
 
 // NewUploadUseCase 创建文件上传用例
-func NewUploadUseCase(oss oss.Storage, c *conf.App, logger log.Logger) *UploadUseCase {
+func NewUploadUseCase(oss oss.Storage, repo UploadRepo, auth auth.TokenService, c *conf.App, logger log.Logger) *UploadUseCase {
 	return &UploadUseCase{
 		oss:    oss,
+		repo:   repo,
+		auth:   auth,
 		config: c.Upload,
 		log:    log.NewHelper(logger),
 	}
@@ -58,11 +94,18 @@ type UploadFileResult struct {
 	FileURL    string    // 文件访问 URL
 	FileSize   int64     // 文件大小
 	IsPrivate  bool      // 是否私有
+	Width      int32     // 图片宽度
+	Height     int32     // 图片高度
 	UploadedAt time.Time // 上传时间
 }
 
 // UploadFile 上传文件
 func (uc *UploadUseCase) UploadFile(ctx context.Context, input *UploadFileInput) (*UploadFileResult, error) {
+	userID, err := uc.auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// 1. 获取场景配置
 	sceneConfig, ok := uc.config.Scenes[input.Scene]
 	if !ok {
@@ -82,34 +125,89 @@ func (uc *UploadUseCase) UploadFile(ctx context.Context, input *UploadFileInput)
 		return nil, err
 	}
 
-	// 4. 生成文件存储路径
+	// 4. 图片读取尺寸
+	var width, height int32
+	if strings.HasPrefix(input.ContentType, "image/") {
+		width, height = uc.imageSize(input)
+	}
+
+	// 5. 生成文件存储路径
 	fileKey := uc.generateFileKey(sceneConfig.PathPrefix, input.Name)
 
-	// 5. 上传到对象存储
-	_, err := uc.oss.Upload(ctx, fileKey, input.Content, input.Size, input.ContentType, sceneConfig.IsPrivate)
+	// 6. 上传到对象存储
+	_, err = uc.oss.Upload(ctx, fileKey, input.Content, input.Size, input.ContentType, sceneConfig.IsPrivate)
 	if err != nil {
 		uc.log.Errorf("Failed to upload file to OSS: %v", err)
 		return nil, ErrorUploadFileFailed
 	}
 
-	// 6. 生成访问URL
-	var expires time.Duration
-	if uc.config.PrivateUrlExpires != nil {
-		expires = uc.config.PrivateUrlExpires.AsDuration()
-	} else {
-		expires = time.Hour // 默认1小时
+	// 7. 记录文件归属，供聊天附件等引用时校验
+	file := &UploadedFile{
+		UserID:      userID,
+		FileKey:     fileKey,
+		Scene:       input.Scene,
+		Name:        filepath.Base(input.Name),
+		ContentType: input.ContentType,
+		Size:        fileSize,
+		IsPrivate:   sceneConfig.IsPrivate,
+		Width:       width,
+		Height:      height,
+		CreatedAt:   time.Now(),
+	}
+	if err := uc.repo.CreateFile(ctx, file); err != nil {
+		uc.log.Errorf("Failed to record uploaded file: %v", err)
+		return nil, ErrorUploadFileFailed
 	}
-	fileURL := uc.oss.GenerateURL(ctx, fileKey, sceneConfig.IsPrivate, expires)
+
+	// 8. 生成访问URL
+	fileURL := uc.GenerateURL(ctx, fileKey, sceneConfig.IsPrivate)
 
 	return &UploadFileResult{
 		FileKey:    fileKey,
 		FileURL:    fileURL,
 		FileSize:   fileSize,
 		IsPrivate:  sceneConfig.IsPrivate,
-		UploadedAt: time.Now(),
+		Width:      width,
+		Height:     height,
+		UploadedAt: file.CreatedAt,
 	}, nil
 }
 
+// GenerateURL 文件访问 URL，私有文件为按配置有效期签名的临时 URL
+func (uc *UploadUseCase) GenerateURL(ctx context.Context, key string, isPrivate bool) string {
+	var expires time.Duration
+	if uc.config.PrivateUrlExpires != nil {
+		expires = uc.config.PrivateUrlExpires.AsDuration()
+	} else {
+		expires = time.Hour // 默认1小时
+	}
+	return uc.oss.GenerateURL(ctx, key, isPrivate, expires)
+}
+
+// OwnedFile 查询用户自己上传的文件，不存在或不属于该用户时返回错误
+func (uc *UploadUseCase) OwnedFile(ctx context.Context, userID int64, key string) (*UploadedFile, error) {
+	f, err := uc.repo.GetFileByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if f.UserID != userID {
+		return nil, ErrorUploadFileNotOwned
+	}
+	return f, nil
+}
+
+// imageSize 预读文件头解析图片尺寸，无法识别时返回 0
+func (uc *UploadUseCase) imageSize(input *UploadFileInput) (int32, int32) {
+	br := bufio.NewReaderSize(input.Content, uploadImageHeaderSize)
+	input.Content = br
+	head, _ := br.Peek(uploadImageHeaderSize)
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(head))
+	if err != nil {
+		return 0, 0
+	}
+	return int32(cfg.Width), int32(cfg.Height)
+}
+
 // verifyFileType 验证文件类型
 func (uc *UploadUseCase) verifyFileType(input *UploadFileInput, allowedTypes []string) error {
 	if len(allowedTypes) == 0 {
@@ -86,6 +86,7 @@ type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
 	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Websocket     *Server_Websocket      `protobuf:"bytes,3,opt,name=websocket,proto3" json:"websocket,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -134,6 +135,13 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetWebsocket() *Server_Websocket {
+	if x != nil {
+		return x.Websocket
+	}
+	return nil
+}
+
 type Data struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
@@ -217,6 +225,14 @@ type App struct {
 	WorkerId      int64                  `protobuf:"varint,3,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
 	Otp           *App_Otp               `protobuf:"bytes,4,opt,name=otp,proto3" json:"otp,omitempty"`
 	Upload        *App_Upload            `protobuf:"bytes,5,opt,name=upload,proto3" json:"upload,omitempty"`
+	Phone         *App_Phone             `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	Captcha       *App_Captcha           `protobuf:"bytes,7,opt,name=captcha,proto3" json:"captcha,omitempty"`
+	Risk          *App_Risk              `protobuf:"bytes,8,opt,name=risk,proto3" json:"risk,omitempty"`
+	Outbound      *App_Outbound          `protobuf:"bytes,9,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Notification  *App_Notification      `protobuf:"bytes,10,opt,name=notification,proto3" json:"notification,omitempty"`
+	Presence      *App_Presence          `protobuf:"bytes,11,opt,name=presence,proto3" json:"presence,omitempty"`
+	Chat          *App_Chat              `protobuf:"bytes,12,opt,name=chat,proto3" json:"chat,omitempty"`
+	Sensitive     *App_Sensitive         `protobuf:"bytes,13,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -286,13 +302,71 @@ func (x *App) GetUpload() *App_Upload {
 	return nil
 }
 
+func (x *App) GetPhone() *App_Phone {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+func (x *App) GetCaptcha() *App_Captcha {
+	if x != nil {
+		return x.Captcha
+	}
+	return nil
+}
+
+func (x *App) GetRisk() *App_Risk {
+	if x != nil {
+		return x.Risk
+	}
+	return nil
+}
+
+func (x *App) GetOutbound() *App_Outbound {
+	if x != nil {
+		return x.Outbound
+	}
+	return nil
+}
+
+func (x *App) GetNotification() *App_Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *App) GetPresence() *App_Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *App) GetChat() *App_Chat {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *App) GetSensitive() *App_Sensitive {
+	if x != nil {
+		return x.Sensitive
+	}
+	return nil
+}
+
 type Server_HTTP struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr          string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Timeout       *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr    string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 可信的反向代理地址（IP 或 CIDR），仅来自这些地址的请求才采信 X-Real-IP / X-Forwarded-For
+	TrustedProxies []string `protobuf:"bytes,4,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Server_HTTP) Reset() {
@@ -346,6 +420,13 @@ func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *Server_HTTP) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
 type Server_GRPC struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -406,32 +487,44 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
-type Data_Database struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Driver string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	// 连接池配置
-	MaxIdleConns    int32                `protobuf:"varint,3,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
-	MaxOpenConns    int32                `protobuf:"varint,4,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`
-	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,5,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
+type Server_Websocket struct {
+	state                   protoimpl.MessageState                 `protogen:"open.v1"`
+	MaxConnectionsPerUser   int32                                  `protobuf:"varint,1,opt,name=max_connections_per_user,json=maxConnectionsPerUser,proto3" json:"max_connections_per_user,omitempty"`       // 每个用户的连接上限，默认 5
+	MaxConnectionsPerDevice int32                                  `protobuf:"varint,2,opt,name=max_connections_per_device,json=maxConnectionsPerDevice,proto3" json:"max_connections_per_device,omitempty"` // 每个用户同一设备类型的连接上限，0 为不限制
+	OverflowPolicy          string                                 `protobuf:"bytes,3,opt,name=overflow_policy,json=overflowPolicy,proto3" json:"overflow_policy,omitempty"`                                 // 超出上限时：kick_oldest（默认，断开最早的连接）/ reject（拒绝新连接）
+	Cluster                 *Server_Websocket_Cluster              `protobuf:"bytes,4,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Delivery                *Server_Websocket_Delivery             `protobuf:"bytes,5,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	SendBufferSize          int32                                  `protobuf:"varint,6,opt,name=send_buffer_size,json=sendBufferSize,proto3" json:"send_buffer_size,omitempty"`                                                            // 每个连接的发送缓冲区大小，默认 256
+	SlowConsumerPolicy      string                                 `protobuf:"bytes,7,opt,name=slow_consumer_policy,json=slowConsumerPolicy,proto3" json:"slow_consumer_policy,omitempty"`                                                 // 发送缓冲区满时：disconnect（默认，断开连接）/ drop（丢弃该消息，可靠消息随后重发）
+	RateLimits              map[string]*Server_Websocket_RateLimit `protobuf:"bytes,8,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 动作 -> 限流配置，未配置的动作不限流
+	ReadLimit               int64                                  `protobuf:"varint,9,opt,name=read_limit,json=readLimit,proto3" json:"read_limit,omitempty"`                                                                             // 客户端单条消息的最大字节数，默认 64KB
+	WriteWait               *durationpb.Duration                   `protobuf:"bytes,10,opt,name=write_wait,json=writeWait,proto3" json:"write_wait,omitempty"`                                                                             // 单次写入超时，默认 10s
+	PongWait                *durationpb.Duration                   `protobuf:"bytes,11,opt,name=pong_wait,json=pongWait,proto3" json:"pong_wait,omitempty"`                                                                                // 等待客户端 pong 或消息的超时，超时断开连接，默认 60s
+	PingPeriod              *durationpb.Duration                   `protobuf:"bytes,12,opt,name=ping_period,json=pingPeriod,proto3" json:"ping_period,omitempty"`                                                                          // 服务端发送 ping 的周期，须小于 pong_wait，默认为 pong_wait 的 9/10
+	Compression             *Server_Websocket_Compression          `protobuf:"bytes,13,opt,name=compression,proto3" json:"compression,omitempty"`
+	// 允许的 Origin，支持通配符（path.Match 语法），如 https://*.example.com、http://localhost:*；
+	// 为空时只允许同源，"*" 允许所有；不带 Origin 的请求（非浏览器客户端）始终允许
+	AllowedOrigins  []string             `protobuf:"bytes,14,rep,name=allowed_origins,json=allowedOrigins,proto3" json:"allowed_origins,omitempty"`
+	TicketTtl       *durationpb.Duration `protobuf:"bytes,15,opt,name=ticket_ttl,json=ticketTtl,proto3" json:"ticket_ttl,omitempty"`                      // 一次性连接凭证的有效期，默认 30s
+	AllowQueryToken bool                 `protobuf:"varint,16,opt,name=allow_query_token,json=allowQueryToken,proto3" json:"allow_query_token,omitempty"` // 兼容旧客户端：允许通过 query 参数 token 传递令牌（会出现在访问日志中），默认关闭
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Data_Database) Reset() {
-	*x = Data_Database{}
+func (x *Server_Websocket) Reset() {
+	*x = Server_Websocket{}
 	mi := &file_conf_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Database) String() string {
+func (x *Server_Websocket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Database) ProtoMessage() {}
+func (*Server_Websocket) ProtoMessage() {}
 
-func (x *Data_Database) ProtoReflect() protoreflect.Message {
+func (x *Server_Websocket) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -443,157 +536,148 @@ func (x *Data_Database) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
-func (*Data_Database) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use Server_Websocket.ProtoReflect.Descriptor instead.
+func (*Server_Websocket) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 2}
 }
 
-func (x *Data_Database) GetDriver() string {
+func (x *Server_Websocket) GetMaxConnectionsPerUser() int32 {
 	if x != nil {
-		return x.Driver
+		return x.MaxConnectionsPerUser
 	}
-	return ""
+	return 0
 }
 
-func (x *Data_Database) GetSource() string {
+func (x *Server_Websocket) GetMaxConnectionsPerDevice() int32 {
 	if x != nil {
-		return x.Source
+		return x.MaxConnectionsPerDevice
 	}
-	return ""
+	return 0
 }
 
-func (x *Data_Database) GetMaxIdleConns() int32 {
+func (x *Server_Websocket) GetOverflowPolicy() string {
 	if x != nil {
-		return x.MaxIdleConns
+		return x.OverflowPolicy
 	}
-	return 0
+	return ""
 }
 
-func (x *Data_Database) GetMaxOpenConns() int32 {
+func (x *Server_Websocket) GetCluster() *Server_Websocket_Cluster {
 	if x != nil {
-		return x.MaxOpenConns
+		return x.Cluster
 	}
-	return 0
+	return nil
 }
 
-func (x *Data_Database) GetConnMaxLifetime() *durationpb.Duration {
+func (x *Server_Websocket) GetDelivery() *Server_Websocket_Delivery {
 	if x != nil {
-		return x.ConnMaxLifetime
+		return x.Delivery
 	}
 	return nil
 }
 
-type Data_Redis struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 移除 network 字段
-	Addr          string               `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	ReadTimeout   *durationpb.Duration `protobuf:"bytes,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
-	WriteTimeout  *durationpb.Duration `protobuf:"bytes,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
-	Password      string               `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
-	Database      int32                `protobuf:"varint,7,opt,name=database,proto3" json:"database,omitempty"`
-	PoolSize      int32                `protobuf:"varint,8,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Server_Websocket) GetSendBufferSize() int32 {
+	if x != nil {
+		return x.SendBufferSize
+	}
+	return 0
 }
 
-func (x *Data_Redis) Reset() {
-	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Server_Websocket) GetSlowConsumerPolicy() string {
+	if x != nil {
+		return x.SlowConsumerPolicy
+	}
+	return ""
 }
 
-func (x *Data_Redis) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Server_Websocket) GetRateLimits() map[string]*Server_Websocket_RateLimit {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
 }
 
-func (*Data_Redis) ProtoMessage() {}
-
-func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+func (x *Server_Websocket) GetReadLimit() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ReadLimit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
-func (*Data_Redis) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
+func (x *Server_Websocket) GetWriteWait() *durationpb.Duration {
+	if x != nil {
+		return x.WriteWait
+	}
+	return nil
 }
 
-func (x *Data_Redis) GetAddr() string {
+func (x *Server_Websocket) GetPongWait() *durationpb.Duration {
 	if x != nil {
-		return x.Addr
+		return x.PongWait
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
+func (x *Server_Websocket) GetPingPeriod() *durationpb.Duration {
 	if x != nil {
-		return x.ReadTimeout
+		return x.PingPeriod
 	}
 	return nil
 }
 
-func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
+func (x *Server_Websocket) GetCompression() *Server_Websocket_Compression {
 	if x != nil {
-		return x.WriteTimeout
+		return x.Compression
 	}
 	return nil
 }
 
-func (x *Data_Redis) GetPassword() string {
+func (x *Server_Websocket) GetAllowedOrigins() []string {
 	if x != nil {
-		return x.Password
+		return x.AllowedOrigins
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Redis) GetDatabase() int32 {
+func (x *Server_Websocket) GetTicketTtl() *durationpb.Duration {
 	if x != nil {
-		return x.Database
+		return x.TicketTtl
 	}
-	return 0
+	return nil
 }
 
-func (x *Data_Redis) GetPoolSize() int32 {
+func (x *Server_Websocket) GetAllowQueryToken() bool {
 	if x != nil {
-		return x.PoolSize
+		return x.AllowQueryToken
 	}
-	return 0
+	return false
 }
 
-type Data_Sms struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Provider        string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                                                                                                                // aliyun, tencent
-	TemplateMapping map[string]string      `protobuf:"bytes,2,rep,name=template_mapping,json=templateMapping,proto3" json:"template_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // "otp_login" -> "SMS_123"
-	AccessKey       string                 `protobuf:"bytes,3,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
-	AccessSecret    string                 `protobuf:"bytes,4,opt,name=access_secret,json=accessSecret,proto3" json:"access_secret,omitempty"`
-	SignName        string                 `protobuf:"bytes,5,opt,name=sign_name,json=signName,proto3" json:"sign_name,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// 集群模式：多实例部署时通过 Redis 发布/订阅在实例间转发消息
+type Server_Websocket_Cluster struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`                // 实例标识，为空时使用主机名加随机后缀
+	PresenceTtl   *durationpb.Duration   `protobuf:"bytes,3,opt,name=presence_ttl,json=presenceTtl,proto3" json:"presence_ttl,omitempty"` // 用户所在实例记录的有效期，默认 60s，实例每 1/3 周期续期一次
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Sms) Reset() {
-	*x = Data_Sms{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+func (x *Server_Websocket_Cluster) Reset() {
+	*x = Server_Websocket_Cluster{}
+	mi := &file_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Sms) String() string {
+func (x *Server_Websocket_Cluster) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Sms) ProtoMessage() {}
+func (*Server_Websocket_Cluster) ProtoMessage() {}
 
-func (x *Data_Sms) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+func (x *Server_Websocket_Cluster) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,70 +688,58 @@ func (x *Data_Sms) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Sms.ProtoReflect.Descriptor instead.
-func (*Data_Sms) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
-}
-
-func (x *Data_Sms) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-func (x *Data_Sms) GetTemplateMapping() map[string]string {
-	if x != nil {
-		return x.TemplateMapping
-	}
-	return nil
+// Deprecated: Use Server_Websocket_Cluster.ProtoReflect.Descriptor instead.
+func (*Server_Websocket_Cluster) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 2, 0}
 }
 
-func (x *Data_Sms) GetAccessKey() string {
+func (x *Server_Websocket_Cluster) GetEnabled() bool {
 	if x != nil {
-		return x.AccessKey
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *Data_Sms) GetAccessSecret() string {
+func (x *Server_Websocket_Cluster) GetNodeId() string {
 	if x != nil {
-		return x.AccessSecret
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *Data_Sms) GetSignName() string {
+func (x *Server_Websocket_Cluster) GetPresenceTtl() *durationpb.Duration {
 	if x != nil {
-		return x.SignName
+		return x.PresenceTtl
 	}
-	return ""
+	return nil
 }
 
-type Data_Email struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	From           string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Smtp           *Data_Email_SMTP       `protobuf:"bytes,2,opt,name=smtp,proto3" json:"smtp,omitempty"`
-	SubjectMapping map[string]string      `protobuf:"bytes,3,rep,name=subject_mapping,json=subjectMapping,proto3" json:"subject_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // "otp_bind" -> "绑定验证码"
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// 可靠投递：客户端以 ack=1 连接后，消息按用户分配递增序号，客户端以 ack 动作确认，未确认的消息重发，离线期间的消息重连后补发
+type Server_Websocket_Delivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetryInterval *durationpb.Duration   `protobuf:"bytes,1,opt,name=retry_interval,json=retryInterval,proto3" json:"retry_interval,omitempty"` // 未确认消息的重发间隔，默认 5s
+	MaxRetries    int32                  `protobuf:"varint,2,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`         // 连续重发无确认后断开连接，由客户端重连补发，默认 5
+	MailboxSize   int32                  `protobuf:"varint,3,opt,name=mailbox_size,json=mailboxSize,proto3" json:"mailbox_size,omitempty"`      // 每个用户保留的最近消息数，默认 500
+	MailboxTtl    *durationpb.Duration   `protobuf:"bytes,4,opt,name=mailbox_ttl,json=mailboxTtl,proto3" json:"mailbox_ttl,omitempty"`          // 消息保留时长，默认 7 天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Email) Reset() {
-	*x = Data_Email{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+func (x *Server_Websocket_Delivery) Reset() {
+	*x = Server_Websocket_Delivery{}
+	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Email) String() string {
+func (x *Server_Websocket_Delivery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Email) ProtoMessage() {}
+func (*Server_Websocket_Delivery) ProtoMessage() {}
 
-func (x *Data_Email) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+func (x *Server_Websocket_Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,61 +750,63 @@ func (x *Data_Email) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Email.ProtoReflect.Descriptor instead.
-func (*Data_Email) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+// Deprecated: Use Server_Websocket_Delivery.ProtoReflect.Descriptor instead.
+func (*Server_Websocket_Delivery) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 2, 1}
 }
 
-func (x *Data_Email) GetFrom() string {
+func (x *Server_Websocket_Delivery) GetRetryInterval() *durationpb.Duration {
 	if x != nil {
-		return x.From
+		return x.RetryInterval
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Email) GetSmtp() *Data_Email_SMTP {
+func (x *Server_Websocket_Delivery) GetMaxRetries() int32 {
 	if x != nil {
-		return x.Smtp
+		return x.MaxRetries
 	}
-	return nil
+	return 0
 }
 
-func (x *Data_Email) GetSubjectMapping() map[string]string {
+func (x *Server_Websocket_Delivery) GetMailboxSize() int32 {
 	if x != nil {
-		return x.SubjectMapping
+		return x.MailboxSize
+	}
+	return 0
+}
+
+func (x *Server_Websocket_Delivery) GetMailboxTtl() *durationpb.Duration {
+	if x != nil {
+		return x.MailboxTtl
 	}
 	return nil
 }
 
-type Data_Oss struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Endpoint        string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	AccessKeyId     string                 `protobuf:"bytes,2,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
-	AccessKeySecret string                 `protobuf:"bytes,3,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
-	Bucket          string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Region          string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
-	Domain          string                 `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
-	UseHttps        bool                   `protobuf:"varint,7,opt,name=use_https,json=useHttps,proto3" json:"use_https,omitempty"`
-	Provider        string                 `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// 客户端请求限流：每个连接每个动作在 window 内最多 limit 次
+type Server_Websocket_RateLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Window        *durationpb.Duration   `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Oss) Reset() {
-	*x = Data_Oss{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+func (x *Server_Websocket_RateLimit) Reset() {
+	*x = Server_Websocket_RateLimit{}
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Oss) String() string {
+func (x *Server_Websocket_RateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Oss) ProtoMessage() {}
+func (*Server_Websocket_RateLimit) ProtoMessage() {}
 
-func (x *Data_Oss) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+func (x *Server_Websocket_RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,92 +817,191 @@ func (x *Data_Oss) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Oss.ProtoReflect.Descriptor instead.
-func (*Data_Oss) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4}
+// Deprecated: Use Server_Websocket_RateLimit.ProtoReflect.Descriptor instead.
+func (*Server_Websocket_RateLimit) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 2, 2}
 }
 
-func (x *Data_Oss) GetEndpoint() string {
+func (x *Server_Websocket_RateLimit) GetLimit() int32 {
 	if x != nil {
-		return x.Endpoint
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Data_Oss) GetAccessKeyId() string {
+func (x *Server_Websocket_RateLimit) GetWindow() *durationpb.Duration {
 	if x != nil {
-		return x.AccessKeyId
+		return x.Window
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Oss) GetAccessKeySecret() string {
+// permessage-deflate 压缩，客户端握手时协商，未协商的连接不压缩
+type Server_Websocket_Compression struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`         // 压缩级别 1~9，默认 1（最快）
+	Threshold     int32                  `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"` // 不小于该字节数的消息才压缩，默认 1024
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Websocket_Compression) Reset() {
+	*x = Server_Websocket_Compression{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Websocket_Compression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Websocket_Compression) ProtoMessage() {}
+
+func (x *Server_Websocket_Compression) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
-		return x.AccessKeySecret
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Data_Oss) GetBucket() string {
+// Deprecated: Use Server_Websocket_Compression.ProtoReflect.Descriptor instead.
+func (*Server_Websocket_Compression) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 2, 4}
+}
+
+func (x *Server_Websocket_Compression) GetEnabled() bool {
 	if x != nil {
-		return x.Bucket
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *Data_Oss) GetRegion() string {
+func (x *Server_Websocket_Compression) GetLevel() int32 {
 	if x != nil {
-		return x.Region
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Server_Websocket_Compression) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type Data_Database struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Driver string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Source string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// 连接池配置
+	MaxIdleConns    int32                `protobuf:"varint,3,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
+	MaxOpenConns    int32                `protobuf:"varint,4,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`
+	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,5,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Database) Reset() {
+	*x = Data_Database{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Database) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Database) ProtoMessage() {}
+
+func (x *Data_Database) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Database.ProtoReflect.Descriptor instead.
+func (*Data_Database) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Data_Database) GetDriver() string {
+	if x != nil {
+		return x.Driver
 	}
 	return ""
 }
 
-func (x *Data_Oss) GetDomain() string {
+func (x *Data_Database) GetSource() string {
 	if x != nil {
-		return x.Domain
+		return x.Source
 	}
 	return ""
 }
 
-func (x *Data_Oss) GetUseHttps() bool {
+func (x *Data_Database) GetMaxIdleConns() int32 {
 	if x != nil {
-		return x.UseHttps
+		return x.MaxIdleConns
 	}
-	return false
+	return 0
 }
 
-func (x *Data_Oss) GetProvider() string {
+func (x *Data_Database) GetMaxOpenConns() int32 {
 	if x != nil {
-		return x.Provider
+		return x.MaxOpenConns
 	}
-	return ""
+	return 0
 }
 
-type Data_Email_SMTP struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+func (x *Data_Database) GetConnMaxLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.ConnMaxLifetime
+	}
+	return nil
+}
+
+type Data_Redis struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 移除 network 字段
+	Addr          string               `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	ReadTimeout   *durationpb.Duration `protobuf:"bytes,3,opt,name=read_timeout,json=readTimeout,proto3" json:"read_timeout,omitempty"`
+	WriteTimeout  *durationpb.Duration `protobuf:"bytes,4,opt,name=write_timeout,json=writeTimeout,proto3" json:"write_timeout,omitempty"`
+	Password      string               `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`
+	Database      int32                `protobuf:"varint,7,opt,name=database,proto3" json:"database,omitempty"`
+	PoolSize      int32                `protobuf:"varint,8,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data_Email_SMTP) Reset() {
-	*x = Data_Email_SMTP{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+func (x *Data_Redis) Reset() {
+	*x = Data_Redis{}
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data_Email_SMTP) String() string {
+func (x *Data_Redis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data_Email_SMTP) ProtoMessage() {}
+func (*Data_Redis) ProtoMessage() {}
 
-func (x *Data_Email_SMTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+func (x *Data_Redis) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,63 +1012,1372 @@ func (x *Data_Email_SMTP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data_Email_SMTP.ProtoReflect.Descriptor instead.
-func (*Data_Email_SMTP) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3, 0}
+// Deprecated: Use Data_Redis.ProtoReflect.Descriptor instead.
+func (*Data_Redis) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 1}
 }
 
-func (x *Data_Email_SMTP) GetHost() string {
+func (x *Data_Redis) GetAddr() string {
 	if x != nil {
-		return x.Host
+		return x.Addr
 	}
 	return ""
 }
 
-func (x *Data_Email_SMTP) GetPort() int32 {
+func (x *Data_Redis) GetReadTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.Port
+		return x.ReadTimeout
 	}
-	return 0
+	return nil
 }
 
-func (x *Data_Email_SMTP) GetUsername() string {
+func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.Username
+		return x.WriteTimeout
 	}
-	return ""
+	return nil
 }
 
-func (x *Data_Email_SMTP) GetPassword() string {
+func (x *Data_Redis) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-type App_Auth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PublicPaths   []string               `protobuf:"bytes,1,rep,name=public_paths,json=publicPaths,proto3" json:"public_paths,omitempty"`
-	Passport      *App_Auth_Passport     `protobuf:"bytes,2,opt,name=passport,proto3" json:"passport,omitempty"`
-	Jwt           *App_Auth_JWT          `protobuf:"bytes,3,opt,name=jwt,proto3" json:"jwt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Data_Redis) GetDatabase() int32 {
+	if x != nil {
+		return x.Database
+	}
+	return 0
 }
 
-func (x *App_Auth) Reset() {
-	*x = App_Auth{}
+func (x *Data_Redis) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+type Data_Sms struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Provider        string                   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`                                                                                                                // aliyun, tencent
+	TemplateMapping map[string]string        `protobuf:"bytes,2,rep,name=template_mapping,json=templateMapping,proto3" json:"template_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // "otp_login" -> "SMS_123"
+	AccessKey       string                   `protobuf:"bytes,3,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	AccessSecret    string                   `protobuf:"bytes,4,opt,name=access_secret,json=accessSecret,proto3" json:"access_secret,omitempty"`
+	SignName        string                   `protobuf:"bytes,5,opt,name=sign_name,json=signName,proto3" json:"sign_name,omitempty"`
+	Providers       []*Data_Sms_Provider     `protobuf:"bytes,6,rep,name=providers,proto3" json:"providers,omitempty"` // 按优先级排列的供应商链，配置后忽略上面的单供应商字段
+	CircuitBreaker  *Data_Sms_CircuitBreaker `protobuf:"bytes,7,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	CallbackToken   string                   `protobuf:"bytes,8,opt,name=callback_token,json=callbackToken,proto3" json:"callback_token,omitempty"` // 回执回调地址中携带的校验令牌，如 /callback/sms/aliyun?token=xxx；未配置时拒绝回调
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Sms) Reset() {
+	*x = Data_Sms{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Sms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Sms) ProtoMessage() {}
+
+func (x *Data_Sms) ProtoReflect() protoreflect.Message {
 	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Sms.ProtoReflect.Descriptor instead.
+func (*Data_Sms) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_Sms) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Data_Sms) GetTemplateMapping() map[string]string {
+	if x != nil {
+		return x.TemplateMapping
+	}
+	return nil
+}
+
+func (x *Data_Sms) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Data_Sms) GetAccessSecret() string {
+	if x != nil {
+		return x.AccessSecret
+	}
+	return ""
+}
+
+func (x *Data_Sms) GetSignName() string {
+	if x != nil {
+		return x.SignName
+	}
+	return ""
+}
+
+func (x *Data_Sms) GetProviders() []*Data_Sms_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *Data_Sms) GetCircuitBreaker() *Data_Sms_CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+func (x *Data_Sms) GetCallbackToken() string {
+	if x != nil {
+		return x.CallbackToken
+	}
+	return ""
+}
+
+type Data_Email struct {
+	state          protoimpl.MessageState        `protogen:"open.v1"`
+	From           string                        `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Smtp           *Data_Email_SMTP              `protobuf:"bytes,2,opt,name=smtp,proto3" json:"smtp,omitempty"`
+	SubjectMapping map[string]string             `protobuf:"bytes,3,rep,name=subject_mapping,json=subjectMapping,proto3" json:"subject_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 未按语言配置标题时使用，"bind_email" -> "绑定验证码"
+	DefaultLocale  string                        `protobuf:"bytes,4,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"`                                                                              // 默认语言，对应 templates 下的目录名，默认 zh-CN
+	Locales        map[string]*Data_Email_Locale `protobuf:"bytes,5,rep,name=locales,proto3" json:"locales,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                                     // 按语言配置的邮件标题，key 为语言标签，如 zh-CN、en
+	Provider       string                        `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`                                                                                                             // smtp（默认）/ aliyun / webhook
+	Aliyun         *Data_Email_Aliyun            `protobuf:"bytes,7,opt,name=aliyun,proto3" json:"aliyun,omitempty"`
+	Webhook        *Data_Email_Webhook           `protobuf:"bytes,8,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Data_Email) Reset() {
+	*x = Data_Email{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Email) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Email) ProtoMessage() {}
+
+func (x *Data_Email) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Email.ProtoReflect.Descriptor instead.
+func (*Data_Email) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_Email) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Data_Email) GetSmtp() *Data_Email_SMTP {
+	if x != nil {
+		return x.Smtp
+	}
+	return nil
+}
+
+func (x *Data_Email) GetSubjectMapping() map[string]string {
+	if x != nil {
+		return x.SubjectMapping
+	}
+	return nil
+}
+
+func (x *Data_Email) GetDefaultLocale() string {
+	if x != nil {
+		return x.DefaultLocale
+	}
+	return ""
+}
+
+func (x *Data_Email) GetLocales() map[string]*Data_Email_Locale {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+func (x *Data_Email) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Data_Email) GetAliyun() *Data_Email_Aliyun {
+	if x != nil {
+		return x.Aliyun
+	}
+	return nil
+}
+
+func (x *Data_Email) GetWebhook() *Data_Email_Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type Data_Oss struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint        string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	AccessKeyId     string                 `protobuf:"bytes,2,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	AccessKeySecret string                 `protobuf:"bytes,3,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
+	Bucket          string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Region          string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	Domain          string                 `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
+	UseHttps        bool                   `protobuf:"varint,7,opt,name=use_https,json=useHttps,proto3" json:"use_https,omitempty"`
+	Provider        string                 `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Data_Oss) Reset() {
+	*x = Data_Oss{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Oss) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Oss) ProtoMessage() {}
+
+func (x *Data_Oss) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Oss.ProtoReflect.Descriptor instead.
+func (*Data_Oss) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 4}
+}
+
+func (x *Data_Oss) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Data_Oss) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *Data_Oss) GetAccessKeySecret() string {
+	if x != nil {
+		return x.AccessKeySecret
+	}
+	return ""
+}
+
+func (x *Data_Oss) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *Data_Oss) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Data_Oss) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Data_Oss) GetUseHttps() bool {
+	if x != nil {
+		return x.UseHttps
+	}
+	return false
+}
+
+func (x *Data_Oss) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type Data_Sms_Provider struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                                                        // aliyun, tencent
+	TemplateMapping     map[string]string      `protobuf:"bytes,2,rep,name=template_mapping,json=templateMapping,proto3" json:"template_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // "otp_login" -> "SMS_123"
+	AccessKey           string                 `protobuf:"bytes,3,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	AccessSecret        string                 `protobuf:"bytes,4,opt,name=access_secret,json=accessSecret,proto3" json:"access_secret,omitempty"`
+	SignName            string                 `protobuf:"bytes,5,opt,name=sign_name,json=signName,proto3" json:"sign_name,omitempty"`
+	Endpoint            string                 `protobuf:"bytes,6,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                                                                                                                              // 接入点，为空时使用供应商默认值
+	Region              string                 `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`                                                                                                                                  // 腾讯云地域，如 ap-guangzhou
+	AppId               string                 `protobuf:"bytes,8,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`                                                                                                                       // 腾讯云 SmsSdkAppId
+	IntlTemplateMapping map[string]string      `protobuf:"bytes,9,rep,name=intl_template_mapping,json=intlTemplateMapping,proto3" json:"intl_template_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 国际/港澳台号码使用的模板映射
+	IntlSignName        string                 `protobuf:"bytes,10,opt,name=intl_sign_name,json=intlSignName,proto3" json:"intl_sign_name,omitempty"`                                                                                               // 国际/港澳台号码使用的签名，为空时沿用 sign_name
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Data_Sms_Provider) Reset() {
+	*x = Data_Sms_Provider{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Sms_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Sms_Provider) ProtoMessage() {}
+
+func (x *Data_Sms_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Sms_Provider.ProtoReflect.Descriptor instead.
+func (*Data_Sms_Provider) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2, 0}
+}
+
+func (x *Data_Sms_Provider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Data_Sms_Provider) GetTemplateMapping() map[string]string {
+	if x != nil {
+		return x.TemplateMapping
+	}
+	return nil
+}
+
+func (x *Data_Sms_Provider) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Data_Sms_Provider) GetAccessSecret() string {
+	if x != nil {
+		return x.AccessSecret
+	}
+	return ""
+}
+
+func (x *Data_Sms_Provider) GetSignName() string {
+	if x != nil {
+		return x.SignName
+	}
+	return ""
+}
+
+func (x *Data_Sms_Provider) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Data_Sms_Provider) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Data_Sms_Provider) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *Data_Sms_Provider) GetIntlTemplateMapping() map[string]string {
+	if x != nil {
+		return x.IntlTemplateMapping
+	}
+	return nil
+}
+
+func (x *Data_Sms_Provider) GetIntlSignName() string {
+	if x != nil {
+		return x.IntlSignName
+	}
+	return ""
+}
+
+type Data_Sms_CircuitBreaker struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FailureThreshold int32                  `protobuf:"varint,1,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"` // 连续失败次数阈值，达到后熔断
+	CoolDown         *durationpb.Duration   `protobuf:"bytes,2,opt,name=cool_down,json=coolDown,proto3" json:"cool_down,omitempty"`                          // 熔断冷却时间
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Data_Sms_CircuitBreaker) Reset() {
+	*x = Data_Sms_CircuitBreaker{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Sms_CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Sms_CircuitBreaker) ProtoMessage() {}
+
+func (x *Data_Sms_CircuitBreaker) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Sms_CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*Data_Sms_CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2, 1}
+}
+
+func (x *Data_Sms_CircuitBreaker) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *Data_Sms_CircuitBreaker) GetCoolDown() *durationpb.Duration {
+	if x != nil {
+		return x.CoolDown
+	}
+	return nil
+}
+
+type Data_Email_SMTP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Email_SMTP) Reset() {
+	*x = Data_Email_SMTP{}
+	mi := &file_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Email_SMTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Email_SMTP) ProtoMessage() {}
+
+func (x *Data_Email_SMTP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Email_SMTP.ProtoReflect.Descriptor instead.
+func (*Data_Email_SMTP) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3, 0}
+}
+
+func (x *Data_Email_SMTP) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Data_Email_SMTP) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Data_Email_SMTP) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Data_Email_SMTP) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type Data_Email_Locale struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubjectMapping map[string]string      `protobuf:"bytes,1,rep,name=subject_mapping,json=subjectMapping,proto3" json:"subject_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // "bind_email" -> "Verify your email"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Data_Email_Locale) Reset() {
+	*x = Data_Email_Locale{}
+	mi := &file_conf_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Email_Locale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Email_Locale) ProtoMessage() {}
+
+func (x *Data_Email_Locale) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Email_Locale.ProtoReflect.Descriptor instead.
+func (*Data_Email_Locale) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3, 1}
+}
+
+func (x *Data_Email_Locale) GetSubjectMapping() map[string]string {
+	if x != nil {
+		return x.SubjectMapping
+	}
+	return nil
+}
+
+// 阿里云邮件推送（DirectMail）
+type Data_Email_Aliyun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessKey     string                 `protobuf:"bytes,1,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	AccessSecret  string                 `protobuf:"bytes,2,opt,name=access_secret,json=accessSecret,proto3" json:"access_secret,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                          // 默认 dm.aliyuncs.com，海外地域如 dm.ap-southeast-1.aliyuncs.com；可带 http:// 前缀
+	AccountName   string                 `protobuf:"bytes,4,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"` // 控制台配置的发信地址，默认使用 from
+	FromAlias     string                 `protobuf:"bytes,5,opt,name=from_alias,json=fromAlias,proto3" json:"from_alias,omitempty"`       // 发信人昵称
+	TagName       string                 `protobuf:"bytes,6,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`             // 邮件标签，用于控制台统计
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Email_Aliyun) Reset() {
+	*x = Data_Email_Aliyun{}
+	mi := &file_conf_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Email_Aliyun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Email_Aliyun) ProtoMessage() {}
+
+func (x *Data_Email_Aliyun) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Email_Aliyun.ProtoReflect.Descriptor instead.
+func (*Data_Email_Aliyun) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3, 2}
+}
+
+func (x *Data_Email_Aliyun) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *Data_Email_Aliyun) GetAccessSecret() string {
+	if x != nil {
+		return x.AccessSecret
+	}
+	return ""
+}
+
+func (x *Data_Email_Aliyun) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Data_Email_Aliyun) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *Data_Email_Aliyun) GetFromAlias() string {
+	if x != nil {
+		return x.FromAlias
+	}
+	return ""
+}
+
+func (x *Data_Email_Aliyun) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+// 通用 JSON Webhook，由自建网关或第三方服务完成投递
+type Data_Email_Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 附加请求头，如 Authorization
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`                                                                             // 非空时以 HMAC-SHA256 签名请求体，写入 X-Signature 请求头
+	Timeout       *durationpb.Duration   `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                           // 请求超时，默认 10 秒
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Email_Webhook) Reset() {
+	*x = Data_Email_Webhook{}
+	mi := &file_conf_conf_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Email_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Email_Webhook) ProtoMessage() {}
+
+func (x *Data_Email_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Email_Webhook.ProtoReflect.Descriptor instead.
+func (*Data_Email_Webhook) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3, 3}
+}
+
+func (x *Data_Email_Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Data_Email_Webhook) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Data_Email_Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Data_Email_Webhook) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type App_Auth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicPaths   []string               `protobuf:"bytes,1,rep,name=public_paths,json=publicPaths,proto3" json:"public_paths,omitempty"`
+	Passport      *App_Auth_Passport     `protobuf:"bytes,2,opt,name=passport,proto3" json:"passport,omitempty"`
+	Jwt           *App_Auth_JWT          `protobuf:"bytes,3,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	AdminUserIds  []int64                `protobuf:"varint,4,rep,packed,name=admin_user_ids,json=adminUserIds,proto3" json:"admin_user_ids,omitempty"` // 管理员用户 ID，可访问 /api.admin. 开头的接口
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Auth) Reset() {
+	*x = App_Auth{}
+	mi := &file_conf_conf_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Auth) ProtoMessage() {}
+
+func (x *App_Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Auth.ProtoReflect.Descriptor instead.
+func (*App_Auth) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *App_Auth) GetPublicPaths() []string {
+	if x != nil {
+		return x.PublicPaths
+	}
+	return nil
+}
+
+func (x *App_Auth) GetPassport() *App_Auth_Passport {
+	if x != nil {
+		return x.Passport
+	}
+	return nil
+}
+
+func (x *App_Auth) GetJwt() *App_Auth_JWT {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
+func (x *App_Auth) GetAdminUserIds() []int64 {
+	if x != nil {
+		return x.AdminUserIds
+	}
+	return nil
+}
+
+type App_Otp struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	PhoneScenes   map[string]*App_Otp_Scene `protobuf:"bytes,1,rep,name=phone_scenes,json=phoneScenes,proto3" json:"phone_scenes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 手机号场景
+	EmailScenes   map[string]*App_Otp_Scene `protobuf:"bytes,2,rep,name=email_scenes,json=emailScenes,proto3" json:"email_scenes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 邮箱场景
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Otp) Reset() {
+	*x = App_Otp{}
+	mi := &file_conf_conf_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Otp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Otp) ProtoMessage() {}
+
+func (x *App_Otp) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Otp.ProtoReflect.Descriptor instead.
+func (*App_Otp) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *App_Otp) GetPhoneScenes() map[string]*App_Otp_Scene {
+	if x != nil {
+		return x.PhoneScenes
+	}
+	return nil
+}
+
+func (x *App_Otp) GetEmailScenes() map[string]*App_Otp_Scene {
+	if x != nil {
+		return x.EmailScenes
+	}
+	return nil
+}
+
+type App_Captcha struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Default       *App_Captcha_Profile            `protobuf:"bytes,1,opt,name=default,proto3" json:"default,omitempty"`                                                                         // 默认配置，未单独配置的场景使用
+	Scenes        map[string]*App_Captcha_Profile `protobuf:"bytes,2,rep,name=scenes,proto3" json:"scenes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 场景 -> 配置，如 login、sms_otp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Captcha) Reset() {
+	*x = App_Captcha{}
+	mi := &file_conf_conf_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Captcha) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Captcha) ProtoMessage() {}
+
+func (x *App_Captcha) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Captcha.ProtoReflect.Descriptor instead.
+func (*App_Captcha) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *App_Captcha) GetDefault() *App_Captcha_Profile {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *App_Captcha) GetScenes() map[string]*App_Captcha_Profile {
+	if x != nil {
+		return x.Scenes
+	}
+	return nil
+}
+
+type App_Risk struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Enabled              bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                                         // 关闭时始终要求图形验证码
+	CaptchaThreshold     int32                  `protobuf:"varint,2,opt,name=captcha_threshold,json=captchaThreshold,proto3" json:"captcha_threshold,omitempty"`               // 风险分达到该值时要求图形验证码，默认 3
+	Window               *durationpb.Duration   `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`                                                            // 失败次数及请求频率的统计窗口，默认 15 分钟
+	AccountFailureWeight int32                  `protobuf:"varint,4,opt,name=account_failure_weight,json=accountFailureWeight,proto3" json:"account_failure_weight,omitempty"` // 账号每失败一次的分值
+	IpFailureWeight      int32                  `protobuf:"varint,5,opt,name=ip_failure_weight,json=ipFailureWeight,proto3" json:"ip_failure_weight,omitempty"`                // IP 每失败一次的分值
+	VelocityLimit        int32                  `protobuf:"varint,6,opt,name=velocity_limit,json=velocityLimit,proto3" json:"velocity_limit,omitempty"`                        // 窗口内同 IP 请求次数上限，超出部分按次计分
+	VelocityWeight       int32                  `protobuf:"varint,7,opt,name=velocity_weight,json=velocityWeight,proto3" json:"velocity_weight,omitempty"`                     // 超出上限后每次请求的分值
+	MissingDeviceWeight  int32                  `protobuf:"varint,8,opt,name=missing_device_weight,json=missingDeviceWeight,proto3" json:"missing_device_weight,omitempty"`    // 未携带设备指纹的分值
+	NewDeviceWeight      int32                  `protobuf:"varint,9,opt,name=new_device_weight,json=newDeviceWeight,proto3" json:"new_device_weight,omitempty"`                // 账号首次出现的设备指纹的分值
+	DeviceTtl            *durationpb.Duration   `protobuf:"bytes,10,opt,name=device_ttl,json=deviceTtl,proto3" json:"device_ttl,omitempty"`                                    // 可信设备记忆时长，默认 90 天
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *App_Risk) Reset() {
+	*x = App_Risk{}
+	mi := &file_conf_conf_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Risk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Risk) ProtoMessage() {}
+
+func (x *App_Risk) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Risk.ProtoReflect.Descriptor instead.
+func (*App_Risk) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *App_Risk) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *App_Risk) GetCaptchaThreshold() int32 {
+	if x != nil {
+		return x.CaptchaThreshold
+	}
+	return 0
+}
+
+func (x *App_Risk) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *App_Risk) GetAccountFailureWeight() int32 {
+	if x != nil {
+		return x.AccountFailureWeight
+	}
+	return 0
+}
+
+func (x *App_Risk) GetIpFailureWeight() int32 {
+	if x != nil {
+		return x.IpFailureWeight
+	}
+	return 0
+}
+
+func (x *App_Risk) GetVelocityLimit() int32 {
+	if x != nil {
+		return x.VelocityLimit
+	}
+	return 0
+}
+
+func (x *App_Risk) GetVelocityWeight() int32 {
+	if x != nil {
+		return x.VelocityWeight
+	}
+	return 0
+}
+
+func (x *App_Risk) GetMissingDeviceWeight() int32 {
+	if x != nil {
+		return x.MissingDeviceWeight
+	}
+	return 0
+}
+
+func (x *App_Risk) GetNewDeviceWeight() int32 {
+	if x != nil {
+		return x.NewDeviceWeight
+	}
+	return 0
+}
+
+func (x *App_Risk) GetDeviceTtl() *durationpb.Duration {
+	if x != nil {
+		return x.DeviceTtl
+	}
+	return nil
+}
+
+type App_Outbound struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Enabled           bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                             // 开启后短信、邮件先入队再由后台协程发送，关闭时同步发送并有限次重试
+	Driver            string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`                                                // redis（默认，基于 Streams）/ database
+	Workers           int32                  `protobuf:"varint,3,opt,name=workers,proto3" json:"workers,omitempty"`                                             // 消费协程数，默认 4
+	BatchSize         int32                  `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`                        // 每次拉取的消息数，默认 10
+	MaxAttempts       int32                  `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                  // 最大发送次数，超过后进入死信，默认 5
+	BaseBackoff       *durationpb.Duration   `protobuf:"bytes,6,opt,name=base_backoff,json=baseBackoff,proto3" json:"base_backoff,omitempty"`                   // 首次重试间隔，之后按 2 的指数增长，默认 2 秒
+	MaxBackoff        *durationpb.Duration   `protobuf:"bytes,7,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`                      // 最大重试间隔，默认 5 分钟
+	VisibilityTimeout *durationpb.Duration   `protobuf:"bytes,8,opt,name=visibility_timeout,json=visibilityTimeout,proto3" json:"visibility_timeout,omitempty"` // 消息取出后未确认的超时时间，超时后重新投递，默认 1 分钟
+	IdempotencyTtl    *durationpb.Duration   `protobuf:"bytes,9,opt,name=idempotency_ttl,json=idempotencyTtl,proto3" json:"idempotency_ttl,omitempty"`          // 幂等键保留时长（仅 redis），默认 24 小时
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *App_Outbound) Reset() {
+	*x = App_Outbound{}
+	mi := &file_conf_conf_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Outbound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Outbound) ProtoMessage() {}
+
+func (x *App_Outbound) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Outbound.ProtoReflect.Descriptor instead.
+func (*App_Outbound) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 4}
+}
+
+func (x *App_Outbound) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *App_Outbound) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *App_Outbound) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *App_Outbound) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *App_Outbound) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *App_Outbound) GetBaseBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.BaseBackoff
+	}
+	return nil
+}
+
+func (x *App_Outbound) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *App_Outbound) GetVisibilityTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.VisibilityTimeout
+	}
+	return nil
+}
+
+func (x *App_Outbound) GetIdempotencyTtl() *durationpb.Duration {
+	if x != nil {
+		return x.IdempotencyTtl
+	}
+	return nil
+}
+
+type App_Notification struct {
+	state           protoimpl.MessageState            `protogen:"open.v1"`
+	Types           map[string]*App_Notification_Type `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 通知类型 -> 配置，如 login_alert、system
+	DefaultTimezone string                            `protobuf:"bytes,2,opt,name=default_timezone,json=defaultTimezone,proto3" json:"default_timezone,omitempty"`                                // 用户未设置时区时免打扰时段使用的时区，默认 Asia/Shanghai
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *App_Notification) Reset() {
+	*x = App_Notification{}
+	mi := &file_conf_conf_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Notification) ProtoMessage() {}
+
+func (x *App_Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Notification.ProtoReflect.Descriptor instead.
+func (*App_Notification) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 5}
+}
+
+func (x *App_Notification) GetTypes() map[string]*App_Notification_Type {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *App_Notification) GetDefaultTimezone() string {
+	if x != nil {
+		return x.DefaultTimezone
+	}
+	return ""
+}
+
+type App_Presence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OnlineTtl     *durationpb.Duration   `protobuf:"bytes,1,opt,name=online_ttl,json=onlineTtl,proto3" json:"online_ttl,omitempty"`         // 连接心跳过期时间，超过后视为离线（如实例异常退出），需大于心跳周期，默认 90s
+	LastSeenTtl   *durationpb.Duration   `protobuf:"bytes,2,opt,name=last_seen_ttl,json=lastSeenTtl,proto3" json:"last_seen_ttl,omitempty"` // 最后活跃时间保留时长，默认 30 天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Presence) Reset() {
+	*x = App_Presence{}
+	mi := &file_conf_conf_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Presence) ProtoMessage() {}
+
+func (x *App_Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Presence.ProtoReflect.Descriptor instead.
+func (*App_Presence) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 6}
+}
+
+func (x *App_Presence) GetOnlineTtl() *durationpb.Duration {
+	if x != nil {
+		return x.OnlineTtl
+	}
+	return nil
+}
+
+func (x *App_Presence) GetLastSeenTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LastSeenTtl
+	}
+	return nil
+}
+
+type App_Chat struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GroupMaxMembers int32                  `protobuf:"varint,1,opt,name=group_max_members,json=groupMaxMembers,proto3" json:"group_max_members,omitempty"` // 群成员上限，默认 500
+	RecallWindow    *durationpb.Duration   `protobuf:"bytes,2,opt,name=recall_window,json=recallWindow,proto3" json:"recall_window,omitempty"`             // 发送后可撤回的时长，默认 2 分钟
+	EditWindow      *durationpb.Duration   `protobuf:"bytes,3,opt,name=edit_window,json=editWindow,proto3" json:"edit_window,omitempty"`                   // 发送后可编辑的时长，默认 24 小时
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *App_Chat) Reset() {
+	*x = App_Chat{}
+	mi := &file_conf_conf_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Chat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Chat) ProtoMessage() {}
+
+func (x *App_Chat) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Chat.ProtoReflect.Descriptor instead.
+func (*App_Chat) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 7}
+}
+
+func (x *App_Chat) GetGroupMaxMembers() int32 {
+	if x != nil {
+		return x.GroupMaxMembers
+	}
+	return 0
+}
+
+func (x *App_Chat) GetRecallWindow() *durationpb.Duration {
+	if x != nil {
+		return x.RecallWindow
+	}
+	return nil
+}
+
+func (x *App_Chat) GetEditWindow() *durationpb.Duration {
+	if x != nil {
+		return x.EditWindow
+	}
+	return nil
+}
+
+type App_Sensitive struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Files          []string               `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`                                         // 词库文件，每行 词条[|动作[|变体1,变体2]]，与数据库 sensitive_words 表合并
+	DefaultAction  string                 `protobuf:"bytes,2,opt,name=default_action,json=defaultAction,proto3" json:"default_action,omitempty"`    // 未指定动作的词条：mask/review/reject，默认 mask
+	Mask           string                 `protobuf:"bytes,3,opt,name=mask,proto3" json:"mask,omitempty"`                                           // 掩码字符，默认 *
+	ReloadInterval *durationpb.Duration   `protobuf:"bytes,4,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"` // 重新加载词库的间隔，默认 1 分钟
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *App_Sensitive) Reset() {
+	*x = App_Sensitive{}
+	mi := &file_conf_conf_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *App_Auth) String() string {
+func (x *App_Sensitive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*App_Auth) ProtoMessage() {}
+func (*App_Sensitive) ProtoMessage() {}
 
-func (x *App_Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+func (x *App_Sensitive) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,55 +2388,62 @@ func (x *App_Auth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use App_Auth.ProtoReflect.Descriptor instead.
-func (*App_Auth) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use App_Sensitive.ProtoReflect.Descriptor instead.
+func (*App_Sensitive) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 8}
 }
 
-func (x *App_Auth) GetPublicPaths() []string {
+func (x *App_Sensitive) GetFiles() []string {
 	if x != nil {
-		return x.PublicPaths
+		return x.Files
 	}
 	return nil
 }
 
-func (x *App_Auth) GetPassport() *App_Auth_Passport {
+func (x *App_Sensitive) GetDefaultAction() string {
 	if x != nil {
-		return x.Passport
+		return x.DefaultAction
 	}
-	return nil
+	return ""
 }
 
-func (x *App_Auth) GetJwt() *App_Auth_JWT {
+func (x *App_Sensitive) GetMask() string {
 	if x != nil {
-		return x.Jwt
+		return x.Mask
+	}
+	return ""
+}
+
+func (x *App_Sensitive) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
 	}
 	return nil
 }
 
-type App_Otp struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	PhoneScenes   map[string]*App_Otp_Scene `protobuf:"bytes,1,rep,name=phone_scenes,json=phoneScenes,proto3" json:"phone_scenes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 手机号场景
-	EmailScenes   map[string]*App_Otp_Scene `protobuf:"bytes,2,rep,name=email_scenes,json=emailScenes,proto3" json:"email_scenes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 邮箱场景
+type App_Phone struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	DefaultRegion string                       `protobuf:"bytes,1,opt,name=default_region,json=defaultRegion,proto3" json:"default_region,omitempty"`                                          // 未传国家码时使用的地区，如 CN
+	Regions       map[string]*App_Phone_Region `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 地区代码 -> 号码规则
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *App_Otp) Reset() {
-	*x = App_Otp{}
-	mi := &file_conf_conf_proto_msgTypes[15]
+func (x *App_Phone) Reset() {
+	*x = App_Phone{}
+	mi := &file_conf_conf_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *App_Otp) String() string {
+func (x *App_Phone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*App_Otp) ProtoMessage() {}
+func (*App_Phone) ProtoMessage() {}
 
-func (x *App_Otp) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[15]
+func (x *App_Phone) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,21 +2454,21 @@ func (x *App_Otp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use App_Otp.ProtoReflect.Descriptor instead.
-func (*App_Otp) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3, 1}
+// Deprecated: Use App_Phone.ProtoReflect.Descriptor instead.
+func (*App_Phone) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 9}
 }
 
-func (x *App_Otp) GetPhoneScenes() map[string]*App_Otp_Scene {
+func (x *App_Phone) GetDefaultRegion() string {
 	if x != nil {
-		return x.PhoneScenes
+		return x.DefaultRegion
 	}
-	return nil
+	return ""
 }
 
-func (x *App_Otp) GetEmailScenes() map[string]*App_Otp_Scene {
+func (x *App_Phone) GetRegions() map[string]*App_Phone_Region {
 	if x != nil {
-		return x.EmailScenes
+		return x.Regions
 	}
 	return nil
 }
@@ -994,7 +2483,7 @@ type App_Upload struct {
 
 func (x *App_Upload) Reset() {
 	*x = App_Upload{}
-	mi := &file_conf_conf_proto_msgTypes[16]
+	mi := &file_conf_conf_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1006,7 +2495,7 @@ func (x *App_Upload) String() string {
 func (*App_Upload) ProtoMessage() {}
 
 func (x *App_Upload) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[16]
+	mi := &file_conf_conf_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +2508,7 @@ func (x *App_Upload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use App_Upload.ProtoReflect.Descriptor instead.
 func (*App_Upload) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3, 2}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 10}
 }
 
 func (x *App_Upload) GetPrivateUrlExpires() *durationpb.Duration {
@@ -1045,7 +2534,7 @@ type App_Auth_Passport struct {
 
 func (x *App_Auth_Passport) Reset() {
 	*x = App_Auth_Passport{}
-	mi := &file_conf_conf_proto_msgTypes[17]
+	mi := &file_conf_conf_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1057,7 +2546,7 @@ func (x *App_Auth_Passport) String() string {
 func (*App_Auth_Passport) ProtoMessage() {}
 
 func (x *App_Auth_Passport) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[17]
+	mi := &file_conf_conf_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,7 +2580,7 @@ type App_Auth_JWT struct {
 
 func (x *App_Auth_JWT) Reset() {
 	*x = App_Auth_JWT{}
-	mi := &file_conf_conf_proto_msgTypes[18]
+	mi := &file_conf_conf_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1103,7 +2592,7 @@ func (x *App_Auth_JWT) String() string {
 func (*App_Auth_JWT) ProtoMessage() {}
 
 func (x *App_Auth_JWT) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[18]
+	mi := &file_conf_conf_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,7 +2641,7 @@ type App_Otp_Scene struct {
 
 func (x *App_Otp_Scene) Reset() {
 	*x = App_Otp_Scene{}
-	mi := &file_conf_conf_proto_msgTypes[19]
+	mi := &file_conf_conf_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1164,7 +2653,7 @@ func (x *App_Otp_Scene) String() string {
 func (*App_Otp_Scene) ProtoMessage() {}
 
 func (x *App_Otp_Scene) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[19]
+	mi := &file_conf_conf_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,6 +2697,282 @@ func (x *App_Otp_Scene) GetCodeLength() int32 {
 	return 0
 }
 
+type App_Captcha_Profile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Driver         string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`                                           // digit/string/math/chinese/audio/slider，默认 digit
+	Height         int32                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`                                          // 图片高度，默认 80
+	Width          int32                  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`                                            // 图片宽度，默认 240
+	Length         int32                  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`                                          // 字符长度，math 无效，默认 4
+	MaxSkew        float64                `protobuf:"fixed64,5,opt,name=max_skew,json=maxSkew,proto3" json:"max_skew,omitempty"`                        // digit：最大倾斜度
+	DotCount       int32                  `protobuf:"varint,6,opt,name=dot_count,json=dotCount,proto3" json:"dot_count,omitempty"`                      // digit：干扰点数量
+	NoiseCount     int32                  `protobuf:"varint,7,opt,name=noise_count,json=noiseCount,proto3" json:"noise_count,omitempty"`                // string/math/chinese：噪点数量
+	LineOptions    int32                  `protobuf:"varint,8,opt,name=line_options,json=lineOptions,proto3" json:"line_options,omitempty"`             // string/math/chinese：干扰线类型位掩码，2=空心线 4=粘液线 8=正弦线
+	Source         string                 `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`                                           // string/chinese：字符集，chinese 以逗号分隔
+	Language       string                 `protobuf:"bytes,10,opt,name=language,proto3" json:"language,omitempty"`                                      // audio：语言，如 zh、en
+	Ttl            *durationpb.Duration   `protobuf:"bytes,11,opt,name=ttl,proto3" json:"ttl,omitempty"`                                                // 有效期，默认 10 分钟
+	PieceSize      int32                  `protobuf:"varint,12,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`                  // slider：拼图块边长，默认 40
+	Tolerance      int32                  `protobuf:"varint,13,opt,name=tolerance,proto3" json:"tolerance,omitempty"`                                   // slider：允许的偏移误差（像素），默认 5
+	MinDuration    *durationpb.Duration   `protobuf:"bytes,14,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`             // slider：最短拖动时长，默认 300ms
+	MinTrackPoints int32                  `protobuf:"varint,15,opt,name=min_track_points,json=minTrackPoints,proto3" json:"min_track_points,omitempty"` // slider：最少轨迹点数，默认 5
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *App_Captcha_Profile) Reset() {
+	*x = App_Captcha_Profile{}
+	mi := &file_conf_conf_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Captcha_Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Captcha_Profile) ProtoMessage() {}
+
+func (x *App_Captcha_Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Captcha_Profile.ProtoReflect.Descriptor instead.
+func (*App_Captcha_Profile) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 2, 0}
+}
+
+func (x *App_Captcha_Profile) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *App_Captcha_Profile) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *App_Captcha_Profile) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *App_Captcha_Profile) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *App_Captcha_Profile) GetMaxSkew() float64 {
+	if x != nil {
+		return x.MaxSkew
+	}
+	return 0
+}
+
+func (x *App_Captcha_Profile) GetDotCount() int32 {
+	if x != nil {
+		return x.DotCount
+	}
+	return 0
+}
+
+func (x *App_Captcha_Profile) GetNoiseCount() int32 {
+	if x != nil {
+		return x.NoiseCount
+	}
+	return 0
+}
+
+func (x *App_Captcha_Profile) GetLineOptions() int32 {
+	if x != nil {
+		return x.LineOptions
+	}
+	return 0
+}
+
+func (x *App_Captcha_Profile) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *App_Captcha_Profile) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *App_Captcha_Profile) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *App_Captcha_Profile) GetPieceSize() int32 {
+	if x != nil {
+		return x.PieceSize
+	}
+	return 0
+}
+
+func (x *App_Captcha_Profile) GetTolerance() int32 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *App_Captcha_Profile) GetMinDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MinDuration
+	}
+	return nil
+}
+
+func (x *App_Captcha_Profile) GetMinTrackPoints() int32 {
+	if x != nil {
+		return x.MinTrackPoints
+	}
+	return 0
+}
+
+type App_Notification_Type struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []string               `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`    // 默认渠道，可选 in_app/email/sms，用户只能在其中选择
+	Template      string                 `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`    // 邮件/短信逻辑模板名，默认与通知类型同名
+	Mandatory     bool                   `protobuf:"varint,3,opt,name=mandatory,proto3" json:"mandatory,omitempty"` // 强制通知（如安全提醒），忽略用户退订及免打扰时段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Notification_Type) Reset() {
+	*x = App_Notification_Type{}
+	mi := &file_conf_conf_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Notification_Type) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Notification_Type) ProtoMessage() {}
+
+func (x *App_Notification_Type) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Notification_Type.ProtoReflect.Descriptor instead.
+func (*App_Notification_Type) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 5, 0}
+}
+
+func (x *App_Notification_Type) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *App_Notification_Type) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *App_Notification_Type) GetMandatory() bool {
+	if x != nil {
+		return x.Mandatory
+	}
+	return false
+}
+
+type App_Phone_Region struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CallingCode   string                 `protobuf:"bytes,1,opt,name=calling_code,json=callingCode,proto3" json:"calling_code,omitempty"` // 国际区号，如 86
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`                            // 国内号码校验正则（不含国际区号）
+	TrunkPrefix   string                 `protobuf:"bytes,3,opt,name=trunk_prefix,json=trunkPrefix,proto3" json:"trunk_prefix,omitempty"` // 国内长途前缀，规范化时去除，如英国的 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *App_Phone_Region) Reset() {
+	*x = App_Phone_Region{}
+	mi := &file_conf_conf_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *App_Phone_Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*App_Phone_Region) ProtoMessage() {}
+
+func (x *App_Phone_Region) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use App_Phone_Region.ProtoReflect.Descriptor instead.
+func (*App_Phone_Region) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 9, 0}
+}
+
+func (x *App_Phone_Region) GetCallingCode() string {
+	if x != nil {
+		return x.CallingCode
+	}
+	return ""
+}
+
+func (x *App_Phone_Region) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *App_Phone_Region) GetTrunkPrefix() string {
+	if x != nil {
+		return x.TrunkPrefix
+	}
+	return ""
+}
+
 type App_Upload_Scene struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PathPrefix    string                 `protobuf:"bytes,1,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
@@ -1220,7 +2985,7 @@ type App_Upload_Scene struct {
 
 func (x *App_Upload_Scene) Reset() {
 	*x = App_Upload_Scene{}
-	mi := &file_conf_conf_proto_msgTypes[22]
+	mi := &file_conf_conf_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1232,7 +2997,7 @@ func (x *App_Upload_Scene) String() string {
 func (*App_Upload_Scene) ProtoMessage() {}
 
 func (x *App_Upload_Scene) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[22]
+	mi := &file_conf_conf_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1245,7 +3010,7 @@ func (x *App_Upload_Scene) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use App_Upload_Scene.ProtoReflect.Descriptor instead.
 func (*App_Upload_Scene) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3, 2, 0}
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 10, 0}
 }
 
 func (x *App_Upload_Scene) GetPathPrefix() string {
@@ -1285,18 +3050,64 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
-	"\x03app\x18\x03 \x01(\v2\x0f.kratos.api.AppR\x03app\"\xb8\x02\n" +
+	"\x03app\x18\x03 \x01(\v2\x0f.kratos.api.AppR\x03app\"\x86\x0f\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12:\n" +
+	"\twebsocket\x18\x03 \x01(\v2\x1c.kratos.api.Server.WebsocketR\twebsocket\x1a\x92\x01\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1ai\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12'\n" +
+	"\x0ftrusted_proxies\x18\x04 \x03(\tR\x0etrustedProxies\x1ai\n" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x8d\f\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\xe5\v\n" +
+	"\tWebsocket\x127\n" +
+	"\x18max_connections_per_user\x18\x01 \x01(\x05R\x15maxConnectionsPerUser\x12;\n" +
+	"\x1amax_connections_per_device\x18\x02 \x01(\x05R\x17maxConnectionsPerDevice\x12'\n" +
+	"\x0foverflow_policy\x18\x03 \x01(\tR\x0eoverflowPolicy\x12>\n" +
+	"\acluster\x18\x04 \x01(\v2$.kratos.api.Server.Websocket.ClusterR\acluster\x12A\n" +
+	"\bdelivery\x18\x05 \x01(\v2%.kratos.api.Server.Websocket.DeliveryR\bdelivery\x12(\n" +
+	"\x10send_buffer_size\x18\x06 \x01(\x05R\x0esendBufferSize\x120\n" +
+	"\x14slow_consumer_policy\x18\a \x01(\tR\x12slowConsumerPolicy\x12M\n" +
+	"\vrate_limits\x18\b \x03(\v2,.kratos.api.Server.Websocket.RateLimitsEntryR\n" +
+	"rateLimits\x12\x1d\n" +
+	"\n" +
+	"read_limit\x18\t \x01(\x03R\treadLimit\x128\n" +
+	"\n" +
+	"write_wait\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\twriteWait\x126\n" +
+	"\tpong_wait\x18\v \x01(\v2\x19.google.protobuf.DurationR\bpongWait\x12:\n" +
+	"\vping_period\x18\f \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"pingPeriod\x12J\n" +
+	"\vcompression\x18\r \x01(\v2(.kratos.api.Server.Websocket.CompressionR\vcompression\x12'\n" +
+	"\x0fallowed_origins\x18\x0e \x03(\tR\x0eallowedOrigins\x128\n" +
+	"\n" +
+	"ticket_ttl\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\tticketTtl\x12*\n" +
+	"\x11allow_query_token\x18\x10 \x01(\bR\x0fallowQueryToken\x1az\n" +
+	"\aCluster\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12<\n" +
+	"\fpresence_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vpresenceTtl\x1a\xcc\x01\n" +
+	"\bDelivery\x12@\n" +
+	"\x0eretry_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\rretryInterval\x12\x1f\n" +
+	"\vmax_retries\x18\x02 \x01(\x05R\n" +
+	"maxRetries\x12!\n" +
+	"\fmailbox_size\x18\x03 \x01(\x05R\vmailboxSize\x12:\n" +
+	"\vmailbox_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"mailboxTtl\x1aT\n" +
+	"\tRateLimit\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x121\n" +
+	"\x06window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06window\x1ae\n" +
+	"\x0fRateLimitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12<\n" +
+	"\x05value\x18\x02 \x01(\v2&.kratos.api.Server.Websocket.RateLimitR\x05value:\x028\x01\x1a[\n" +
+	"\vCompression\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x05R\tthreshold\"\xae\x1a\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x12&\n" +
@@ -1315,29 +3126,84 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x12\x1a\n" +
 	"\bpassword\x18\x06 \x01(\tR\bpassword\x12\x1a\n" +
 	"\bdatabase\x18\a \x01(\x05R\bdatabase\x12\x1b\n" +
-	"\tpool_size\x18\b \x01(\x05R\bpoolSize\x1a\x9c\x02\n" +
+	"\tpool_size\x18\b \x01(\x05R\bpoolSize\x1a\x8f\t\n" +
 	"\x03Sms\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12T\n" +
 	"\x10template_mapping\x18\x02 \x03(\v2).kratos.api.Data.Sms.TemplateMappingEntryR\x0ftemplateMapping\x12\x1d\n" +
 	"\n" +
 	"access_key\x18\x03 \x01(\tR\taccessKey\x12#\n" +
 	"\raccess_secret\x18\x04 \x01(\tR\faccessSecret\x12\x1b\n" +
-	"\tsign_name\x18\x05 \x01(\tR\bsignName\x1aB\n" +
+	"\tsign_name\x18\x05 \x01(\tR\bsignName\x12;\n" +
+	"\tproviders\x18\x06 \x03(\v2\x1d.kratos.api.Data.Sms.ProviderR\tproviders\x12L\n" +
+	"\x0fcircuit_breaker\x18\a \x01(\v2#.kratos.api.Data.Sms.CircuitBreakerR\x0ecircuitBreaker\x12%\n" +
+	"\x0ecallback_token\x18\b \x01(\tR\rcallbackToken\x1a\xc7\x04\n" +
+	"\bProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12]\n" +
+	"\x10template_mapping\x18\x02 \x03(\v22.kratos.api.Data.Sms.Provider.TemplateMappingEntryR\x0ftemplateMapping\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x03 \x01(\tR\taccessKey\x12#\n" +
+	"\raccess_secret\x18\x04 \x01(\tR\faccessSecret\x12\x1b\n" +
+	"\tsign_name\x18\x05 \x01(\tR\bsignName\x12\x1a\n" +
+	"\bendpoint\x18\x06 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06region\x18\a \x01(\tR\x06region\x12\x15\n" +
+	"\x06app_id\x18\b \x01(\tR\x05appId\x12j\n" +
+	"\x15intl_template_mapping\x18\t \x03(\v26.kratos.api.Data.Sms.Provider.IntlTemplateMappingEntryR\x13intlTemplateMapping\x12$\n" +
+	"\x0eintl_sign_name\x18\n" +
+	" \x01(\tR\fintlSignName\x1aB\n" +
+	"\x14TemplateMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aF\n" +
+	"\x18IntlTemplateMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1au\n" +
+	"\x0eCircuitBreaker\x12+\n" +
+	"\x11failure_threshold\x18\x01 \x01(\x05R\x10failureThreshold\x126\n" +
+	"\tcool_down\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bcoolDown\x1aB\n" +
 	"\x14TemplateMappingEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xcc\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xfa\t\n" +
 	"\x05Email\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12/\n" +
 	"\x04smtp\x18\x02 \x01(\v2\x1b.kratos.api.Data.Email.SMTPR\x04smtp\x12S\n" +
-	"\x0fsubject_mapping\x18\x03 \x03(\v2*.kratos.api.Data.Email.SubjectMappingEntryR\x0esubjectMapping\x1af\n" +
+	"\x0fsubject_mapping\x18\x03 \x03(\v2*.kratos.api.Data.Email.SubjectMappingEntryR\x0esubjectMapping\x12%\n" +
+	"\x0edefault_locale\x18\x04 \x01(\tR\rdefaultLocale\x12=\n" +
+	"\alocales\x18\x05 \x03(\v2#.kratos.api.Data.Email.LocalesEntryR\alocales\x12\x1a\n" +
+	"\bprovider\x18\x06 \x01(\tR\bprovider\x125\n" +
+	"\x06aliyun\x18\a \x01(\v2\x1d.kratos.api.Data.Email.AliyunR\x06aliyun\x128\n" +
+	"\awebhook\x18\b \x01(\v2\x1e.kratos.api.Data.Email.WebhookR\awebhook\x1af\n" +
 	"\x04SMTP\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
 	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\x1aA\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x1a\xa7\x01\n" +
+	"\x06Locale\x12Z\n" +
+	"\x0fsubject_mapping\x18\x01 \x03(\v21.kratos.api.Data.Email.Locale.SubjectMappingEntryR\x0esubjectMapping\x1aA\n" +
+	"\x13SubjectMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xc5\x01\n" +
+	"\x06Aliyun\x12\x1d\n" +
+	"\n" +
+	"access_key\x18\x01 \x01(\tR\taccessKey\x12#\n" +
+	"\raccess_secret\x18\x02 \x01(\tR\faccessSecret\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12!\n" +
+	"\faccount_name\x18\x04 \x01(\tR\vaccountName\x12\x1d\n" +
+	"\n" +
+	"from_alias\x18\x05 \x01(\tR\tfromAlias\x12\x19\n" +
+	"\btag_name\x18\x06 \x01(\tR\atagName\x1a\xeb\x01\n" +
+	"\aWebhook\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12E\n" +
+	"\aheaders\x18\x02 \x03(\v2+.kratos.api.Data.Email.Webhook.HeadersEntryR\aheaders\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x123\n" +
+	"\atimeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
 	"\x13SubjectMappingEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xf2\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aY\n" +
+	"\fLocalesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.kratos.api.Data.Email.LocaleR\x05value:\x028\x01\x1a\xf2\x01\n" +
 	"\x03Oss\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\"\n" +
 	"\raccess_key_id\x18\x02 \x01(\tR\vaccessKeyId\x12*\n" +
@@ -1346,18 +3212,27 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x06region\x18\x05 \x01(\tR\x06region\x12\x16\n" +
 	"\x06domain\x18\x06 \x01(\tR\x06domain\x12\x1b\n" +
 	"\tuse_https\x18\a \x01(\bR\buseHttps\x12\x1a\n" +
-	"\bprovider\x18\b \x01(\tR\bprovider\"\xd9\n" +
-	"\n" +
+	"\bprovider\x18\b \x01(\tR\bprovider\"\x9b#\n" +
 	"\x03App\x12(\n" +
 	"\x04auth\x18\x01 \x01(\v2\x14.kratos.api.App.AuthR\x04auth\x12\x10\n" +
 	"\x03env\x18\x02 \x01(\tR\x03env\x12\x1b\n" +
 	"\tworker_id\x18\x03 \x01(\x03R\bworkerId\x12%\n" +
 	"\x03otp\x18\x04 \x01(\v2\x13.kratos.api.App.OtpR\x03otp\x12.\n" +
-	"\x06upload\x18\x05 \x01(\v2\x16.kratos.api.App.UploadR\x06upload\x1a\x8e\x02\n" +
+	"\x06upload\x18\x05 \x01(\v2\x16.kratos.api.App.UploadR\x06upload\x12+\n" +
+	"\x05phone\x18\x06 \x01(\v2\x15.kratos.api.App.PhoneR\x05phone\x121\n" +
+	"\acaptcha\x18\a \x01(\v2\x17.kratos.api.App.CaptchaR\acaptcha\x12(\n" +
+	"\x04risk\x18\b \x01(\v2\x14.kratos.api.App.RiskR\x04risk\x124\n" +
+	"\boutbound\x18\t \x01(\v2\x18.kratos.api.App.OutboundR\boutbound\x12@\n" +
+	"\fnotification\x18\n" +
+	" \x01(\v2\x1c.kratos.api.App.NotificationR\fnotification\x124\n" +
+	"\bpresence\x18\v \x01(\v2\x18.kratos.api.App.PresenceR\bpresence\x12(\n" +
+	"\x04chat\x18\f \x01(\v2\x14.kratos.api.App.ChatR\x04chat\x127\n" +
+	"\tsensitive\x18\r \x01(\v2\x19.kratos.api.App.SensitiveR\tsensitive\x1a\xb4\x02\n" +
 	"\x04Auth\x12!\n" +
 	"\fpublic_paths\x18\x01 \x03(\tR\vpublicPaths\x129\n" +
 	"\bpassport\x18\x02 \x01(\v2\x1d.kratos.api.App.Auth.PassportR\bpassport\x12*\n" +
-	"\x03jwt\x18\x03 \x01(\v2\x18.kratos.api.App.Auth.JWTR\x03jwt\x1a/\n" +
+	"\x03jwt\x18\x03 \x01(\v2\x18.kratos.api.App.Auth.JWTR\x03jwt\x12$\n" +
+	"\x0eadmin_user_ids\x18\x04 \x03(\x03R\fadminUserIds\x1a/\n" +
 	"\bPassport\x12#\n" +
 	"\rauto_register\x18\x01 \x01(\bR\fautoRegister\x1aK\n" +
 	"\x03JWT\x12\x16\n" +
@@ -1379,7 +3254,92 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x19.kratos.api.App.Otp.SceneR\x05value:\x028\x01\x1aY\n" +
 	"\x10EmailScenesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.kratos.api.App.Otp.SceneR\x05value:\x028\x01\x1a\xf2\x02\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.kratos.api.App.Otp.SceneR\x05value:\x028\x01\x1a\xc9\x05\n" +
+	"\aCaptcha\x129\n" +
+	"\adefault\x18\x01 \x01(\v2\x1f.kratos.api.App.Captcha.ProfileR\adefault\x12;\n" +
+	"\x06scenes\x18\x02 \x03(\v2#.kratos.api.App.Captcha.ScenesEntryR\x06scenes\x1a\xe9\x03\n" +
+	"\aProfile\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x05R\x06height\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x05R\x06length\x12\x19\n" +
+	"\bmax_skew\x18\x05 \x01(\x01R\amaxSkew\x12\x1b\n" +
+	"\tdot_count\x18\x06 \x01(\x05R\bdotCount\x12\x1f\n" +
+	"\vnoise_count\x18\a \x01(\x05R\n" +
+	"noiseCount\x12!\n" +
+	"\fline_options\x18\b \x01(\x05R\vlineOptions\x12\x16\n" +
+	"\x06source\x18\t \x01(\tR\x06source\x12\x1a\n" +
+	"\blanguage\x18\n" +
+	" \x01(\tR\blanguage\x12+\n" +
+	"\x03ttl\x18\v \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12\x1d\n" +
+	"\n" +
+	"piece_size\x18\f \x01(\x05R\tpieceSize\x12\x1c\n" +
+	"\ttolerance\x18\r \x01(\x05R\ttolerance\x12<\n" +
+	"\fmin_duration\x18\x0e \x01(\v2\x19.google.protobuf.DurationR\vminDuration\x12(\n" +
+	"\x10min_track_points\x18\x0f \x01(\x05R\x0eminTrackPoints\x1aZ\n" +
+	"\vScenesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.kratos.api.App.Captcha.ProfileR\x05value:\x028\x01\x1a\xcc\x03\n" +
+	"\x04Risk\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12+\n" +
+	"\x11captcha_threshold\x18\x02 \x01(\x05R\x10captchaThreshold\x121\n" +
+	"\x06window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06window\x124\n" +
+	"\x16account_failure_weight\x18\x04 \x01(\x05R\x14accountFailureWeight\x12*\n" +
+	"\x11ip_failure_weight\x18\x05 \x01(\x05R\x0fipFailureWeight\x12%\n" +
+	"\x0evelocity_limit\x18\x06 \x01(\x05R\rvelocityLimit\x12'\n" +
+	"\x0fvelocity_weight\x18\a \x01(\x05R\x0evelocityWeight\x122\n" +
+	"\x15missing_device_weight\x18\b \x01(\x05R\x13missingDeviceWeight\x12*\n" +
+	"\x11new_device_weight\x18\t \x01(\x05R\x0fnewDeviceWeight\x128\n" +
+	"\n" +
+	"device_ttl\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\tdeviceTtl\x1a\xa0\x03\n" +
+	"\bOutbound\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12\x18\n" +
+	"\aworkers\x18\x03 \x01(\x05R\aworkers\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12!\n" +
+	"\fmax_attempts\x18\x05 \x01(\x05R\vmaxAttempts\x12<\n" +
+	"\fbase_backoff\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vbaseBackoff\x12:\n" +
+	"\vmax_backoff\x18\a \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x12H\n" +
+	"\x12visibility_timeout\x18\b \x01(\v2\x19.google.protobuf.DurationR\x11visibilityTimeout\x12B\n" +
+	"\x0fidempotency_ttl\x18\t \x01(\v2\x19.google.protobuf.DurationR\x0eidempotencyTtl\x1a\xb3\x02\n" +
+	"\fNotification\x12=\n" +
+	"\x05types\x18\x01 \x03(\v2'.kratos.api.App.Notification.TypesEntryR\x05types\x12)\n" +
+	"\x10default_timezone\x18\x02 \x01(\tR\x0fdefaultTimezone\x1a\\\n" +
+	"\x04Type\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12\x1c\n" +
+	"\tmandatory\x18\x03 \x01(\bR\tmandatory\x1a[\n" +
+	"\n" +
+	"TypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x127\n" +
+	"\x05value\x18\x02 \x01(\v2!.kratos.api.App.Notification.TypeR\x05value:\x028\x01\x1a\x83\x01\n" +
+	"\bPresence\x128\n" +
+	"\n" +
+	"online_ttl\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\tonlineTtl\x12=\n" +
+	"\rlast_seen_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vlastSeenTtl\x1a\xae\x01\n" +
+	"\x04Chat\x12*\n" +
+	"\x11group_max_members\x18\x01 \x01(\x05R\x0fgroupMaxMembers\x12>\n" +
+	"\rrecall_window\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\frecallWindow\x12:\n" +
+	"\vedit_window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"editWindow\x1a\xa0\x01\n" +
+	"\tSensitive\x12\x14\n" +
+	"\x05files\x18\x01 \x03(\tR\x05files\x12%\n" +
+	"\x0edefault_action\x18\x02 \x01(\tR\rdefaultAction\x12\x12\n" +
+	"\x04mask\x18\x03 \x01(\tR\x04mask\x12B\n" +
+	"\x0freload_interval\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x0ereloadInterval\x1a\xb0\x02\n" +
+	"\x05Phone\x12%\n" +
+	"\x0edefault_region\x18\x01 \x01(\tR\rdefaultRegion\x12<\n" +
+	"\aregions\x18\x02 \x03(\v2\".kratos.api.App.Phone.RegionsEntryR\aregions\x1ah\n" +
+	"\x06Region\x12!\n" +
+	"\fcalling_code\x18\x01 \x01(\tR\vcallingCode\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12!\n" +
+	"\ftrunk_prefix\x18\x03 \x01(\tR\vtrunkPrefix\x1aX\n" +
+	"\fRegionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.kratos.api.App.Phone.RegionR\x05value:\x028\x01\x1a\xf2\x02\n" +
 	"\x06Upload\x12I\n" +
 	"\x13private_url_expires\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x11privateUrlExpires\x12:\n" +
 	"\x06scenes\x18\x02 \x03(\v2\".kratos.api.App.Upload.ScenesEntryR\x06scenes\x1a\x87\x01\n" +
@@ -1406,33 +3366,63 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*App)(nil),                 // 3: kratos.api.App
-	(*Server_HTTP)(nil),         // 4: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 5: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 6: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 7: kratos.api.Data.Redis
-	(*Data_Sms)(nil),            // 8: kratos.api.Data.Sms
-	(*Data_Email)(nil),          // 9: kratos.api.Data.Email
-	(*Data_Oss)(nil),            // 10: kratos.api.Data.Oss
-	nil,                         // 11: kratos.api.Data.Sms.TemplateMappingEntry
-	(*Data_Email_SMTP)(nil),     // 12: kratos.api.Data.Email.SMTP
-	nil,                         // 13: kratos.api.Data.Email.SubjectMappingEntry
-	(*App_Auth)(nil),            // 14: kratos.api.App.Auth
-	(*App_Otp)(nil),             // 15: kratos.api.App.Otp
-	(*App_Upload)(nil),          // 16: kratos.api.App.Upload
-	(*App_Auth_Passport)(nil),   // 17: kratos.api.App.Auth.Passport
-	(*App_Auth_JWT)(nil),        // 18: kratos.api.App.Auth.JWT
-	(*App_Otp_Scene)(nil),       // 19: kratos.api.App.Otp.Scene
-	nil,                         // 20: kratos.api.App.Otp.PhoneScenesEntry
-	nil,                         // 21: kratos.api.App.Otp.EmailScenesEntry
-	(*App_Upload_Scene)(nil),    // 22: kratos.api.App.Upload.Scene
-	nil,                         // 23: kratos.api.App.Upload.ScenesEntry
-	(*durationpb.Duration)(nil), // 24: google.protobuf.Duration
+	(*Bootstrap)(nil),                    // 0: kratos.api.Bootstrap
+	(*Server)(nil),                       // 1: kratos.api.Server
+	(*Data)(nil),                         // 2: kratos.api.Data
+	(*App)(nil),                          // 3: kratos.api.App
+	(*Server_HTTP)(nil),                  // 4: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),                  // 5: kratos.api.Server.GRPC
+	(*Server_Websocket)(nil),             // 6: kratos.api.Server.Websocket
+	(*Server_Websocket_Cluster)(nil),     // 7: kratos.api.Server.Websocket.Cluster
+	(*Server_Websocket_Delivery)(nil),    // 8: kratos.api.Server.Websocket.Delivery
+	(*Server_Websocket_RateLimit)(nil),   // 9: kratos.api.Server.Websocket.RateLimit
+	nil,                                  // 10: kratos.api.Server.Websocket.RateLimitsEntry
+	(*Server_Websocket_Compression)(nil), // 11: kratos.api.Server.Websocket.Compression
+	(*Data_Database)(nil),                // 12: kratos.api.Data.Database
+	(*Data_Redis)(nil),                   // 13: kratos.api.Data.Redis
+	(*Data_Sms)(nil),                     // 14: kratos.api.Data.Sms
+	(*Data_Email)(nil),                   // 15: kratos.api.Data.Email
+	(*Data_Oss)(nil),                     // 16: kratos.api.Data.Oss
+	(*Data_Sms_Provider)(nil),            // 17: kratos.api.Data.Sms.Provider
+	(*Data_Sms_CircuitBreaker)(nil),      // 18: kratos.api.Data.Sms.CircuitBreaker
+	nil,                                  // 19: kratos.api.Data.Sms.TemplateMappingEntry
+	nil,                                  // 20: kratos.api.Data.Sms.Provider.TemplateMappingEntry
+	nil,                                  // 21: kratos.api.Data.Sms.Provider.IntlTemplateMappingEntry
+	(*Data_Email_SMTP)(nil),              // 22: kratos.api.Data.Email.SMTP
+	(*Data_Email_Locale)(nil),            // 23: kratos.api.Data.Email.Locale
+	(*Data_Email_Aliyun)(nil),            // 24: kratos.api.Data.Email.Aliyun
+	(*Data_Email_Webhook)(nil),           // 25: kratos.api.Data.Email.Webhook
+	nil,                                  // 26: kratos.api.Data.Email.SubjectMappingEntry
+	nil,                                  // 27: kratos.api.Data.Email.LocalesEntry
+	nil,                                  // 28: kratos.api.Data.Email.Locale.SubjectMappingEntry
+	nil,                                  // 29: kratos.api.Data.Email.Webhook.HeadersEntry
+	(*App_Auth)(nil),                     // 30: kratos.api.App.Auth
+	(*App_Otp)(nil),                      // 31: kratos.api.App.Otp
+	(*App_Captcha)(nil),                  // 32: kratos.api.App.Captcha
+	(*App_Risk)(nil),                     // 33: kratos.api.App.Risk
+	(*App_Outbound)(nil),                 // 34: kratos.api.App.Outbound
+	(*App_Notification)(nil),             // 35: kratos.api.App.Notification
+	(*App_Presence)(nil),                 // 36: kratos.api.App.Presence
+	(*App_Chat)(nil),                     // 37: kratos.api.App.Chat
+	(*App_Sensitive)(nil),                // 38: kratos.api.App.Sensitive
+	(*App_Phone)(nil),                    // 39: kratos.api.App.Phone
+	(*App_Upload)(nil),                   // 40: kratos.api.App.Upload
+	(*App_Auth_Passport)(nil),            // 41: kratos.api.App.Auth.Passport
+	(*App_Auth_JWT)(nil),                 // 42: kratos.api.App.Auth.JWT
+	(*App_Otp_Scene)(nil),                // 43: kratos.api.App.Otp.Scene
+	nil,                                  // 44: kratos.api.App.Otp.PhoneScenesEntry
+	nil,                                  // 45: kratos.api.App.Otp.EmailScenesEntry
+	(*App_Captcha_Profile)(nil),          // 46: kratos.api.App.Captcha.Profile
+	nil,                                  // 47: kratos.api.App.Captcha.ScenesEntry
+	(*App_Notification_Type)(nil),        // 48: kratos.api.App.Notification.Type
+	nil,                                  // 49: kratos.api.App.Notification.TypesEntry
+	(*App_Phone_Region)(nil),             // 50: kratos.api.App.Phone.Region
+	nil,                                  // 51: kratos.api.App.Phone.RegionsEntry
+	(*App_Upload_Scene)(nil),             // 52: kratos.api.App.Upload.Scene
+	nil,                                  // 53: kratos.api.App.Upload.ScenesEntry
+	(*durationpb.Duration)(nil),          // 54: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -1440,38 +3430,92 @@ var file_conf_conf_proto_depIdxs = []int32{
 	3,  // 2: kratos.api.Bootstrap.app:type_name -> kratos.api.App
 	4,  // 3: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
 	5,  // 4: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	6,  // 5: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	7,  // 6: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	8,  // 7: kratos.api.Data.sms:type_name -> kratos.api.Data.Sms
-	9,  // 8: kratos.api.Data.email:type_name -> kratos.api.Data.Email
-	10, // 9: kratos.api.Data.oss:type_name -> kratos.api.Data.Oss
-	14, // 10: kratos.api.App.auth:type_name -> kratos.api.App.Auth
-	15, // 11: kratos.api.App.otp:type_name -> kratos.api.App.Otp
-	16, // 12: kratos.api.App.upload:type_name -> kratos.api.App.Upload
-	24, // 13: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	24, // 14: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	24, // 15: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
-	24, // 16: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	24, // 17: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	11, // 18: kratos.api.Data.Sms.template_mapping:type_name -> kratos.api.Data.Sms.TemplateMappingEntry
-	12, // 19: kratos.api.Data.Email.smtp:type_name -> kratos.api.Data.Email.SMTP
-	13, // 20: kratos.api.Data.Email.subject_mapping:type_name -> kratos.api.Data.Email.SubjectMappingEntry
-	17, // 21: kratos.api.App.Auth.passport:type_name -> kratos.api.App.Auth.Passport
-	18, // 22: kratos.api.App.Auth.jwt:type_name -> kratos.api.App.Auth.JWT
-	20, // 23: kratos.api.App.Otp.phone_scenes:type_name -> kratos.api.App.Otp.PhoneScenesEntry
-	21, // 24: kratos.api.App.Otp.email_scenes:type_name -> kratos.api.App.Otp.EmailScenesEntry
-	24, // 25: kratos.api.App.Upload.private_url_expires:type_name -> google.protobuf.Duration
-	23, // 26: kratos.api.App.Upload.scenes:type_name -> kratos.api.App.Upload.ScenesEntry
-	24, // 27: kratos.api.App.Otp.Scene.expires_in:type_name -> google.protobuf.Duration
-	24, // 28: kratos.api.App.Otp.Scene.resend_interval:type_name -> google.protobuf.Duration
-	19, // 29: kratos.api.App.Otp.PhoneScenesEntry.value:type_name -> kratos.api.App.Otp.Scene
-	19, // 30: kratos.api.App.Otp.EmailScenesEntry.value:type_name -> kratos.api.App.Otp.Scene
-	22, // 31: kratos.api.App.Upload.ScenesEntry.value:type_name -> kratos.api.App.Upload.Scene
-	32, // [32:32] is the sub-list for method output_type
-	32, // [32:32] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	6,  // 5: kratos.api.Server.websocket:type_name -> kratos.api.Server.Websocket
+	12, // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	13, // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	14, // 8: kratos.api.Data.sms:type_name -> kratos.api.Data.Sms
+	15, // 9: kratos.api.Data.email:type_name -> kratos.api.Data.Email
+	16, // 10: kratos.api.Data.oss:type_name -> kratos.api.Data.Oss
+	30, // 11: kratos.api.App.auth:type_name -> kratos.api.App.Auth
+	31, // 12: kratos.api.App.otp:type_name -> kratos.api.App.Otp
+	40, // 13: kratos.api.App.upload:type_name -> kratos.api.App.Upload
+	39, // 14: kratos.api.App.phone:type_name -> kratos.api.App.Phone
+	32, // 15: kratos.api.App.captcha:type_name -> kratos.api.App.Captcha
+	33, // 16: kratos.api.App.risk:type_name -> kratos.api.App.Risk
+	34, // 17: kratos.api.App.outbound:type_name -> kratos.api.App.Outbound
+	35, // 18: kratos.api.App.notification:type_name -> kratos.api.App.Notification
+	36, // 19: kratos.api.App.presence:type_name -> kratos.api.App.Presence
+	37, // 20: kratos.api.App.chat:type_name -> kratos.api.App.Chat
+	38, // 21: kratos.api.App.sensitive:type_name -> kratos.api.App.Sensitive
+	54, // 22: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	54, // 23: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	7,  // 24: kratos.api.Server.Websocket.cluster:type_name -> kratos.api.Server.Websocket.Cluster
+	8,  // 25: kratos.api.Server.Websocket.delivery:type_name -> kratos.api.Server.Websocket.Delivery
+	10, // 26: kratos.api.Server.Websocket.rate_limits:type_name -> kratos.api.Server.Websocket.RateLimitsEntry
+	54, // 27: kratos.api.Server.Websocket.write_wait:type_name -> google.protobuf.Duration
+	54, // 28: kratos.api.Server.Websocket.pong_wait:type_name -> google.protobuf.Duration
+	54, // 29: kratos.api.Server.Websocket.ping_period:type_name -> google.protobuf.Duration
+	11, // 30: kratos.api.Server.Websocket.compression:type_name -> kratos.api.Server.Websocket.Compression
+	54, // 31: kratos.api.Server.Websocket.ticket_ttl:type_name -> google.protobuf.Duration
+	54, // 32: kratos.api.Server.Websocket.Cluster.presence_ttl:type_name -> google.protobuf.Duration
+	54, // 33: kratos.api.Server.Websocket.Delivery.retry_interval:type_name -> google.protobuf.Duration
+	54, // 34: kratos.api.Server.Websocket.Delivery.mailbox_ttl:type_name -> google.protobuf.Duration
+	54, // 35: kratos.api.Server.Websocket.RateLimit.window:type_name -> google.protobuf.Duration
+	9,  // 36: kratos.api.Server.Websocket.RateLimitsEntry.value:type_name -> kratos.api.Server.Websocket.RateLimit
+	54, // 37: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	54, // 38: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	54, // 39: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	19, // 40: kratos.api.Data.Sms.template_mapping:type_name -> kratos.api.Data.Sms.TemplateMappingEntry
+	17, // 41: kratos.api.Data.Sms.providers:type_name -> kratos.api.Data.Sms.Provider
+	18, // 42: kratos.api.Data.Sms.circuit_breaker:type_name -> kratos.api.Data.Sms.CircuitBreaker
+	22, // 43: kratos.api.Data.Email.smtp:type_name -> kratos.api.Data.Email.SMTP
+	26, // 44: kratos.api.Data.Email.subject_mapping:type_name -> kratos.api.Data.Email.SubjectMappingEntry
+	27, // 45: kratos.api.Data.Email.locales:type_name -> kratos.api.Data.Email.LocalesEntry
+	24, // 46: kratos.api.Data.Email.aliyun:type_name -> kratos.api.Data.Email.Aliyun
+	25, // 47: kratos.api.Data.Email.webhook:type_name -> kratos.api.Data.Email.Webhook
+	20, // 48: kratos.api.Data.Sms.Provider.template_mapping:type_name -> kratos.api.Data.Sms.Provider.TemplateMappingEntry
+	21, // 49: kratos.api.Data.Sms.Provider.intl_template_mapping:type_name -> kratos.api.Data.Sms.Provider.IntlTemplateMappingEntry
+	54, // 50: kratos.api.Data.Sms.CircuitBreaker.cool_down:type_name -> google.protobuf.Duration
+	28, // 51: kratos.api.Data.Email.Locale.subject_mapping:type_name -> kratos.api.Data.Email.Locale.SubjectMappingEntry
+	29, // 52: kratos.api.Data.Email.Webhook.headers:type_name -> kratos.api.Data.Email.Webhook.HeadersEntry
+	54, // 53: kratos.api.Data.Email.Webhook.timeout:type_name -> google.protobuf.Duration
+	23, // 54: kratos.api.Data.Email.LocalesEntry.value:type_name -> kratos.api.Data.Email.Locale
+	41, // 55: kratos.api.App.Auth.passport:type_name -> kratos.api.App.Auth.Passport
+	42, // 56: kratos.api.App.Auth.jwt:type_name -> kratos.api.App.Auth.JWT
+	44, // 57: kratos.api.App.Otp.phone_scenes:type_name -> kratos.api.App.Otp.PhoneScenesEntry
+	45, // 58: kratos.api.App.Otp.email_scenes:type_name -> kratos.api.App.Otp.EmailScenesEntry
+	46, // 59: kratos.api.App.Captcha.default:type_name -> kratos.api.App.Captcha.Profile
+	47, // 60: kratos.api.App.Captcha.scenes:type_name -> kratos.api.App.Captcha.ScenesEntry
+	54, // 61: kratos.api.App.Risk.window:type_name -> google.protobuf.Duration
+	54, // 62: kratos.api.App.Risk.device_ttl:type_name -> google.protobuf.Duration
+	54, // 63: kratos.api.App.Outbound.base_backoff:type_name -> google.protobuf.Duration
+	54, // 64: kratos.api.App.Outbound.max_backoff:type_name -> google.protobuf.Duration
+	54, // 65: kratos.api.App.Outbound.visibility_timeout:type_name -> google.protobuf.Duration
+	54, // 66: kratos.api.App.Outbound.idempotency_ttl:type_name -> google.protobuf.Duration
+	49, // 67: kratos.api.App.Notification.types:type_name -> kratos.api.App.Notification.TypesEntry
+	54, // 68: kratos.api.App.Presence.online_ttl:type_name -> google.protobuf.Duration
+	54, // 69: kratos.api.App.Presence.last_seen_ttl:type_name -> google.protobuf.Duration
+	54, // 70: kratos.api.App.Chat.recall_window:type_name -> google.protobuf.Duration
+	54, // 71: kratos.api.App.Chat.edit_window:type_name -> google.protobuf.Duration
+	54, // 72: kratos.api.App.Sensitive.reload_interval:type_name -> google.protobuf.Duration
+	51, // 73: kratos.api.App.Phone.regions:type_name -> kratos.api.App.Phone.RegionsEntry
+	54, // 74: kratos.api.App.Upload.private_url_expires:type_name -> google.protobuf.Duration
+	53, // 75: kratos.api.App.Upload.scenes:type_name -> kratos.api.App.Upload.ScenesEntry
+	54, // 76: kratos.api.App.Otp.Scene.expires_in:type_name -> google.protobuf.Duration
+	54, // 77: kratos.api.App.Otp.Scene.resend_interval:type_name -> google.protobuf.Duration
+	43, // 78: kratos.api.App.Otp.PhoneScenesEntry.value:type_name -> kratos.api.App.Otp.Scene
+	43, // 79: kratos.api.App.Otp.EmailScenesEntry.value:type_name -> kratos.api.App.Otp.Scene
+	54, // 80: kratos.api.App.Captcha.Profile.ttl:type_name -> google.protobuf.Duration
+	54, // 81: kratos.api.App.Captcha.Profile.min_duration:type_name -> google.protobuf.Duration
+	46, // 82: kratos.api.App.Captcha.ScenesEntry.value:type_name -> kratos.api.App.Captcha.Profile
+	48, // 83: kratos.api.App.Notification.TypesEntry.value:type_name -> kratos.api.App.Notification.Type
+	50, // 84: kratos.api.App.Phone.RegionsEntry.value:type_name -> kratos.api.App.Phone.Region
+	52, // 85: kratos.api.App.Upload.ScenesEntry.value:type_name -> kratos.api.App.Upload.Scene
+	86, // [86:86] is the sub-list for method output_type
+	86, // [86:86] is the sub-list for method input_type
+	86, // [86:86] is the sub-list for extension type_name
+	86, // [86:86] is the sub-list for extension extendee
+	0,  // [0:86] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -1485,7 +3529,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
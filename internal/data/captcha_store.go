@@ -2,40 +2,44 @@ package data
 
 import (
 	"context"
-	"strings"
+	"errors"
 	"time"
 
-	"github.com/mojocn/base64Captcha"
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
 )
 
-// RedisCaptchaStore 实现 base64Captcha.Store 接口
+const captchaKeyPrefix = "captcha:"
+
+var _ biz.CaptchaStore = (*RedisCaptchaStore)(nil)
+
+// RedisCaptchaStore 验证码答案存储，有效期由各场景配置决定
 type RedisCaptchaStore struct {
 	data *Data
 }
 
-func NewRedisCaptchaStore(data *Data) base64Captcha.Store {
+func NewRedisCaptchaStore(data *Data) biz.CaptchaStore {
 	return &RedisCaptchaStore{data: data}
 }
 
-func (s *RedisCaptchaStore) Set(id string, value string) error {
-	// 验证码通常有效期较短，设为 5-10 分钟
-	return s.data.RDB().Set(context.Background(), "captcha:"+id, value, 10*time.Minute).Err()
+func (s *RedisCaptchaStore) Set(ctx context.Context, id string, value string, ttl time.Duration) error {
+	return s.data.RDB().Set(ctx, captchaKeyPrefix+id, value, ttl).Err()
 }
 
-func (s *RedisCaptchaStore) Get(id string, clear bool) string {
-	ctx := context.Background()
-	key := "captcha:" + id
-	val, err := s.data.RDB().Get(ctx, key).Result()
-	if err != nil {
-		return ""
-	}
+func (s *RedisCaptchaStore) Get(ctx context.Context, id string, clear bool) (string, error) {
+	key := captchaKeyPrefix + id
+	var (
+		val string
+		err error
+	)
 	if clear {
-		s.data.RDB().Del(ctx, key)
+		// GETDEL 保证同一个验证码只能被校验一次
+		val, err = s.data.RDB().GetDel(ctx, key).Result()
+	} else {
+		val, err = s.data.RDB().Get(ctx, key).Result()
 	}
-	return val
-}
-
-func (s *RedisCaptchaStore) Verify(id, answer string, clear bool) bool {
-	vv := s.Get(id, clear)
-	return strings.ToLower(vv) == strings.ToLower(answer)
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return val, err
 }
@@ -2,12 +2,20 @@ package data
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/data/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+var _ biz.ChatRepo = (*chatRepo)(nil)
+
 // chatRepo 实现了 biz.ChatRepo 接口
 type chatRepo struct {
 	data *Data
@@ -22,29 +30,369 @@ func NewChatRepo(data *Data, logger log.Logger) biz.ChatRepo {
 	}
 }
 
-// SaveMessage 模拟保存消息到数据库
+// SaveMessage 保存消息并在同一事务中更新双方的会话
 func (r *chatRepo) SaveMessage(ctx context.Context, msg *biz.Message) error {
-	// 模拟数据库写入延迟
-	r.log.WithContext(ctx).Infof("存储消息到数据库: [ID:%s] From:%s To:%s Content:%s",
-		msg.ID, msg.FromUID, msg.ToUID, msg.Content)
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		m := &model.Message{
+			ConversationID: msg.ConversationID,
+			SenderID:       msg.SenderID,
+			ReceiverID:     msg.ReceiverID,
+			Type:           string(msg.Type),
+			Content:        msg.Content,
+		}
+		m.ID = msg.ID
+		m.CreatedAt = msg.CreatedAt
+		if err := r.data.Q(ctx).Message.WithContext(ctx).Create(m); err != nil {
+			return err
+		}
 
-	// 在真实的生产环境中，这里会使用 gorm 或 ent：
-	// return r.data.db.WithContext(ctx).Create(msg).Error
+		at := msg.CreatedAt
+		// 发送方：自己发的消息视为已读
+		if err := r.upsertConversation(ctx, &model.Conversation{
+			UserID:            msg.SenderID,
+			ConversationID:    msg.ConversationID,
+			PeerID:            msg.ReceiverID,
+			LastMessageID:     msg.ID,
+			LastMessageAt:     &at,
+			LastReadMessageID: msg.ID,
+		}, map[string]interface{}{
+			"last_read_message_id": msg.ID,
+		}); err != nil {
+			return err
+		}
+		// 接收方：未读数加一
+		return r.upsertConversation(ctx, &model.Conversation{
+			UserID:         msg.ReceiverID,
+			ConversationID: msg.ConversationID,
+			PeerID:         msg.SenderID,
+			LastMessageID:  msg.ID,
+			LastMessageAt:  &at,
+			UnreadCount:    1,
+		}, map[string]interface{}{
+			"unread_count": gorm.Expr("conversations.unread_count + 1"),
+		})
+	})
+}
 
-	return nil
+// SaveGroupMessage 保存群消息并在同一事务中更新所有成员的会话，群聊未读数按已读位置计算，不在此累加
+func (r *chatRepo) SaveGroupMessage(ctx context.Context, msg *biz.Message) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		m := &model.Message{
+			ConversationID: msg.ConversationID,
+			SenderID:       msg.SenderID,
+			Type:           string(msg.Type),
+			Content:        msg.Content,
+		}
+		m.ID = msg.ID
+		m.CreatedAt = msg.CreatedAt
+		if err := r.data.Q(ctx).Message.WithContext(ctx).Create(m); err != nil {
+			return err
+		}
+		// 发送方：自己发的消息视为已读
+		return r.data.DB(ctx).Model(&model.Conversation{}).
+			Where("conversation_id = ?", msg.ConversationID).
+			Updates(map[string]interface{}{
+				"last_message_id": msg.ID,
+				"last_message_at": msg.CreatedAt,
+				"last_read_message_id": gorm.Expr(
+					"CASE WHEN user_id = ? THEN ? ELSE last_read_message_id END", msg.SenderID, msg.ID),
+			}).Error
+	})
+}
+
+// upsertConversation 会话不存在时创建，存在时更新最后一条消息及 updates 中的字段
+func (r *chatRepo) upsertConversation(ctx context.Context, c *model.Conversation, updates map[string]interface{}) error {
+	updates["last_message_id"] = c.LastMessageID
+	updates["last_message_at"] = c.LastMessageAt
+	updates["updated_at"] = gorm.Expr("CURRENT_TIMESTAMP")
+	return r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "conversation_id"}},
+		DoUpdates: clause.Assignments(updates),
+	}).Create(c).Error
+}
+
+func (r *chatRepo) GetConversation(ctx context.Context, userID int64, conversationID string) (*biz.Conversation, error) {
+	q := r.data.Q(ctx).Conversation
+	m, err := q.WithContext(ctx).Where(q.UserID.Eq(userID), q.ConversationID.Eq(conversationID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrConversationNotFound
+		}
+		return nil, err
+	}
+	return r.toBizConversation(m), nil
 }
 
-// UpdateUserStatus 模拟更新用户在线状态
-func (r *chatRepo) UpdateUserStatus(ctx context.Context, uid string, online bool) error {
-	status := "离线"
-	if online {
-		status = "上线"
+func (r *chatRepo) ListConversations(ctx context.Context, userID int64, page, pageSize int) ([]*biz.Conversation, int64, error) {
+	db := r.data.DB(ctx).Model(&model.Conversation{}).Where("user_id = ?", userID).Session(&gorm.Session{})
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*model.Conversation
+	if err := db.Order("last_message_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// 批量查询最后一条消息
+	ids := make([]int64, 0, len(list))
+	for _, m := range list {
+		if m.LastMessageID > 0 {
+			ids = append(ids, m.LastMessageID)
+		}
+	}
+	messages := make(map[int64]*biz.Message, len(ids))
+	if len(ids) > 0 {
+		var rows []*model.Message
+		if err := r.data.DB(ctx).Where("id IN ?", ids).Find(&rows).Error; err != nil {
+			return nil, 0, err
+		}
+		for _, m := range rows {
+			messages[m.ID] = r.toBizMessage(m)
+		}
+	}
+
+	unread, err := r.countGroupUnread(ctx, userID, list)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	r.log.WithContext(ctx).Infof("更新用户状态: 用户ID:%s 状态:%s", uid, status)
+	conversations := make([]*biz.Conversation, 0, len(list))
+	for _, m := range list {
+		c := r.toBizConversation(m)
+		c.LastMessage = messages[m.LastMessageID]
+		if c.GroupID > 0 {
+			c.UnreadCount = unread[m.ConversationID]
+		}
+		conversations = append(conversations, c)
+	}
+	return conversations, total, nil
+}
 
-	// 在真实的生产环境中，这里通常操作 Redis：
-	// return r.data.redis.Set(ctx, "user_online:"+uid, online, 0).Err()
+// countGroupUnread 批量统计群聊会话已读位置之后他人发送的消息数
+func (r *chatRepo) countGroupUnread(ctx context.Context, userID int64, list []*model.Conversation) (map[string]int32, error) {
+	var (
+		conds []string
+		args  []interface{}
+	)
+	for _, m := range list {
+		if _, ok := biz.ParseGroupConversationID(m.ConversationID); ok && m.LastMessageID > m.LastReadMessageID {
+			conds = append(conds, "(conversation_id = ? AND id > ?)")
+			args = append(args, m.ConversationID, m.LastReadMessageID)
+		}
+	}
+	unread := make(map[string]int32, len(conds))
+	if len(conds) == 0 {
+		return unread, nil
+	}
+	var rows []struct {
+		ConversationID string
+		Count          int32
+	}
+	if err := r.data.DB(ctx).Model(&model.Message{}).
+		Select("conversation_id, COUNT(*) AS count").
+		Where("sender_id <> ?", userID).
+		Where("("+strings.Join(conds, " OR ")+")", args...).
+		Group("conversation_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		unread[row.ConversationID] = row.Count
+	}
+	return unread, nil
+}
 
+func (r *chatRepo) ListMessages(ctx context.Context, q *biz.MessageQuery) ([]*biz.Message, error) {
+	db := r.data.DB(ctx).Where("conversation_id = ?", q.ConversationID)
+	if q.BeforeID > 0 {
+		db = db.Where("id < ?", q.BeforeID)
+	}
+	var rows []*model.Message
+	if err := db.Order("id DESC").Limit(q.Limit).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	list := make([]*biz.Message, 0, len(rows))
+	for _, m := range rows {
+		list = append(list, r.toBizMessage(m))
+	}
+	return list, nil
+}
+
+func (r *chatRepo) MarkConversationRead(ctx context.Context, userID int64, conversationID string) (int64, int64, error) {
+	var before, after int64
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		var c model.Conversation
+		if err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND conversation_id = ?", userID, conversationID).
+			First(&c).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return biz.ErrConversationNotFound
+			}
+			return err
+		}
+		before, after = c.LastReadMessageID, c.LastMessageID
+		if err := r.data.DB(ctx).Model(&c).Updates(map[string]interface{}{
+			"unread_count":         0,
+			"last_read_message_id": c.LastMessageID,
+		}).Error; err != nil {
+			return err
+		}
+		if _, ok := biz.ParseGroupConversationID(conversationID); ok || after <= before {
+			return nil
+		}
+		// 单聊：收到的消息逐条置为已读
+		return r.data.DB(ctx).Model(&model.Message{}).
+			Where("conversation_id = ? AND receiver_id = ? AND status = ? AND id <= ?",
+				conversationID, userID, biz.MessageStatusUnread, after).
+			Update("status", biz.MessageStatusRead).Error
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return before, after, nil
+}
+
+func (r *chatRepo) ListSenders(ctx context.Context, conversationID string, afterID, uptoID, exclude int64) ([]int64, error) {
+	var senders []int64
+	err := r.data.DB(ctx).Model(&model.Message{}).
+		Where("conversation_id = ? AND id > ? AND id <= ? AND sender_id <> ?", conversationID, afterID, uptoID, exclude).
+		Distinct().Pluck("sender_id", &senders).Error
+	return senders, err
+}
+
+func (r *chatRepo) CountReads(ctx context.Context, conversationID string, messageID, senderID int64) (int32, int32, error) {
+	var row struct {
+		Total int32
+		Read  int32
+	}
+	if err := r.data.DB(ctx).Model(&model.Conversation{}).
+		Select("COUNT(*) AS total, COUNT(*) FILTER (WHERE last_read_message_id >= ?) AS read", messageID).
+		Where("conversation_id = ? AND user_id <> ?", conversationID, senderID).
+		Scan(&row).Error; err != nil {
+		return 0, 0, err
+	}
+	return row.Read, row.Total, nil
+}
+
+func (r *chatRepo) GetMessage(ctx context.Context, id int64) (*biz.Message, error) {
+	var m model.Message
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrMessageNotFound
+		}
+		return nil, err
+	}
+	return r.toBizMessage(&m), nil
+}
+
+func (r *chatRepo) RecallMessage(ctx context.Context, id int64, at time.Time) error {
+	res := r.data.DB(ctx).Model(&model.Message{}).
+		Where("id = ? AND recalled_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"content":     "",
+			"recalled_at": at,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrMessageRecalled
+	}
 	return nil
 }
+
+func (r *chatRepo) EditMessage(ctx context.Context, id int64, content string, at time.Time) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		var m model.Message
+		if err := r.data.DB(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", id).First(&m).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return biz.ErrMessageNotFound
+			}
+			return err
+		}
+		if m.RecalledAt != nil {
+			return biz.ErrMessageRecalled
+		}
+		edit := &model.MessageEdit{MessageID: id, Content: m.Content}
+		edit.CreatedAt = at
+		if err := r.data.Q(ctx).MessageEdit.WithContext(ctx).Create(edit); err != nil {
+			return err
+		}
+		return r.data.DB(ctx).Model(&m).Updates(map[string]interface{}{
+			"content":   content,
+			"edited_at": at,
+		}).Error
+	})
+}
+
+func (r *chatRepo) ListMessageEdits(ctx context.Context, messageID int64) ([]*biz.MessageEdit, error) {
+	var rows []*model.MessageEdit
+	if err := r.data.DB(ctx).Where("message_id = ?", messageID).Order("id DESC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	list := make([]*biz.MessageEdit, 0, len(rows))
+	for _, m := range rows {
+		list = append(list, &biz.MessageEdit{
+			MessageID: m.MessageID,
+			Content:   m.Content,
+			EditedAt:  m.CreatedAt,
+		})
+	}
+	return list, nil
+}
+
+func (r *chatRepo) ListContacts(ctx context.Context, userID int64, candidates []int64) ([]int64, error) {
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	var peers []int64
+	if err := r.data.DB(ctx).Model(&model.Conversation{}).
+		Where("user_id = ? AND peer_id IN ?", userID, candidates).
+		Pluck("peer_id", &peers).Error; err != nil {
+		return nil, err
+	}
+	var members []int64
+	groups := r.data.DB(ctx).Model(&model.ChatGroupMember{}).Select("group_id").Where("user_id = ?", userID)
+	if err := r.data.DB(ctx).Model(&model.ChatGroupMember{}).
+		Where("group_id IN (?) AND user_id IN ?", groups, candidates).
+		Distinct().Pluck("user_id", &members).Error; err != nil {
+		return nil, err
+	}
+	return append(peers, members...), nil
+}
+
+func (r *chatRepo) toBizMessage(m *model.Message) *biz.Message {
+	groupID, _ := biz.ParseGroupConversationID(m.ConversationID)
+	return &biz.Message{
+		GroupID:        groupID,
+		ID:             m.ID,
+		ConversationID: m.ConversationID,
+		SenderID:       m.SenderID,
+		ReceiverID:     m.ReceiverID,
+		Type:           biz.MessageType(m.Type),
+		Content:        m.Content,
+		Status:         biz.MessageStatus(m.Status),
+		EditedAt:       m.EditedAt,
+		RecalledAt:     m.RecalledAt,
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+func (r *chatRepo) toBizConversation(m *model.Conversation) *biz.Conversation {
+	groupID, _ := biz.ParseGroupConversationID(m.ConversationID)
+	return &biz.Conversation{
+		GroupID:           groupID,
+		UserID:            m.UserID,
+		ConversationID:    m.ConversationID,
+		PeerID:            m.PeerID,
+		LastMessageAt:     m.LastMessageAt,
+		LastReadMessageID: m.LastReadMessageID,
+		UnreadCount:       m.UnreadCount,
+	}
+}
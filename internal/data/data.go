@@ -30,12 +30,27 @@ var ProviderSet = wire.NewSet(
 	NewRedisCaptchaStore,
 	// OTP 缓存
 	NewRedisOtpCache,
+	// 风险评估计数
+	NewRedisRiskCache,
+	// 出站消息队列
+	NewOutboundQueue,
+	// WebSocket 集群转发
+	NewWsBroker,
+	NewWsMailboxStore,
+	NewWsTicketStore,
 	// 数据库事务
 	wire.Bind(new(biz.Transaction), new(*Data)),
 	// 数据存储
 	NewUserRepo,
+	NewDeliveryRepo,
+	NewMessageTemplateRepo,
+	NewNotificationRepo,
+	NewPresenceRepo,
 	// Mock
 	NewChatRepo,
+	NewGroupRepo,
+	NewSensitiveRepo,
+	NewUploadRepo,
 )
 
 // Data .
@@ -83,6 +98,8 @@ func NewDB(c *conf.Data, l log.Logger) *gorm.DB {
 	db, err := gorm.Open(dialector, &gorm.Config{
 		// 使用自定义的 Kratos 日志适配器 (前面步骤中定义的 NewGormLogger)
 		Logger: NewGormLogger(l),
+		// 将驱动的唯一约束冲突等错误转换为 gorm.ErrDuplicatedKey 等通用错误
+		TranslateError: true,
 	})
 	if err != nil {
 		log.NewHelper(l).Fatalf("failed opening connection to database: %v", err)
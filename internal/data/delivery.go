@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/data/model"
+	"gorm.io/gorm"
+)
+
+var _ biz.DeliveryRepo = (*deliveryRepo)(nil)
+
+type deliveryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewDeliveryRepo(data *Data, logger log.Logger) biz.DeliveryRepo {
+	return &deliveryRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *deliveryRepo) CreateDelivery(ctx context.Context, d *biz.Delivery) error {
+	delivery := &model.MessageDelivery{
+		Channel:      string(d.Channel),
+		Receiver:     d.Receiver,
+		ReceiverHash: d.ReceiverHash,
+		Template:     d.Template,
+		Provider:     d.Provider,
+		Status:       string(d.Status),
+	}
+	if d.ProviderMessageID != "" {
+		delivery.ProviderMessageID = &d.ProviderMessageID
+	}
+	if d.ProviderRequestID != "" {
+		delivery.ProviderRequestID = &d.ProviderRequestID
+	}
+	if d.Error != "" {
+		errMsg := truncateError(d.Error)
+		delivery.Error = &errMsg
+	}
+
+	if err := r.data.Q(ctx).MessageDelivery.WithContext(ctx).Create(delivery); err != nil {
+		return err
+	}
+	d.ID = delivery.ID
+	d.CreatedAt = delivery.CreatedAt
+	d.UpdatedAt = delivery.UpdatedAt
+	return nil
+}
+
+func (r *deliveryRepo) UpdateDeliveryReport(ctx context.Context, report *biz.DeliveryReport) (int64, error) {
+	m := r.data.Q(ctx).MessageDelivery
+	values := map[string]interface{}{
+		"status":      string(report.Status),
+		"reported_at": report.ReportedAt,
+	}
+	if report.Error != "" {
+		values["error"] = truncateError(report.Error)
+	}
+	info, err := m.WithContext(ctx).
+		Where(m.Provider.Eq(report.Provider), m.ProviderMessageID.Eq(report.ProviderMessageID)).
+		Updates(values)
+	if err != nil {
+		return 0, err
+	}
+	return info.RowsAffected, nil
+}
+
+func (r *deliveryRepo) ListDeliveries(ctx context.Context, q *biz.DeliveryQuery) ([]*biz.Delivery, int64, error) {
+	db := r.data.DB(ctx).Model(&model.MessageDelivery{})
+	if q.Channel != "" {
+		db = db.Where("channel = ?", string(q.Channel))
+	}
+	if q.ReceiverHash != "" {
+		db = db.Where("receiver_hash = ?", q.ReceiverHash)
+	}
+	if !q.Start.IsZero() {
+		db = db.Where("created_at >= ?", q.Start)
+	}
+	if !q.End.IsZero() {
+		db = db.Where("created_at < ?", q.End)
+	}
+	// 新会话，使 Count 与 Find 共用过滤条件
+	db = db.Session(&gorm.Session{})
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*model.MessageDelivery
+	if err := db.Order("created_at DESC").
+		Offset((q.Page - 1) * q.PageSize).
+		Limit(q.PageSize).
+		Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+
+	deliveries := make([]*biz.Delivery, 0, len(list))
+	for _, d := range list {
+		deliveries = append(deliveries, r.toBiz(d))
+	}
+	return deliveries, total, nil
+}
+
+func (r *deliveryRepo) toBiz(d *model.MessageDelivery) *biz.Delivery {
+	providerMessageID, providerRequestID := "", ""
+	if d.ProviderMessageID != nil {
+		providerMessageID = *d.ProviderMessageID
+	}
+	if d.ProviderRequestID != nil {
+		providerRequestID = *d.ProviderRequestID
+	}
+	errMsg := ""
+	if d.Error != nil {
+		errMsg = *d.Error
+	}
+
+	return &biz.Delivery{
+		ID:                d.ID,
+		Channel:           biz.DeliveryChannel(d.Channel),
+		Receiver:          d.Receiver,
+		ReceiverHash:      d.ReceiverHash,
+		Template:          d.Template,
+		Provider:          d.Provider,
+		ProviderMessageID: providerMessageID,
+		ProviderRequestID: providerRequestID,
+		Status:            biz.DeliveryStatus(d.Status),
+		Error:             errMsg,
+		ReportedAt:        d.ReportedAt,
+		CreatedAt:         d.CreatedAt,
+		UpdatedAt:         d.UpdatedAt,
+	}
+}
@@ -0,0 +1,251 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/data/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ biz.GroupRepo = (*groupRepo)(nil)
+
+type groupRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewGroupRepo(data *Data, logger log.Logger) biz.GroupRepo {
+	return &groupRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/group")),
+	}
+}
+
+func (r *groupRepo) CreateGroup(ctx context.Context, g *biz.Group, members []*biz.GroupMember) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		m := &model.ChatGroup{
+			Name:        g.Name,
+			OwnerID:     g.OwnerID,
+			MemberCount: g.MemberCount,
+			MaxMembers:  g.MaxMembers,
+		}
+		if err := r.data.Q(ctx).ChatGroup.WithContext(ctx).Create(m); err != nil {
+			return err
+		}
+		g.ID = m.ID
+		g.CreatedAt = m.CreatedAt
+		for _, member := range members {
+			member.GroupID = g.ID
+		}
+		return r.insertMembers(ctx, g.ID, members)
+	})
+}
+
+func (r *groupRepo) GetGroup(ctx context.Context, id int64) (*biz.Group, error) {
+	var m model.ChatGroup
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrGroupNotFound
+		}
+		return nil, err
+	}
+	return &biz.Group{
+		ID:          m.ID,
+		Name:        m.Name,
+		OwnerID:     m.OwnerID,
+		MemberCount: m.MemberCount,
+		MaxMembers:  m.MaxMembers,
+		CreatedAt:   m.CreatedAt,
+	}, nil
+}
+
+// DeleteGroup 群组软删除，成员与会话物理删除，以免占用唯一索引
+func (r *groupRepo) DeleteGroup(ctx context.Context, id int64) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		res := r.data.DB(ctx).Where("id = ?", id).Delete(&model.ChatGroup{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrGroupNotFound
+		}
+		if err := r.data.DB(ctx).Unscoped().Where("group_id = ?", id).Delete(&model.ChatGroupMember{}).Error; err != nil {
+			return err
+		}
+		return r.data.DB(ctx).Unscoped().
+			Where("conversation_id = ?", biz.GroupConversationID(id)).
+			Delete(&model.Conversation{}).Error
+	})
+}
+
+func (r *groupRepo) GetMember(ctx context.Context, groupID, userID int64) (*biz.GroupMember, error) {
+	q := r.data.Q(ctx).ChatGroupMember
+	m, err := q.WithContext(ctx).Where(q.GroupID.Eq(groupID), q.UserID.Eq(userID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrGroupMemberNotFound
+		}
+		return nil, err
+	}
+	return r.toBizMember(m), nil
+}
+
+// ListMembers 按入群时间排序
+func (r *groupRepo) ListMembers(ctx context.Context, groupID int64) ([]*biz.GroupMember, error) {
+	var list []*model.ChatGroupMember
+	if err := r.data.DB(ctx).Where("group_id = ?", groupID).Order("created_at, id").Find(&list).Error; err != nil {
+		return nil, err
+	}
+	members := make([]*biz.GroupMember, 0, len(list))
+	for _, m := range list {
+		members = append(members, r.toBizMember(m))
+	}
+	return members, nil
+}
+
+func (r *groupRepo) AddMembers(ctx context.Context, groupID int64, members []*biz.GroupMember) ([]int64, error) {
+	var added []int64
+	err := r.data.InTx(ctx, func(ctx context.Context) error {
+		ids := make([]int64, 0, len(members))
+		for _, m := range members {
+			ids = append(ids, m.UserID)
+		}
+		var existing []int64
+		if err := r.data.DB(ctx).Model(&model.ChatGroupMember{}).
+			Where("group_id = ? AND user_id IN ?", groupID, ids).
+			Pluck("user_id", &existing).Error; err != nil {
+			return err
+		}
+		exists := make(map[int64]struct{}, len(existing))
+		for _, id := range existing {
+			exists[id] = struct{}{}
+		}
+		pending := make([]*biz.GroupMember, 0, len(members))
+		for _, m := range members {
+			if _, ok := exists[m.UserID]; !ok {
+				pending = append(pending, m)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		// 条件更新成员数，并发邀请时也不会超出上限
+		res := r.data.DB(ctx).Model(&model.ChatGroup{}).
+			Where("id = ? AND member_count + ? <= max_members", groupID, len(pending)).
+			Update("member_count", gorm.Expr("member_count + ?", len(pending)))
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			if _, err := r.GetGroup(ctx, groupID); err != nil {
+				return err
+			}
+			return biz.ErrGroupFull
+		}
+		if err := r.insertMembers(ctx, groupID, pending); err != nil {
+			return err
+		}
+		for _, m := range pending {
+			added = append(added, m.UserID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+// insertMembers 写入成员并创建群聊会话，入群前的消息不计未读
+func (r *groupRepo) insertMembers(ctx context.Context, groupID int64, members []*biz.GroupMember) error {
+	conversationID := biz.GroupConversationID(groupID)
+	var last model.Conversation
+	if err := r.data.DB(ctx).Where("conversation_id = ?", conversationID).
+		Order("last_message_id DESC").Limit(1).Find(&last).Error; err != nil {
+		return err
+	}
+
+	rows := make([]*model.ChatGroupMember, 0, len(members))
+	conversations := make([]*model.Conversation, 0, len(members))
+	for _, m := range members {
+		rows = append(rows, &model.ChatGroupMember{
+			GroupID:    groupID,
+			UserID:     m.UserID,
+			Role:       string(m.Role),
+			MutedUntil: m.MutedUntil,
+		})
+		at := time.Now()
+		if last.LastMessageAt != nil {
+			at = *last.LastMessageAt
+		}
+		conversations = append(conversations, &model.Conversation{
+			UserID:            m.UserID,
+			ConversationID:    conversationID,
+			LastMessageID:     last.LastMessageID,
+			LastMessageAt:     &at,
+			LastReadMessageID: last.LastMessageID,
+		})
+	}
+	if err := r.data.DB(ctx).Create(&rows).Error; err != nil {
+		return err
+	}
+	return r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&conversations).Error
+}
+
+func (r *groupRepo) RemoveMember(ctx context.Context, groupID, userID int64) error {
+	return r.data.InTx(ctx, func(ctx context.Context) error {
+		res := r.data.DB(ctx).Unscoped().
+			Where("group_id = ? AND user_id = ?", groupID, userID).
+			Delete(&model.ChatGroupMember{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrGroupMemberNotFound
+		}
+		if err := r.data.DB(ctx).Model(&model.ChatGroup{}).Where("id = ?", groupID).
+			Update("member_count", gorm.Expr("member_count - 1")).Error; err != nil {
+			return err
+		}
+		return r.data.DB(ctx).Unscoped().
+			Where("user_id = ? AND conversation_id = ?", userID, biz.GroupConversationID(groupID)).
+			Delete(&model.Conversation{}).Error
+	})
+}
+
+func (r *groupRepo) UpdateMemberRole(ctx context.Context, groupID, userID int64, role biz.GroupRole) error {
+	return r.updateMember(ctx, groupID, userID, map[string]interface{}{"role": string(role)})
+}
+
+func (r *groupRepo) UpdateMemberMute(ctx context.Context, groupID, userID int64, until *time.Time) error {
+	return r.updateMember(ctx, groupID, userID, map[string]interface{}{"muted_until": until})
+}
+
+func (r *groupRepo) updateMember(ctx context.Context, groupID, userID int64, updates map[string]interface{}) error {
+	res := r.data.DB(ctx).Model(&model.ChatGroupMember{}).
+		Where("group_id = ? AND user_id = ?", groupID, userID).
+		Updates(updates)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrGroupMemberNotFound
+	}
+	return nil
+}
+
+func (r *groupRepo) toBizMember(m *model.ChatGroupMember) *biz.GroupMember {
+	return &biz.GroupMember{
+		GroupID:    m.GroupID,
+		UserID:     m.UserID,
+		Role:       biz.GroupRole(m.Role),
+		MutedUntil: m.MutedUntil,
+		JoinedAt:   m.CreatedAt,
+	}
+}
@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/data/model"
+	"gorm.io/gorm"
+)
+
+// 模板修订号，所有实例共享
+const messageTemplateRevisionKey = "msgtpl:revision"
+
+var _ biz.MessageTemplateRepo = (*messageTemplateRepo)(nil)
+
+type messageTemplateRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewMessageTemplateRepo(data *Data, logger log.Logger) biz.MessageTemplateRepo {
+	return &messageTemplateRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *messageTemplateRepo) CreateTemplate(ctx context.Context, t *biz.MessageTemplate) error {
+	m := &model.MessageTemplate{
+		Channel:            string(t.Channel),
+		Name:               t.Name,
+		Locale:             t.Locale,
+		Provider:           t.Provider,
+		Subject:            t.Subject,
+		Body:               t.Body,
+		TextBody:           t.TextBody,
+		VendorTemplateCode: t.VendorCode,
+		Version:            t.Version,
+	}
+	if err := r.data.Q(ctx).MessageTemplate.WithContext(ctx).Create(m); err != nil {
+		// 并发创建时由唯一索引保证同一渠道、名称、语言及供应商只有一个模板
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return biz.ErrMessageTemplateExists
+		}
+		return err
+	}
+	t.ID = m.ID
+	t.CreatedAt = m.CreatedAt
+	t.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+func (r *messageTemplateRepo) UpdateTemplate(ctx context.Context, t *biz.MessageTemplate) error {
+	res := r.data.DB(ctx).Model(&model.MessageTemplate{}).
+		Where("id = ? AND version = ?", t.ID, t.Version).
+		Updates(map[string]interface{}{
+			"subject":              t.Subject,
+			"body":                 t.Body,
+			"text_body":            t.TextBody,
+			"vendor_template_code": t.VendorCode,
+			"version":              gorm.Expr("version + 1"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrMessageTemplateConflict
+	}
+	return nil
+}
+
+func (r *messageTemplateRepo) DeleteTemplate(ctx context.Context, id int64) error {
+	return r.data.DB(ctx).Where("id = ?", id).Delete(&model.MessageTemplate{}).Error
+}
+
+func (r *messageTemplateRepo) GetTemplate(ctx context.Context, id int64) (*biz.MessageTemplate, error) {
+	var m model.MessageTemplate
+	if err := r.data.DB(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrMessageTemplateNotFound
+		}
+		return nil, err
+	}
+	return r.toBiz(&m), nil
+}
+
+func (r *messageTemplateRepo) ListTemplates(ctx context.Context, q *biz.MessageTemplateQuery) ([]*biz.MessageTemplate, int64, error) {
+	db := r.data.DB(ctx).Model(&model.MessageTemplate{})
+	if q.Channel != "" {
+		db = db.Where("channel = ?", string(q.Channel))
+	}
+	if q.Name != "" {
+		db = db.Where("name = ?", q.Name)
+	}
+	// 新会话，使 Count 与 Find 共用过滤条件
+	db = db.Session(&gorm.Session{})
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*model.MessageTemplate
+	if err := db.Order("channel, name, locale, provider").
+		Offset((q.Page - 1) * q.PageSize).
+		Limit(q.PageSize).
+		Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+
+	templates := make([]*biz.MessageTemplate, 0, len(list))
+	for _, m := range list {
+		templates = append(templates, r.toBiz(m))
+	}
+	return templates, total, nil
+}
+
+func (r *messageTemplateRepo) ListAllTemplates(ctx context.Context) ([]*biz.MessageTemplate, error) {
+	var list []*model.MessageTemplate
+	if err := r.data.DB(ctx).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	templates := make([]*biz.MessageTemplate, 0, len(list))
+	for _, m := range list {
+		templates = append(templates, r.toBiz(m))
+	}
+	return templates, nil
+}
+
+func (r *messageTemplateRepo) GetTemplateRevision(ctx context.Context) (int64, error) {
+	revision, err := r.data.RDB().Get(ctx, messageTemplateRevisionKey).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return revision, err
+}
+
+func (r *messageTemplateRepo) IncrTemplateRevision(ctx context.Context) (int64, error) {
+	return r.data.RDB().Incr(ctx, messageTemplateRevisionKey).Result()
+}
+
+func (r *messageTemplateRepo) toBiz(m *model.MessageTemplate) *biz.MessageTemplate {
+	return &biz.MessageTemplate{
+		ID:         m.ID,
+		Channel:    biz.DeliveryChannel(m.Channel),
+		Name:       m.Name,
+		Locale:     m.Locale,
+		Provider:   m.Provider,
+		Subject:    m.Subject,
+		Body:       m.Body,
+		TextBody:   m.TextBody,
+		VendorCode: m.VendorTemplateCode,
+		Version:    m.Version,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/data/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ biz.NotificationRepo = (*notificationRepo)(nil)
+
+type notificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &notificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *notificationRepo) CreateNotification(ctx context.Context, n *biz.Notification) error {
+	m := &model.Notification{
+		UserID:  n.UserID,
+		Type:    n.Type,
+		Title:   n.Title,
+		Content: n.Content,
+	}
+	if len(n.Params) > 0 {
+		data, err := json.Marshal(n.Params)
+		if err != nil {
+			return err
+		}
+		s := string(data)
+		m.Data = &s
+	}
+	if err := r.data.Q(ctx).Notification.WithContext(ctx).Create(m); err != nil {
+		return err
+	}
+	n.ID = m.ID
+	n.CreatedAt = m.CreatedAt
+	return nil
+}
+
+func (r *notificationRepo) ListNotifications(ctx context.Context, q *biz.NotificationQuery) ([]*biz.Notification, int64, error) {
+	db := r.data.DB(ctx).Model(&model.Notification{}).Where("user_id = ?", q.UserID)
+	if q.UnreadOnly {
+		db = db.Where("read_at IS NULL")
+	}
+	// 新会话，使 Count 与 Find 共用过滤条件
+	db = db.Session(&gorm.Session{})
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*model.Notification
+	if err := db.Order("created_at DESC, id DESC").
+		Offset((q.Page - 1) * q.PageSize).
+		Limit(q.PageSize).
+		Find(&list).Error; err != nil {
+		return nil, 0, err
+	}
+
+	notifications := make([]*biz.Notification, 0, len(list))
+	for _, m := range list {
+		notifications = append(notifications, r.toBiz(m))
+	}
+	return notifications, total, nil
+}
+
+func (r *notificationRepo) MarkRead(ctx context.Context, userID int64, ids []int64, at time.Time) (int64, error) {
+	db := r.data.DB(ctx).Model(&model.Notification{}).Where("user_id = ? AND read_at IS NULL", userID)
+	if len(ids) > 0 {
+		db = db.Where("id IN ?", ids)
+	}
+	res := db.Update("read_at", at)
+	return res.RowsAffected, res.Error
+}
+
+func (r *notificationRepo) CountUnread(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.data.DB(ctx).Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *notificationRepo) GetPreference(ctx context.Context, userID int64) (*biz.NotificationPreference, error) {
+	pref := &biz.NotificationPreference{UserID: userID, Channels: make(map[string][]biz.NotificationChannel)}
+
+	p := r.data.Q(ctx).NotificationPreference
+	m, err := p.WithContext(ctx).Where(p.UserID.Eq(userID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return pref, nil
+		}
+		return nil, err
+	}
+	if m.Channels != "" {
+		if err := json.Unmarshal([]byte(m.Channels), &pref.Channels); err != nil {
+			r.log.WithContext(ctx).Errorf("解析通知偏好失败: user_id=%d err=%v", userID, err)
+		}
+	}
+	pref.QuietHoursStart = m.QuietHoursStart
+	pref.QuietHoursEnd = m.QuietHoursEnd
+	pref.Timezone = m.Timezone
+	return pref, nil
+}
+
+func (r *notificationRepo) SavePreference(ctx context.Context, pref *biz.NotificationPreference) error {
+	channels, err := json.Marshal(pref.Channels)
+	if err != nil {
+		return err
+	}
+	m := &model.NotificationPreference{
+		UserID:          pref.UserID,
+		Channels:        string(channels),
+		QuietHoursStart: pref.QuietHoursStart,
+		QuietHoursEnd:   pref.QuietHoursEnd,
+		Timezone:        pref.Timezone,
+	}
+	return r.data.DB(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"channels", "quiet_hours_start", "quiet_hours_end", "timezone", "updated_at"}),
+	}).Create(m).Error
+}
+
+func (r *notificationRepo) toBiz(m *model.Notification) *biz.Notification {
+	n := &biz.Notification{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Type:      m.Type,
+		Title:     m.Title,
+		Content:   m.Content,
+		ReadAt:    m.ReadAt,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.Data != nil && *m.Data != "" {
+		if err := json.Unmarshal([]byte(*m.Data), &n.Params); err != nil {
+			r.log.Errorf("解析通知参数失败: id=%d err=%v", m.ID, err)
+		}
+	}
+	return n
+}
@@ -0,0 +1,19 @@
+package data
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+// NewOutboundQueue 按配置选择出站队列实现，默认使用 Redis Streams
+func NewOutboundQueue(data *Data, c *conf.App, logger log.Logger) biz.OutboundQueue {
+	cfg := c.Outbound
+	if cfg == nil {
+		cfg = &conf.App_Outbound{}
+	}
+	if cfg.Driver == "database" {
+		return newDBOutboundQueue(data, cfg, logger)
+	}
+	return newRedisOutboundQueue(data, cfg, logger)
+}
@@ -0,0 +1,167 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/data/model"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	outboundStatusPending    = "pending"
+	outboundStatusProcessing = "processing"
+	outboundStatusSent       = "sent"
+	outboundStatusDead       = "dead"
+
+	// 错误信息最大字符数，与 outbound_messages.last_error、message_deliveries.error 的长度一致
+	maxErrorLength = 1000
+)
+
+var _ biz.OutboundQueue = (*dbOutboundQueue)(nil)
+
+// dbOutboundQueue 基于数据库表的出站队列，适用于未部署 Redis 持久化的环境
+// 通过 FOR UPDATE SKIP LOCKED 领取消息，多实例消费互不阻塞；幂等键由唯一索引保证，长期有效
+type dbOutboundQueue struct {
+	data       *Data
+	visibility time.Duration
+	log        *log.Helper
+}
+
+func newDBOutboundQueue(data *Data, c *conf.App_Outbound, logger log.Logger) *dbOutboundQueue {
+	q := &dbOutboundQueue{
+		data:       data,
+		visibility: outboundDefaultVisibility,
+		log:        log.NewHelper(log.With(logger, "module", "data/outbound")),
+	}
+	if c.VisibilityTimeout != nil && c.VisibilityTimeout.AsDuration() > 0 {
+		q.visibility = c.VisibilityTimeout.AsDuration()
+	}
+	return q
+}
+
+func (q *dbOutboundQueue) Enqueue(ctx context.Context, m *biz.OutboundMessage) (bool, error) {
+	payload, err := json.Marshal(newOutboundPayload(m))
+	if err != nil {
+		return false, err
+	}
+	row := &model.OutboundMessage{
+		MessageKey:    m.Key,
+		Channel:       string(m.Channel),
+		Receiver:      m.To,
+		Template:      m.Template,
+		Payload:       string(payload),
+		Status:        outboundStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+	// 幂等键冲突时不插入，通过影响行数判断是否为重复请求
+	result := q.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(row)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (q *dbOutboundQueue) Dequeue(ctx context.Context, consumer string, count int, wait time.Duration) ([]*biz.OutboundMessage, error) {
+	var rows []*model.OutboundMessage
+	err := q.data.InTx(ctx, func(ctx context.Context) error {
+		om := q.data.Q(ctx).OutboundMessage
+		now := time.Now()
+		var err error
+		// processing 状态且已超过确认超时时间的消息视为消费者崩溃，重新领取
+		rows, err = om.WithContext(ctx).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where(om.Status.In(outboundStatusPending, outboundStatusProcessing), om.NextAttemptAt.Lte(now)).
+			Order(om.NextAttemptAt).
+			Limit(count).
+			Find()
+		if err != nil || len(rows) == 0 {
+			return err
+		}
+		keys := make([]string, 0, len(rows))
+		for _, r := range rows {
+			keys = append(keys, r.MessageKey)
+		}
+		_, err = om.WithContext(ctx).Where(om.MessageKey.In(keys...)).Updates(map[string]interface{}{
+			"status":          outboundStatusProcessing,
+			"next_attempt_at": now.Add(q.visibility),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+		}
+		return nil, nil
+	}
+
+	list := make([]*biz.OutboundMessage, 0, len(rows))
+	for _, r := range rows {
+		var p outboundPayload
+		if err := json.Unmarshal([]byte(r.Payload), &p); err != nil {
+			q.log.Errorf("解析出站消息失败，已移入死信: key=%s err=%v", r.MessageKey, err)
+			_ = q.update(ctx, r.MessageKey, map[string]interface{}{"status": outboundStatusDead, "last_error": truncateError(err.Error())})
+			continue
+		}
+		m := p.toBiz()
+		m.Attempts = int(r.Attempts)
+		m.Receipt = r.MessageKey
+		list = append(list, m)
+	}
+	return list, nil
+}
+
+func (q *dbOutboundQueue) IsSent(ctx context.Context, key string) (bool, error) {
+	om := q.data.Q(ctx).OutboundMessage
+	n, err := om.WithContext(ctx).Where(om.MessageKey.Eq(key), om.Status.Eq(outboundStatusSent)).Count()
+	return n > 0, err
+}
+
+func (q *dbOutboundQueue) Ack(ctx context.Context, m *biz.OutboundMessage) error {
+	return q.update(ctx, m.Key, map[string]interface{}{
+		"status":   outboundStatusSent,
+		"attempts": m.Attempts,
+		"sent_at":  time.Now(),
+	})
+}
+
+func (q *dbOutboundQueue) Retry(ctx context.Context, m *biz.OutboundMessage, at time.Time) error {
+	return q.update(ctx, m.Key, map[string]interface{}{
+		"status":          outboundStatusPending,
+		"attempts":        m.Attempts,
+		"next_attempt_at": at,
+		"last_error":      truncateError(m.LastError),
+	})
+}
+
+func (q *dbOutboundQueue) DeadLetter(ctx context.Context, m *biz.OutboundMessage) error {
+	return q.update(ctx, m.Key, map[string]interface{}{
+		"status":     outboundStatusDead,
+		"attempts":   m.Attempts,
+		"last_error": truncateError(m.LastError),
+	})
+}
+
+func (q *dbOutboundQueue) update(ctx context.Context, key string, values map[string]interface{}) error {
+	om := q.data.Q(ctx).OutboundMessage
+	_, err := om.WithContext(ctx).Where(om.MessageKey.Eq(key)).Updates(values)
+	return err
+}
+
+// truncateError 按字符截断，避免截断多字节字符导致写入失败
+func truncateError(s string) string {
+	r := []rune(s)
+	if len(r) <= maxErrorLength {
+		return s
+	}
+	return string(r[:maxErrorLength])
+}
@@ -0,0 +1,273 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/email"
+)
+
+const (
+	outboundStreamKey  = "outbound:stream"
+	outboundDelayedKey = "outbound:delayed" // 等待重试的消息，score 为重新投递的毫秒时间戳
+	outboundDeadKey    = "outbound:dead"
+	outboundIdemPrefix = "outbound:key:"
+	outboundGroup      = "outbound-workers"
+	outboundDeadMaxLen = 10000
+
+	outboundStateQueued = "queued"
+	outboundStateSent   = "sent"
+
+	outboundDefaultVisibility = time.Minute
+	outboundDefaultIdemTTL    = 24 * time.Hour
+)
+
+// 将到期的重试消息移回 Stream
+var outboundPromoteScript = redis.NewScript(`
+local items = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+for _, v in ipairs(items) do
+	redis.call('XADD', KEYS[2], '*', 'payload', v)
+	redis.call('ZREM', KEYS[1], v)
+end
+return #items
+`)
+
+// outboundPayload 队列中存储的消息体
+type outboundPayload struct {
+	Key         string              `json:"key"`
+	Channel     string              `json:"channel"`
+	To          string              `json:"to"`
+	Template    string              `json:"template"`
+	Params      map[string]string   `json:"params,omitempty"`
+	Locales     []string            `json:"locales,omitempty"`
+	Attachments []*email.Attachment `json:"attachments,omitempty"`
+	Inline      []*email.Attachment `json:"inline,omitempty"`
+	Attempts    int                 `json:"attempts"`
+	LastError   string              `json:"last_error,omitempty"`
+	CreatedAt   int64               `json:"created_at"`
+}
+
+func newOutboundPayload(m *biz.OutboundMessage) *outboundPayload {
+	return &outboundPayload{
+		Key:         m.Key,
+		Channel:     string(m.Channel),
+		To:          m.To,
+		Template:    m.Template,
+		Params:      m.Params,
+		Locales:     m.Locales,
+		Attachments: m.Attachments,
+		Inline:      m.Inline,
+		Attempts:    m.Attempts,
+		LastError:   m.LastError,
+		CreatedAt:   m.CreatedAt.UnixMilli(),
+	}
+}
+
+func (p *outboundPayload) toBiz() *biz.OutboundMessage {
+	return &biz.OutboundMessage{
+		Key:         p.Key,
+		Channel:     biz.DeliveryChannel(p.Channel),
+		To:          p.To,
+		Template:    p.Template,
+		Params:      p.Params,
+		Locales:     p.Locales,
+		Attachments: p.Attachments,
+		Inline:      p.Inline,
+		Attempts:    p.Attempts,
+		LastError:   p.LastError,
+		CreatedAt:   time.UnixMilli(p.CreatedAt),
+	}
+}
+
+var _ biz.OutboundQueue = (*redisOutboundQueue)(nil)
+
+// redisOutboundQueue 基于 Redis Streams 消费组的出站队列
+// 取出未确认的消息留在 PEL 中，超过可见性超时后由其他消费者通过 XAUTOCLAIM 接管
+type redisOutboundQueue struct {
+	data        *Data
+	visibility  time.Duration
+	idemTTL     time.Duration
+	groupExists atomic.Bool
+	log         *log.Helper
+}
+
+func newRedisOutboundQueue(data *Data, c *conf.App_Outbound, logger log.Logger) *redisOutboundQueue {
+	q := &redisOutboundQueue{
+		data:       data,
+		visibility: outboundDefaultVisibility,
+		idemTTL:    outboundDefaultIdemTTL,
+		log:        log.NewHelper(log.With(logger, "module", "data/outbound")),
+	}
+	if c.VisibilityTimeout != nil && c.VisibilityTimeout.AsDuration() > 0 {
+		q.visibility = c.VisibilityTimeout.AsDuration()
+	}
+	if c.IdempotencyTtl != nil && c.IdempotencyTtl.AsDuration() > 0 {
+		q.idemTTL = c.IdempotencyTtl.AsDuration()
+	}
+	return q
+}
+
+func (q *redisOutboundQueue) Enqueue(ctx context.Context, m *biz.OutboundMessage) (bool, error) {
+	rdb := q.data.RDB()
+	ok, err := rdb.SetNX(ctx, outboundIdemPrefix+m.Key, outboundStateQueued, q.idemTTL).Result()
+	if err != nil || !ok {
+		return false, err
+	}
+
+	payload, err := json.Marshal(newOutboundPayload(m))
+	if err != nil {
+		_ = rdb.Del(ctx, outboundIdemPrefix+m.Key).Err()
+		return false, err
+	}
+	if err := rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: outboundStreamKey,
+		Values: map[string]interface{}{"payload": payload},
+	}).Err(); err != nil {
+		// 入队失败时释放幂等键，允许调用方重试
+		_ = rdb.Del(ctx, outboundIdemPrefix+m.Key).Err()
+		return false, err
+	}
+	return true, nil
+}
+
+func (q *redisOutboundQueue) Dequeue(ctx context.Context, consumer string, count int, wait time.Duration) ([]*biz.OutboundMessage, error) {
+	if err := q.ensureGroup(ctx); err != nil {
+		return nil, err
+	}
+	rdb := q.data.RDB()
+
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	if err := outboundPromoteScript.Run(ctx, rdb, []string{outboundDelayedKey, outboundStreamKey}, now, count).Err(); err != nil {
+		return nil, err
+	}
+
+	// 优先接管超时未确认的消息（消费者崩溃或发送卡住）
+	claimed, _, err := rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   outboundStreamKey,
+		Group:    outboundGroup,
+		Consumer: consumer,
+		MinIdle:  q.visibility,
+		Start:    "0-0",
+		Count:    int64(count),
+	}).Result()
+	if err != nil {
+		return nil, q.checkGroup(err)
+	}
+	if len(claimed) > 0 {
+		return q.decode(ctx, claimed), nil
+	}
+
+	streams, err := rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    outboundGroup,
+		Consumer: consumer,
+		Streams:  []string{outboundStreamKey, ">"},
+		Count:    int64(count),
+		Block:    wait,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, q.checkGroup(err)
+	}
+	var list []*biz.OutboundMessage
+	for _, s := range streams {
+		list = append(list, q.decode(ctx, s.Messages)...)
+	}
+	return list, nil
+}
+
+func (q *redisOutboundQueue) IsSent(ctx context.Context, key string) (bool, error) {
+	state, err := q.data.RDB().Get(ctx, outboundIdemPrefix+key).Result()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	return state == outboundStateSent, err
+}
+
+func (q *redisOutboundQueue) Ack(ctx context.Context, m *biz.OutboundMessage) error {
+	pipe := q.data.RDB().TxPipeline()
+	pipe.SetArgs(ctx, outboundIdemPrefix+m.Key, outboundStateSent, redis.SetArgs{KeepTTL: true})
+	pipe.XAck(ctx, outboundStreamKey, outboundGroup, m.Receipt)
+	pipe.XDel(ctx, outboundStreamKey, m.Receipt)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (q *redisOutboundQueue) Retry(ctx context.Context, m *biz.OutboundMessage, at time.Time) error {
+	payload, err := json.Marshal(newOutboundPayload(m))
+	if err != nil {
+		return err
+	}
+	pipe := q.data.RDB().TxPipeline()
+	pipe.ZAdd(ctx, outboundDelayedKey, redis.Z{Score: float64(at.UnixMilli()), Member: payload})
+	pipe.XAck(ctx, outboundStreamKey, outboundGroup, m.Receipt)
+	pipe.XDel(ctx, outboundStreamKey, m.Receipt)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (q *redisOutboundQueue) DeadLetter(ctx context.Context, m *biz.OutboundMessage) error {
+	payload, err := json.Marshal(newOutboundPayload(m))
+	if err != nil {
+		return err
+	}
+	pipe := q.data.RDB().TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: outboundDeadKey,
+		MaxLen: outboundDeadMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{"payload": payload},
+	})
+	pipe.XAck(ctx, outboundStreamKey, outboundGroup, m.Receipt)
+	pipe.XDel(ctx, outboundStreamKey, m.Receipt)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// decode 解析 Stream 消息，无法解析的消息直接确认丢弃
+func (q *redisOutboundQueue) decode(ctx context.Context, messages []redis.XMessage) []*biz.OutboundMessage {
+	list := make([]*biz.OutboundMessage, 0, len(messages))
+	for _, xm := range messages {
+		raw, _ := xm.Values["payload"].(string)
+		var p outboundPayload
+		if err := json.Unmarshal([]byte(raw), &p); err != nil {
+			q.log.Errorf("解析出站消息失败，已丢弃: id=%s err=%v", xm.ID, err)
+			_ = q.data.RDB().XAck(ctx, outboundStreamKey, outboundGroup, xm.ID).Err()
+			continue
+		}
+		m := p.toBiz()
+		m.Receipt = xm.ID
+		list = append(list, m)
+	}
+	return list
+}
+
+func (q *redisOutboundQueue) ensureGroup(ctx context.Context) error {
+	if q.groupExists.Load() {
+		return nil
+	}
+	err := q.data.RDB().XGroupCreateMkStream(ctx, outboundStreamKey, outboundGroup, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	q.groupExists.Store(true)
+	return nil
+}
+
+// checkGroup Stream 被删除后消费组随之消失，下次拉取时重新创建
+func (q *redisOutboundQueue) checkGroup(err error) error {
+	if strings.HasPrefix(err.Error(), "NOGROUP") {
+		q.groupExists.Store(false)
+	}
+	return err
+}
@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+const (
+	presenceConnsKeyPrefix    = "presence:conns:"     // 有序集合，成员为 连接 ID|设备，分数为过期时间（毫秒）
+	presenceLastSeenKeyPrefix = "presence:last_seen:" // 最后活跃时间（秒）
+
+	defaultPresenceOnlineTTL   = 90 * time.Second
+	defaultPresenceLastSeenTTL = 30 * 24 * time.Hour
+)
+
+// presenceTouchScript 清理过期连接后记录连接心跳，返回记录前的有效连接数
+var presenceTouchScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[1])
+local before = redis.call('ZCARD', KEYS[1])
+redis.call('ZADD', KEYS[1], ARGV[2], ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+redis.call('SET', KEYS[2], ARGV[5], 'PX', ARGV[6])
+return before
+`)
+
+// presenceRemoveScript 移除连接，返回 1 表示移除的是最后一个有效连接
+var presenceRemoveScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[1])
+local removed = redis.call('ZREM', KEYS[1], ARGV[2])
+redis.call('SET', KEYS[2], ARGV[3], 'PX', ARGV[4])
+if removed == 1 and redis.call('ZCARD', KEYS[1]) == 0 then
+	return 1
+end
+return 0
+`)
+
+var _ biz.PresenceRepo = (*presenceRepo)(nil)
+
+type presenceRepo struct {
+	data        *Data
+	onlineTTL   time.Duration
+	lastSeenTTL time.Duration
+	log         *log.Helper
+}
+
+func NewPresenceRepo(data *Data, c *conf.App, logger log.Logger) biz.PresenceRepo {
+	r := &presenceRepo{
+		data:        data,
+		onlineTTL:   defaultPresenceOnlineTTL,
+		lastSeenTTL: defaultPresenceLastSeenTTL,
+		log:         log.NewHelper(log.With(logger, "module", "data/presence")),
+	}
+	if pc := c.Presence; pc != nil {
+		if pc.OnlineTtl != nil && pc.OnlineTtl.AsDuration() > 0 {
+			r.onlineTTL = pc.OnlineTtl.AsDuration()
+		}
+		if pc.LastSeenTtl != nil && pc.LastSeenTtl.AsDuration() > 0 {
+			r.lastSeenTTL = pc.LastSeenTtl.AsDuration()
+		}
+	}
+	return r
+}
+
+func (r *presenceRepo) Touch(ctx context.Context, userID int64, connID, device string, at time.Time) (bool, error) {
+	before, err := presenceTouchScript.Run(ctx, r.data.RDB(), r.keys(userID),
+		at.UnixMilli(),
+		at.Add(r.onlineTTL).UnixMilli(),
+		presenceMember(connID, device),
+		r.onlineTTL.Milliseconds(),
+		at.Unix(),
+		r.lastSeenTTL.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return false, err
+	}
+	return before == 0, nil
+}
+
+func (r *presenceRepo) Remove(ctx context.Context, userID int64, connID, device string, at time.Time) (bool, error) {
+	last, err := presenceRemoveScript.Run(ctx, r.data.RDB(), r.keys(userID),
+		at.UnixMilli(),
+		presenceMember(connID, device),
+		at.Unix(),
+		r.lastSeenTTL.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return false, err
+	}
+	return last == 1, nil
+}
+
+func (r *presenceRepo) Get(ctx context.Context, userIDs []int64, at time.Time) ([]*biz.Presence, error) {
+	now := strconv.FormatInt(at.UnixMilli(), 10)
+	pipe := r.data.RDB().Pipeline()
+	conns := make([]*redis.StringSliceCmd, len(userIDs))
+	lastSeen := make([]*redis.StringCmd, len(userIDs))
+	for i, id := range userIDs {
+		keys := r.keys(id)
+		conns[i] = pipe.ZRangeByScore(ctx, keys[0], &redis.ZRangeBy{Min: now, Max: "+inf"})
+		lastSeen[i] = pipe.Get(ctx, keys[1])
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	list := make([]*biz.Presence, 0, len(userIDs))
+	for i, id := range userIDs {
+		p := &biz.Presence{UserID: id}
+		seen := make(map[string]struct{})
+		for _, member := range conns[i].Val() {
+			_, device, _ := strings.Cut(member, "|")
+			if _, ok := seen[device]; !ok {
+				seen[device] = struct{}{}
+				p.Devices = append(p.Devices, device)
+			}
+		}
+		p.Online = len(p.Devices) > 0
+		if sec, err := lastSeen[i].Int64(); err == nil {
+			p.LastSeen = time.Unix(sec, 0)
+		}
+		list = append(list, p)
+	}
+	return list, nil
+}
+
+func (r *presenceRepo) keys(userID int64) []string {
+	id := strconv.FormatInt(userID, 10)
+	return []string{presenceConnsKeyPrefix + id, presenceLastSeenKeyPrefix + id}
+}
+
+// presenceMember 连接在有序集合中的成员，带上设备类型以便查询在线设备
+func presenceMember(connID, device string) string {
+	return connID + "|" + device
+}
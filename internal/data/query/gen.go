@@ -16,34 +16,99 @@ import (
 )
 
 var (
-	Q    = new(Query)
-	User *user
+	Q                      = new(Query)
+	ChatGroup              *chatGroup
+	ChatGroupMember        *chatGroupMember
+	Conversation           *conversation
+	Message                *message
+	MessageDelivery        *messageDelivery
+	MessageEdit            *messageEdit
+	MessageTemplate        *messageTemplate
+	Notification           *notification
+	NotificationPreference *notificationPreference
+	OutboundMessage        *outboundMessage
+	SensitiveReview        *sensitiveReview
+	SensitiveWord          *sensitiveWord
+	UploadFile             *uploadFile
+	User                   *user
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	ChatGroup = &Q.ChatGroup
+	ChatGroupMember = &Q.ChatGroupMember
+	Conversation = &Q.Conversation
+	Message = &Q.Message
+	MessageDelivery = &Q.MessageDelivery
+	MessageEdit = &Q.MessageEdit
+	MessageTemplate = &Q.MessageTemplate
+	Notification = &Q.Notification
+	NotificationPreference = &Q.NotificationPreference
+	OutboundMessage = &Q.OutboundMessage
+	SensitiveReview = &Q.SensitiveReview
+	SensitiveWord = &Q.SensitiveWord
+	UploadFile = &Q.UploadFile
 	User = &Q.User
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:   db,
-		User: newUser(db, opts...),
+		db:                     db,
+		ChatGroup:              newChatGroup(db, opts...),
+		ChatGroupMember:        newChatGroupMember(db, opts...),
+		Conversation:           newConversation(db, opts...),
+		Message:                newMessage(db, opts...),
+		MessageDelivery:        newMessageDelivery(db, opts...),
+		MessageEdit:            newMessageEdit(db, opts...),
+		MessageTemplate:        newMessageTemplate(db, opts...),
+		Notification:           newNotification(db, opts...),
+		NotificationPreference: newNotificationPreference(db, opts...),
+		OutboundMessage:        newOutboundMessage(db, opts...),
+		SensitiveReview:        newSensitiveReview(db, opts...),
+		SensitiveWord:          newSensitiveWord(db, opts...),
+		UploadFile:             newUploadFile(db, opts...),
+		User:                   newUser(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	User user
+	ChatGroup              chatGroup
+	ChatGroupMember        chatGroupMember
+	Conversation           conversation
+	Message                message
+	MessageDelivery        messageDelivery
+	MessageEdit            messageEdit
+	MessageTemplate        messageTemplate
+	Notification           notification
+	NotificationPreference notificationPreference
+	OutboundMessage        outboundMessage
+	SensitiveReview        sensitiveReview
+	SensitiveWord          sensitiveWord
+	UploadFile             uploadFile
+	User                   user
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		User: q.User.clone(db),
+		db:                     db,
+		ChatGroup:              q.ChatGroup.clone(db),
+		ChatGroupMember:        q.ChatGroupMember.clone(db),
+		Conversation:           q.Conversation.clone(db),
+		Message:                q.Message.clone(db),
+		MessageDelivery:        q.MessageDelivery.clone(db),
+		MessageEdit:            q.MessageEdit.clone(db),
+		MessageTemplate:        q.MessageTemplate.clone(db),
+		Notification:           q.Notification.clone(db),
+		NotificationPreference: q.NotificationPreference.clone(db),
+		OutboundMessage:        q.OutboundMessage.clone(db),
+		SensitiveReview:        q.SensitiveReview.clone(db),
+		SensitiveWord:          q.SensitiveWord.clone(db),
+		UploadFile:             q.UploadFile.clone(db),
+		User:                   q.User.clone(db),
 	}
 }
 
@@ -57,18 +122,57 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:   db,
-		User: q.User.replaceDB(db),
+		db:                     db,
+		ChatGroup:              q.ChatGroup.replaceDB(db),
+		ChatGroupMember:        q.ChatGroupMember.replaceDB(db),
+		Conversation:           q.Conversation.replaceDB(db),
+		Message:                q.Message.replaceDB(db),
+		MessageDelivery:        q.MessageDelivery.replaceDB(db),
+		MessageEdit:            q.MessageEdit.replaceDB(db),
+		MessageTemplate:        q.MessageTemplate.replaceDB(db),
+		Notification:           q.Notification.replaceDB(db),
+		NotificationPreference: q.NotificationPreference.replaceDB(db),
+		OutboundMessage:        q.OutboundMessage.replaceDB(db),
+		SensitiveReview:        q.SensitiveReview.replaceDB(db),
+		SensitiveWord:          q.SensitiveWord.replaceDB(db),
+		UploadFile:             q.UploadFile.replaceDB(db),
+		User:                   q.User.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	User IUserDo
+	ChatGroup              IChatGroupDo
+	ChatGroupMember        IChatGroupMemberDo
+	Conversation           IConversationDo
+	Message                IMessageDo
+	MessageDelivery        IMessageDeliveryDo
+	MessageEdit            IMessageEditDo
+	MessageTemplate        IMessageTemplateDo
+	Notification           INotificationDo
+	NotificationPreference INotificationPreferenceDo
+	OutboundMessage        IOutboundMessageDo
+	SensitiveReview        ISensitiveReviewDo
+	SensitiveWord          ISensitiveWordDo
+	UploadFile             IUploadFileDo
+	User                   IUserDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		User: q.User.WithContext(ctx),
+		ChatGroup:              q.ChatGroup.WithContext(ctx),
+		ChatGroupMember:        q.ChatGroupMember.WithContext(ctx),
+		Conversation:           q.Conversation.WithContext(ctx),
+		Message:                q.Message.WithContext(ctx),
+		MessageDelivery:        q.MessageDelivery.WithContext(ctx),
+		MessageEdit:            q.MessageEdit.WithContext(ctx),
+		MessageTemplate:        q.MessageTemplate.WithContext(ctx),
+		Notification:           q.Notification.WithContext(ctx),
+		NotificationPreference: q.NotificationPreference.WithContext(ctx),
+		OutboundMessage:        q.OutboundMessage.WithContext(ctx),
+		SensitiveReview:        q.SensitiveReview.WithContext(ctx),
+		SensitiveWord:          q.SensitiveWord.WithContext(ctx),
+		UploadFile:             q.UploadFile.WithContext(ctx),
+		User:                   q.User.WithContext(ctx),
 	}
 }
 
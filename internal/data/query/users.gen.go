@@ -30,6 +30,7 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 	_user.Username = field.NewString(tableName, "username")
 	_user.PasswordHash = field.NewString(tableName, "password_hash")
 	_user.Phone = field.NewString(tableName, "phone")
+	_user.Email = field.NewString(tableName, "email")
 	_user.Nickname = field.NewString(tableName, "nickname")
 	_user.IsAvailable = field.NewBool(tableName, "is_available")
 
@@ -44,7 +45,8 @@ type user struct {
 	ALL          field.Asterisk
 	Username     field.String // 用户名
 	PasswordHash field.String // 密码哈希
-	Phone        field.String // 手机号
+	Phone        field.String // 手机号（E.164 格式）
+	Email        field.String // 邮箱
 	Nickname     field.String // 昵称
 	IsAvailable  field.Bool   // 是否可用
 
@@ -66,6 +68,7 @@ func (u *user) updateTableName(table string) *user {
 	u.Username = field.NewString(table, "username")
 	u.PasswordHash = field.NewString(table, "password_hash")
 	u.Phone = field.NewString(table, "phone")
+	u.Email = field.NewString(table, "email")
 	u.Nickname = field.NewString(table, "nickname")
 	u.IsAvailable = field.NewBool(table, "is_available")
 
@@ -84,10 +87,11 @@ func (u *user) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *user) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 6)
+	u.fieldMap = make(map[string]field.Expr, 7)
 	u.fieldMap["username"] = u.Username
 	u.fieldMap["password_hash"] = u.PasswordHash
 	u.fieldMap["phone"] = u.Phone
+	u.fieldMap["email"] = u.Email
 	u.fieldMap["nickname"] = u.Nickname
 	u.fieldMap["is_available"] = u.IsAvailable
 
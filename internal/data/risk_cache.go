@@ -0,0 +1,50 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+)
+
+type redisRiskCache struct {
+	data *Data
+}
+
+func NewRedisRiskCache(data *Data) biz.RiskCache {
+	return &redisRiskCache{data: data}
+}
+
+func (r *redisRiskCache) Incr(ctx context.Context, k string, exp time.Duration) (int64, error) {
+	pipe := r.data.RDB().TxPipeline()
+	incr := pipe.Incr(ctx, k)
+	// 每次计数都刷新过期时间，持续异常的请求不会因窗口到期而清零
+	pipe.Expire(ctx, k, exp)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (r *redisRiskCache) Count(ctx context.Context, k string) (int64, error) {
+	n, err := r.data.RDB().Get(ctx, k).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return n, err
+}
+
+func (r *redisRiskCache) Set(ctx context.Context, k, v string, exp time.Duration) error {
+	return r.data.RDB().Set(ctx, k, v, exp).Err()
+}
+
+func (r *redisRiskCache) Exists(ctx context.Context, k string) (bool, error) {
+	i, err := r.data.RDB().Exists(ctx, k).Result()
+	return i > 0, err
+}
+
+func (r *redisRiskCache) Del(ctx context.Context, k string) error {
+	return r.data.RDB().Del(ctx, k).Err()
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/data/model"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/sensitive"
+)
+
+var _ biz.SensitiveRepo = (*sensitiveRepo)(nil)
+
+type sensitiveRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSensitiveRepo(data *Data, logger log.Logger) biz.SensitiveRepo {
+	return &sensitiveRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/sensitive")),
+	}
+}
+
+func (r *sensitiveRepo) ListWords(ctx context.Context) ([]sensitive.Word, error) {
+	list, err := r.data.Q(ctx).SensitiveWord.WithContext(ctx).Find()
+	if err != nil {
+		return nil, err
+	}
+	words := make([]sensitive.Word, 0, len(list))
+	for _, m := range list {
+		action, ok := sensitive.ParseAction(m.Action)
+		if !ok {
+			r.log.WithContext(ctx).Warnf("忽略未知处理方式的敏感词: id=%d action=%s", m.ID, m.Action)
+			continue
+		}
+		words = append(words, sensitive.Word{
+			Text:     m.Word,
+			Action:   action,
+			Variants: sensitive.SplitVariants(m.Variants),
+		})
+	}
+	return words, nil
+}
+
+func (r *sensitiveRepo) CreateReview(ctx context.Context, review *biz.SensitiveReview) error {
+	return r.data.Q(ctx).SensitiveReview.WithContext(ctx).Create(&model.SensitiveReview{
+		Scene:   string(review.Scene),
+		UserID:  review.UserID,
+		Content: review.Content,
+		Words:   strings.Join(review.Words, ","),
+	})
+}
@@ -0,0 +1,67 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/data/model"
+	"gorm.io/gorm"
+)
+
+var _ biz.UploadRepo = (*uploadRepo)(nil)
+
+type uploadRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewUploadRepo(data *Data, logger log.Logger) biz.UploadRepo {
+	return &uploadRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "data/upload")),
+	}
+}
+
+func (r *uploadRepo) CreateFile(ctx context.Context, f *biz.UploadedFile) error {
+	m := &model.UploadFile{
+		UserID:      f.UserID,
+		FileKey:     f.FileKey,
+		Scene:       f.Scene,
+		Name:        f.Name,
+		ContentType: f.ContentType,
+		Size:        f.Size,
+		IsPrivate:   f.IsPrivate,
+		Width:       f.Width,
+		Height:      f.Height,
+	}
+	if err := r.data.Q(ctx).UploadFile.WithContext(ctx).Create(m); err != nil {
+		return err
+	}
+	f.CreatedAt = m.CreatedAt
+	return nil
+}
+
+func (r *uploadRepo) GetFileByKey(ctx context.Context, key string) (*biz.UploadedFile, error) {
+	q := r.data.Q(ctx).UploadFile
+	m, err := q.WithContext(ctx).Where(q.FileKey.Eq(key)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrorUploadFileNotFound
+		}
+		return nil, err
+	}
+	return &biz.UploadedFile{
+		UserID:      m.UserID,
+		FileKey:     m.FileKey,
+		Scene:       m.Scene,
+		Name:        m.Name,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		IsPrivate:   m.IsPrivate,
+		Width:       m.Width,
+		Height:      m.Height,
+		CreatedAt:   m.CreatedAt,
+	}, nil
+}
@@ -33,6 +33,9 @@ func (r *userRepo) CreateUser(ctx context.Context, u *biz.User) (*biz.User, erro
 	if u.Phone != "" {
 		user.Phone = &u.Phone
 	}
+	if u.Email != "" {
+		user.Email = &u.Email
+	}
 	if u.Nickname != "" {
 		user.Nickname = &u.Nickname
 	}
@@ -93,11 +96,22 @@ func (r *userRepo) UpdatePhone(ctx context.Context, id int64, phone string) erro
 		Update("phone", phone).Error
 }
 
+func (r *userRepo) UpdateNickname(ctx context.Context, id int64, nickname string) error {
+	return r.data.db.WithContext(ctx).
+		Model(&model.User{}).
+		Where("id = ?", id).
+		Update("nickname", nickname).Error
+}
+
 func (r *userRepo) toBiz(u *model.User) *biz.User {
 	phone := ""
 	if u.Phone != nil {
 		phone = *u.Phone
 	}
+	email := ""
+	if u.Email != nil {
+		email = *u.Email
+	}
 	nickname := ""
 	if u.Nickname != nil {
 		nickname = *u.Nickname
@@ -112,6 +126,7 @@ func (r *userRepo) toBiz(u *model.User) *biz.User {
 		Username:     u.Username,
 		PasswordHash: u.PasswordHash,
 		Phone:        phone,
+		Email:        email,
 		Nickname:     nickname,
 		IsAvailable:  isAvailable,
 		CreatedAt:    u.CreatedAt,
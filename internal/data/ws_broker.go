@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
+)
+
+const (
+	wsNodeChannelPrefix  = "ws:node:"     // 每个实例订阅自己的频道
+	wsBroadcastChannel   = "ws:broadcast" // 所有实例共同订阅，用于房间广播
+	wsPresenceKeyPrefix  = "ws:presence:" // 有序集合，成员为实例，分数为过期时间（毫秒）
+	defaultWsPresenceTTL = 60 * time.Second
+)
+
+var _ ws.Broker = (*redisWsBroker)(nil)
+
+// redisWsBroker 基于 Redis 发布/订阅的 WebSocket 集群转发
+type redisWsBroker struct {
+	data *Data
+	node string
+	ttl  time.Duration
+	log  *log.Helper
+}
+
+// NewWsBroker 集群模式关闭时返回 nil，Hub 只在本实例投递
+func NewWsBroker(data *Data, c *conf.Server, logger log.Logger) ws.Broker {
+	if c.Websocket == nil || c.Websocket.Cluster == nil || !c.Websocket.Cluster.Enabled {
+		return nil
+	}
+	cc := c.Websocket.Cluster
+	b := &redisWsBroker{
+		data: data,
+		node: cc.NodeId,
+		ttl:  defaultWsPresenceTTL,
+		log:  log.NewHelper(log.With(logger, "module", "data/ws-broker")),
+	}
+	if b.node == "" {
+		host, _ := os.Hostname()
+		b.node = host + "-" + uuid.NewString()[:8]
+	}
+	if cc.PresenceTtl != nil && cc.PresenceTtl.AsDuration() > 0 {
+		b.ttl = cc.PresenceTtl.AsDuration()
+	}
+	b.log.Infof("WebSocket 集群模式已开启: node=%s", b.node)
+	return b
+}
+
+func (b *redisWsBroker) NodeID() string {
+	return b.node
+}
+
+func (b *redisWsBroker) Publish(ctx context.Context, node string, env *ws.Envelope) error {
+	payload, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+	return b.data.RDB().Publish(ctx, wsNodeChannelPrefix+node, payload).Err()
+}
+
+func (b *redisWsBroker) Broadcast(ctx context.Context, env *ws.Envelope) error {
+	payload, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+	return b.data.RDB().Publish(ctx, wsBroadcastChannel, payload).Err()
+}
+
+func (b *redisWsBroker) Subscribe(ctx context.Context, handler func(env *ws.Envelope)) error {
+	sub := b.data.RDB().Subscribe(ctx, wsNodeChannelPrefix+b.node, wsBroadcastChannel)
+	defer sub.Close()
+	// 确认订阅成功，失败时由后台协程重试
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			var env ws.Envelope
+			if err := json.Unmarshal([]byte(msg.Payload), &env); err != nil {
+				b.log.Errorf("解析转发消息失败: %v", err)
+				continue
+			}
+			handler(&env)
+		}
+	}
+}
+
+func (b *redisWsBroker) SetOnline(ctx context.Context, uids []string) error {
+	expireAt := float64(time.Now().Add(b.ttl).UnixMilli())
+	pipe := b.data.RDB().Pipeline()
+	for _, uid := range uids {
+		key := wsPresenceKeyPrefix + uid
+		pipe.ZAdd(ctx, key, redis.Z{Score: expireAt, Member: b.node})
+		pipe.Expire(ctx, key, b.ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (b *redisWsBroker) SetOffline(ctx context.Context, uid string) error {
+	return b.data.RDB().ZRem(ctx, wsPresenceKeyPrefix+uid, b.node).Err()
+}
+
+func (b *redisWsBroker) Nodes(ctx context.Context, uid string) ([]string, error) {
+	key := wsPresenceKeyPrefix + uid
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	pipe := b.data.RDB().Pipeline()
+	// 顺带清理异常退出的实例留下的过期记录
+	pipe.ZRemRangeByScore(ctx, key, "-inf", "("+now)
+	nodes := pipe.ZRangeByScore(ctx, key, &redis.ZRangeBy{Min: now, Max: "+inf"})
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	return nodes.Val(), nil
+}
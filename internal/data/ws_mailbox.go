@@ -0,0 +1,127 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
+)
+
+const (
+	wsSeqKeyPrefix     = "ws:seq:"     // 用户已分配的最大序号
+	wsMailboxKeyPrefix = "ws:mailbox:" // 有序集合，成员为消息，分数为序号
+	wsAckKeyPrefix     = "ws:ack:"     // 哈希，设备类型 -> 已确认的最大序号
+
+	defaultWsMailboxSize = 500
+	defaultWsMailboxTTL  = 7 * 24 * time.Hour
+)
+
+// wsAckScript 只在序号更大时更新确认序号
+var wsAckScript = redis.NewScript(`
+local cur = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0')
+if tonumber(ARGV[2]) > cur then
+	redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+end
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+return 1
+`)
+
+var _ ws.MailboxStore = (*redisWsMailboxStore)(nil)
+
+// redisWsMailboxStore 基于 Redis 的可靠消息信箱
+type redisWsMailboxStore struct {
+	data *Data
+	size int64
+	ttl  time.Duration
+}
+
+func NewWsMailboxStore(data *Data, c *conf.Server) ws.MailboxStore {
+	s := &redisWsMailboxStore{
+		data: data,
+		size: defaultWsMailboxSize,
+		ttl:  defaultWsMailboxTTL,
+	}
+	if wc := c.Websocket; wc != nil && wc.Delivery != nil {
+		if wc.Delivery.MailboxSize > 0 {
+			s.size = int64(wc.Delivery.MailboxSize)
+		}
+		if wc.Delivery.MailboxTtl != nil && wc.Delivery.MailboxTtl.AsDuration() > 0 {
+			s.ttl = wc.Delivery.MailboxTtl.AsDuration()
+		}
+	}
+	return s
+}
+
+func (s *redisWsMailboxStore) NextSeq(ctx context.Context, uid string) (int64, error) {
+	key := wsSeqKeyPrefix + uid
+	pipe := s.data.RDB().TxPipeline()
+	seq := pipe.Incr(ctx, key)
+	// 序号需比信箱保留更久，避免过期后从 1 重新开始与客户端记录的序号冲突，这里保留 4 倍时长
+	pipe.Expire(ctx, key, 4*s.ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return seq.Val(), nil
+}
+
+func (s *redisWsMailboxStore) Save(ctx context.Context, uid string, seq int64, msg []byte) error {
+	key := wsMailboxKeyPrefix + uid
+	pipe := s.data.RDB().TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(seq), Member: msg})
+	// 只保留最近 size 条
+	pipe.ZRemRangeByRank(ctx, key, 0, -s.size-1)
+	pipe.Expire(ctx, key, s.ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *redisWsMailboxStore) Pending(ctx context.Context, uid string, afterSeq int64, limit int) ([]ws.StoredMessage, error) {
+	list, err := s.data.RDB().ZRangeByScoreWithScores(ctx, wsMailboxKeyPrefix+uid, &redis.ZRangeBy{
+		Min:   "(" + strconv.FormatInt(afterSeq, 10),
+		Max:   "+inf",
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]ws.StoredMessage, 0, len(list))
+	for _, z := range list {
+		member, _ := z.Member.(string)
+		messages = append(messages, ws.StoredMessage{Seq: int64(z.Score), Msg: []byte(member)})
+	}
+	return messages, nil
+}
+
+func (s *redisWsMailboxStore) LastSeqs(ctx context.Context, uids []string) (map[string]int64, error) {
+	keys := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		keys = append(keys, wsSeqKeyPrefix+uid)
+	}
+	values, err := s.data.RDB().MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	seqs := make(map[string]int64, len(uids))
+	for i, v := range values {
+		if str, ok := v.(string); ok {
+			seqs[uids[i]], _ = strconv.ParseInt(str, 10, 64)
+		}
+	}
+	return seqs, nil
+}
+
+func (s *redisWsMailboxStore) Ack(ctx context.Context, uid, device string, seq int64) error {
+	return wsAckScript.Run(ctx, s.data.RDB(), []string{wsAckKeyPrefix + uid}, device, seq, (4 * s.ttl).Milliseconds()).Err()
+}
+
+func (s *redisWsMailboxStore) Acked(ctx context.Context, uid, device string) (int64, error) {
+	seq, err := s.data.RDB().HGet(ctx, wsAckKeyPrefix+uid, device).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return seq, err
+}
@@ -0,0 +1,35 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
+)
+
+const wsTicketKeyPrefix = "ws:ticket:" // 一次性连接凭证 -> 令牌
+
+var _ ws.TicketStore = (*redisWsTicketStore)(nil)
+
+// redisWsTicketStore 基于 Redis 的一次性连接凭证，GETDEL 保证只能使用一次
+type redisWsTicketStore struct {
+	data *Data
+}
+
+func NewWsTicketStore(data *Data) ws.TicketStore {
+	return &redisWsTicketStore{data: data}
+}
+
+func (s *redisWsTicketStore) Save(ctx context.Context, ticket, token string, ttl time.Duration) error {
+	return s.data.RDB().Set(ctx, wsTicketKeyPrefix+ticket, token, ttl).Err()
+}
+
+func (s *redisWsTicketStore) Take(ctx context.Context, ticket string) (string, error) {
+	token, err := s.data.RDB().GetDel(ctx, wsTicketKeyPrefix+ticket).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", ws.ErrTicketInvalid
+	}
+	return token, err
+}
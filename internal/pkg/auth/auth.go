@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
@@ -43,8 +44,13 @@ type TokenService interface {
 	RevokeAllTokensByUserID(ctx context.Context, userID int64) error
 	// GetSecretKey 获取密钥
 	GetSecretKey() []byte
+	// OnRevoke 注册令牌撤销回调，如断开使用该令牌的长连接；需在初始化时调用
+	OnRevoke(fn RevokeFunc)
 }
 
+// RevokeFunc 令牌撤销回调，jtis 为空表示撤销了用户的所有令牌
+type RevokeFunc func(ctx context.Context, userID string, jtis []string)
+
 var _ TokenService = (*JWTTokenService)(nil)
 
 // JWTTokenService JWT 令牌服务接口
@@ -52,6 +58,9 @@ type JWTTokenService struct {
 	secretKey []byte
 	ttl       time.Duration
 	store     store.TokenStore
+
+	mu       sync.RWMutex
+	onRevoke []RevokeFunc
 }
 
 func NewJWTTokenService(secretKey string, ttl time.Duration, store store.TokenStore) TokenService {
@@ -176,7 +185,11 @@ func (s *JWTTokenService) RevokeToken(ctx context.Context, jti string) error {
 		jti = registeredClaims.ID
 	}
 
-	return s.store.DeleteUserToken(ctx, userID, jti)
+	if err := s.store.DeleteUserToken(ctx, userID, jti); err != nil {
+		return err
+	}
+	s.revoked(ctx, userID, []string{jti})
+	return nil
 }
 
 func (s *JWTTokenService) RevokeAllTokens(ctx context.Context) error {
@@ -184,14 +197,38 @@ func (s *JWTTokenService) RevokeAllTokens(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return s.store.DeleteUserTokens(ctx, userID)
+	if err := s.store.DeleteUserTokens(ctx, userID); err != nil {
+		return err
+	}
+	s.revoked(ctx, userID, nil)
+	return nil
 }
 
 func (s *JWTTokenService) RevokeAllTokensByUserID(ctx context.Context, userID int64) error {
 	userIDStr := strconv.FormatInt(userID, 10)
-	return s.store.DeleteUserTokens(ctx, userIDStr)
+	if err := s.store.DeleteUserTokens(ctx, userIDStr); err != nil {
+		return err
+	}
+	s.revoked(ctx, userIDStr, nil)
+	return nil
 }
 
 func (s *JWTTokenService) GetSecretKey() []byte {
 	return s.secretKey
 }
+
+func (s *JWTTokenService) OnRevoke(fn RevokeFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onRevoke = append(s.onRevoke, fn)
+}
+
+// revoked 通知令牌撤销回调
+func (s *JWTTokenService) revoked(ctx context.Context, userID string, jtis []string) {
+	s.mu.RLock()
+	fns := s.onRevoke
+	s.mu.RUnlock()
+	for _, fn := range fns {
+		fn(ctx, userID, jtis)
+	}
+}
@@ -2,13 +2,21 @@ package auth
 
 import (
 	"context"
+	"strings"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
 	jwtv5 "github.com/golang-jwt/jwt/v5"
 )
 
+// AdminOperationPrefix 管理接口的 operation 前缀
+const AdminOperationPrefix = "/api.admin."
+
+var ErrPermissionDenied = errors.Forbidden("PERMISSION_DENIED", "无权访问")
+
 // PathAccessConfig 路径访问配置
 type PathAccessConfig struct {
 	// 无需认证的路径
@@ -78,6 +86,44 @@ func JWTRecheck(tokenService TokenService) middleware.Middleware {
 	}
 }
 
+// NewTokenContext 校验 token 并将其声明放入 context，供 WebSocket 等非 Kratos 传输复用 JWTRecheck 的校验方式；
+// 之后可通过 TokenService.GetUserIDFromContext 获取用户，并在令牌被撤销后失效
+func NewTokenContext(ctx context.Context, tokenService TokenService, tokenStr string) (context.Context, error) {
+	t, err := jwtv5.ParseWithClaims(tokenStr, &jwtv5.RegisteredClaims{}, func(token *jwtv5.Token) (interface{}, error) {
+		return tokenService.GetSecretKey(), nil
+	}, jwtv5.WithValidMethods([]string{jwtv5.SigningMethodHS256.Alg()}))
+	if err != nil || !t.Valid {
+		return nil, ErrInvalidToken
+	}
+	ctx = jwt.NewContext(ctx, t.Claims)
+	if _, err := tokenService.ParseTokenFromContext(ctx); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// TokenIDFromContext 返回 context 中令牌的 jti，未认证时为空
+func TokenIDFromContext(ctx context.Context) string {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if c, ok := claims.(*jwtv5.RegisteredClaims); ok {
+		return c.ID
+	}
+	return ""
+}
+
+// TokenFromServerContext 返回当前请求 Authorization 头中的 Bearer 令牌
+func TokenFromServerContext(ctx context.Context) (string, bool) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", false
+	}
+	token, ok := strings.CutPrefix(tr.RequestHeader().Get("Authorization"), "Bearer ")
+	return token, ok && token != ""
+}
+
 // JWTMiddleware 创建 JWT 认证中间件
 func JWTMiddleware(tokenService TokenService) middleware.Middleware {
 	return jwt.Server(
@@ -100,3 +146,27 @@ func Middleware(tokenService TokenService, config *PathAccessConfig) middleware.
 		return !IsPublicPath(ctx, operation, config)
 	}).Build()
 }
+
+// AdminMiddleware 管理接口鉴权，仅允许配置的管理员用户访问，需放在 Middleware 之后
+func AdminMiddleware(tokenService TokenService, adminUserIDs []int64) middleware.Middleware {
+	admins := make(map[int64]struct{}, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		admins[id] = struct{}{}
+	}
+	return selector.Server(
+		func(handler middleware.Handler) middleware.Handler {
+			return func(ctx context.Context, req interface{}) (interface{}, error) {
+				userID, err := tokenService.GetUserIDFromContext(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := admins[userID]; !ok {
+					return nil, ErrPermissionDenied
+				}
+				return handler(ctx, req)
+			}
+		},
+	).Match(func(ctx context.Context, operation string) bool {
+		return strings.HasPrefix(operation, AdminOperationPrefix)
+	}).Build()
+}
@@ -0,0 +1,149 @@
+package clientinfo
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"golang.org/x/text/language"
+	"google.golang.org/grpc/peer"
+)
+
+// HeaderDeviceFingerprint 客户端设备指纹请求头，由前端 SDK 生成
+const HeaderDeviceFingerprint = "X-Device-Fingerprint"
+
+const maxFingerprintLength = 128
+
+type ipKey struct{}
+
+// Middleware 解析客户端 IP 并放入 context：仅当请求来自可信代理（IP 或 CIDR）时采信 X-Real-IP / X-Forwarded-For，
+// 否则使用连接地址，避免客户端伪造请求头绕过按 IP 的限流
+func Middleware(trustedProxies []string) middleware.Middleware {
+	trusted := parsePrefixes(trustedProxies)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if ip := resolveIP(ctx, trusted); ip != "" {
+				ctx = context.WithValue(ctx, ipKey{}, ip)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// IP 获取客户端 IP，由 Middleware 解析；未经过 Middleware 时使用连接地址
+func IP(ctx context.Context) string {
+	if ip, ok := ctx.Value(ipKey{}).(string); ok {
+		return ip
+	}
+	return resolveIP(ctx, nil)
+}
+
+func resolveIP(ctx context.Context, trusted []netip.Prefix) string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	remote := remoteAddr(ctx, tr)
+	if !isTrusted(remote, trusted) {
+		return remote
+	}
+	if ip := strings.TrimSpace(tr.RequestHeader().Get("X-Real-IP")); ip != "" {
+		return ip
+	}
+	// 从右向左跳过可信代理，第一个不可信的地址即客户端
+	if xff := tr.RequestHeader().Get("X-Forwarded-For"); xff != "" {
+		hops := strings.Split(xff, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := strings.TrimSpace(hops[i])
+			if ip == "" {
+				continue
+			}
+			if i == 0 || !isTrusted(ip, trusted) {
+				return ip
+			}
+		}
+	}
+	return remote
+}
+
+// remoteAddr 连接的对端地址
+func remoteAddr(ctx context.Context, tr transport.Transporter) string {
+	var addr string
+	if ht, ok := tr.(http.Transporter); ok {
+		addr = ht.Request().RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+func parsePrefixes(list []string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(list))
+	for _, s := range list {
+		s = strings.TrimSpace(s)
+		if p, err := netip.ParsePrefix(s); err == nil {
+			prefixes = append(prefixes, p.Masked())
+		} else if a, err := netip.ParseAddr(s); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(a, a.BitLen()))
+		}
+	}
+	return prefixes
+}
+
+func isTrusted(ip string, trusted []netip.Prefix) bool {
+	a, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	a = a.Unmap()
+	for _, p := range trusted {
+		if p.Contains(a) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeviceFingerprint 获取设备指纹，未携带时返回空字符串
+func DeviceFingerprint(ctx context.Context) string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	fp := strings.TrimSpace(tr.RequestHeader().Get(HeaderDeviceFingerprint))
+	if len(fp) > maxFingerprintLength {
+		fp = fp[:maxFingerprintLength]
+	}
+	return fp
+}
+
+// Languages 解析 Accept-Language，按权重从高到低返回语言标签，如 [zh-CN zh en]
+func Languages(ctx context.Context) []string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return nil
+	}
+	header := tr.RequestHeader().Get("Accept-Language")
+	if header == "" {
+		return nil
+	}
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+	langs := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if t == language.Und {
+			continue
+		}
+		langs = append(langs, t.String())
+	}
+	return langs
+}
@@ -0,0 +1,150 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	util "github.com/alibabacloud-go/tea-utils/v2/service"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/credentials-go/credentials"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+const (
+	aliyunDefaultEndpoint = "dm.aliyuncs.com"
+	aliyunApiVersion      = "2015-11-23"
+	// 发信地址类型：1 为发信地址，0 为随机账号
+	aliyunAddressTypeAccount = "1"
+)
+
+// aliyunProvider 阿里云邮件推送 SingleSendMail 接口，走 HTTPS 443 端口，不受 SMTP 端口封禁影响
+// 官方 dm SDK 未引入，通过 OpenAPI 通用调用完成签名
+type aliyunProvider struct {
+	client   *openapi.Client
+	protocol string
+	conf     *conf.Data_Email_Aliyun
+	from     string
+	log      *log.Helper
+}
+
+func NewAliyunProvider(c *conf.Data_Email, logger log.Logger) Provider {
+	ac := c.Aliyun
+	if ac == nil {
+		panic("阿里云邮件推送未配置")
+	}
+	cred, err := credentials.NewCredential(&credentials.Config{
+		Type:            tea.String("access_key"),
+		AccessKeyId:     tea.String(ac.AccessKey),
+		AccessKeySecret: tea.String(ac.AccessSecret),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("初始化阿里云凭据失败: %v", err))
+	}
+
+	// endpoint 可带协议前缀，便于本地联调时指向 http 服务
+	endpoint, protocol := aliyunDefaultEndpoint, "HTTPS"
+	if ac.Endpoint != "" {
+		endpoint = ac.Endpoint
+	}
+	if rest, ok := strings.CutPrefix(endpoint, "http://"); ok {
+		endpoint, protocol = rest, "HTTP"
+	} else {
+		endpoint = strings.TrimPrefix(endpoint, "https://")
+	}
+
+	client, err := openapi.NewClient(&openapi.Config{
+		Credential: cred,
+		Endpoint:   tea.String(endpoint),
+		Protocol:   tea.String(protocol),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("创建阿里云邮件推送客户端失败: %v", err))
+	}
+
+	from := ac.AccountName
+	if from == "" {
+		from = c.From
+	}
+	return &aliyunProvider{
+		client:   client,
+		protocol: protocol,
+		conf:     ac,
+		from:     from,
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (p *aliyunProvider) Name() string {
+	return "aliyun"
+}
+
+func (p *aliyunProvider) Deliver(ctx context.Context, msg *Message) error {
+	// SingleSendMail 不支持附件与内嵌图片
+	if len(msg.Attachments) > 0 || len(msg.Inline) > 0 {
+		return ErrorAttachmentNotSupported
+	}
+
+	body := map[string]interface{}{
+		"AccountName":    p.from,
+		"AddressType":    aliyunAddressTypeAccount,
+		"ReplyToAddress": "false",
+		"ToAddress":      msg.To,
+		"Subject":        msg.Subject,
+		"HtmlBody":       msg.HTML,
+	}
+	if msg.Text != "" {
+		body["TextBody"] = msg.Text
+	}
+	if p.conf.FromAlias != "" {
+		body["FromAlias"] = p.conf.FromAlias
+	}
+	if p.conf.TagName != "" {
+		body["TagName"] = p.conf.TagName
+	}
+
+	params := &openapi.Params{
+		Action:      tea.String("SingleSendMail"),
+		Version:     tea.String(aliyunApiVersion),
+		Protocol:    tea.String(p.protocol),
+		Pathname:    tea.String("/"),
+		Method:      tea.String("POST"),
+		AuthType:    tea.String("AK"),
+		Style:       tea.String("RPC"),
+		ReqBodyType: tea.String("formData"),
+		BodyType:    tea.String("json"),
+	}
+	runtime := &util.RuntimeOptions{
+		ConnectTimeout: tea.Int(5000),
+		ReadTimeout:    tea.Int(10000),
+	}
+
+	// 按照官方示例处理 Tea 框架的 Panic 和 Error
+	err := func() (e error) {
+		defer func() {
+			if r := tea.Recover(recover()); r != nil {
+				e = r
+			}
+		}()
+		resp, err := p.client.CallApi(params, &openapi.OpenApiRequest{Body: body}, runtime)
+		if err != nil {
+			return err
+		}
+		if b, ok := resp["body"].(map[string]interface{}); ok {
+			p.log.WithContext(ctx).Infof("邮件发送成功: provider=aliyun RequestId=%v EnvId=%v", b["RequestId"], b["EnvId"])
+		}
+		return nil
+	}()
+	if err != nil {
+		var sdkErr *tea.SDKError
+		if errors.As(err, &sdkErr) {
+			p.log.WithContext(ctx).Errorf("阿里云邮件推送报错: code=%s message=%s",
+				tea.StringValue(sdkErr.Code), tea.StringValue(sdkErr.Message))
+		}
+		return ErrorProviderSendFailed.WithCause(err)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package email
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+const (
+	testAccessKey    = "test-ak"
+	testAccessSecret = "test-sk"
+)
+
+func TestAliyunProvider_Deliver(t *testing.T) {
+	tests := []struct {
+		name     string
+		conf     *conf.Data_Email_Aliyun
+		msg      *Message
+		status   int
+		resp     string
+		want     url.Values
+		wantErr  error
+		requests int
+	}{
+		{
+			name: "defaults",
+			conf: &conf.Data_Email_Aliyun{},
+			msg:  &Message{To: "user@example.com", Subject: "验证码", HTML: "<p>123456</p>"},
+			want: url.Values{
+				"AccountName":    {"noreply@example.com"},
+				"AddressType":    {"1"},
+				"ReplyToAddress": {"false"},
+				"ToAddress":      {"user@example.com"},
+				"Subject":        {"验证码"},
+				"HtmlBody":       {"<p>123456</p>"},
+			},
+			status:   http.StatusOK,
+			resp:     `{"RequestId":"req-1","EnvId":"env-1"}`,
+			requests: 1,
+		},
+		{
+			name: "account, alias, tag and text body",
+			conf: &conf.Data_Email_Aliyun{AccountName: "mail@dm.example.com", FromAlias: "Bubble", TagName: "otp"},
+			msg:  &Message{To: "user@example.com", Subject: "Code", HTML: "<p>1</p>", Text: "1"},
+			want: url.Values{
+				"AccountName":    {"mail@dm.example.com"},
+				"AddressType":    {"1"},
+				"ReplyToAddress": {"false"},
+				"ToAddress":      {"user@example.com"},
+				"Subject":        {"Code"},
+				"HtmlBody":       {"<p>1</p>"},
+				"TextBody":       {"1"},
+				"FromAlias":      {"Bubble"},
+				"TagName":        {"otp"},
+			},
+			status:   http.StatusOK,
+			resp:     `{"RequestId":"req-2","EnvId":"env-2"}`,
+			requests: 1,
+		},
+		{
+			name:     "provider error",
+			conf:     &conf.Data_Email_Aliyun{},
+			msg:      &Message{To: "bad", Subject: "s", HTML: "h"},
+			status:   http.StatusBadRequest,
+			resp:     `{"RequestId":"req-3","Code":"InvalidToAddress","Message":"The ToAddress is invalid."}`,
+			wantErr:  ErrorProviderSendFailed,
+			requests: 1,
+		},
+		{
+			name:     "attachments not supported",
+			conf:     &conf.Data_Email_Aliyun{},
+			msg:      &Message{To: "user@example.com", Subject: "s", HTML: "h", Attachments: []*Attachment{{Filename: "a.txt"}}},
+			wantErr:  ErrorAttachmentNotSupported,
+			requests: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != http.MethodPost || r.URL.Path != "/" {
+					t.Errorf("request = %s %s, want POST /", r.Method, r.URL.Path)
+				}
+				if got := r.Header.Get("X-Acs-Action"); got != "SingleSendMail" {
+					t.Errorf("x-acs-action = %q", got)
+				}
+				if got := r.Header.Get("X-Acs-Version"); got != aliyunApiVersion {
+					t.Errorf("x-acs-version = %q", got)
+				}
+				verifyAcs3Signature(t, r, body)
+
+				form, err := url.ParseQuery(string(body))
+				if err != nil {
+					t.Fatalf("parse form: %v", err)
+				}
+				if tt.want != nil && form.Encode() != tt.want.Encode() {
+					t.Errorf("form = %v\nwant %v", form, tt.want)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = io.WriteString(w, tt.resp)
+			}))
+			defer srv.Close()
+
+			tt.conf.AccessKey, tt.conf.AccessSecret, tt.conf.Endpoint = testAccessKey, testAccessSecret, srv.URL
+			p := NewAliyunProvider(&conf.Data_Email{From: "noreply@example.com", Aliyun: tt.conf}, log.NewStdLogger(io.Discard))
+			err := p.Deliver(context.Background(), tt.msg)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Deliver: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if requests != tt.requests {
+				t.Errorf("requests = %d, want %d", requests, tt.requests)
+			}
+		})
+	}
+}
+
+// verifyAcs3Signature 按阿里云 V3 签名（ACS3-HMAC-SHA256）重新计算签名并比对
+func verifyAcs3Signature(t *testing.T, r *http.Request, body []byte) {
+	t.Helper()
+	auth := strings.TrimPrefix(r.Header.Get("Authorization"), "ACS3-HMAC-SHA256 ")
+	parts := make(map[string]string)
+	for _, kv := range strings.Split(auth, ",") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(kv), "="); ok {
+			parts[k] = v
+		}
+	}
+	if parts["Credential"] != testAccessKey {
+		t.Fatalf("credential = %q, want %q", parts["Credential"], testAccessKey)
+	}
+
+	payloadHash := sha256.Sum256(body)
+	if got := r.Header.Get("X-Acs-Content-Sha256"); got != hex.EncodeToString(payloadHash[:]) {
+		t.Fatalf("x-acs-content-sha256 = %q, want hash of body", got)
+	}
+
+	signed := strings.Split(parts["SignedHeaders"], ";")
+	sort.Strings(signed)
+	var headers strings.Builder
+	for _, h := range signed {
+		v := r.Header.Get(h)
+		if h == "host" {
+			v = r.Host
+		}
+		headers.WriteString(h + ":" + strings.TrimSpace(v) + "\n")
+	}
+	canonical := strings.Join([]string{
+		r.Method,
+		r.URL.Path,
+		r.URL.RawQuery,
+		headers.String(),
+		strings.Join(signed, ";"),
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonical))
+	mac := hmac.New(sha256.New, []byte(testAccessSecret))
+	mac.Write([]byte("ACS3-HMAC-SHA256\n" + hex.EncodeToString(canonicalHash[:])))
+	if want := hex.EncodeToString(mac.Sum(nil)); parts["Signature"] != want {
+		t.Fatalf("signature = %q, want %q", parts["Signature"], want)
+	}
+}
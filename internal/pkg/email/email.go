@@ -8,12 +8,71 @@ import (
 )
 
 var (
-	ErrorTemplateNotConfigured = errors.InternalServer("EMAIL_TEMPLATE_NOT_CONFIGURED", "邮件模板未配置")
+	ErrorTemplateNotConfigured  = errors.InternalServer("EMAIL_TEMPLATE_NOT_CONFIGURED", "邮件模板未配置")
+	ErrorAttachmentNotSupported = errors.InternalServer("EMAIL_ATTACHMENT_NOT_SUPPORTED", "当前邮件供应商不支持附件")
+	ErrorProviderSendFailed     = errors.InternalServer("EMAIL_PROVIDER_SEND_FAILED", "邮件发送失败")
 )
 
-//go:embed templates/*.html
+// 模板按语言分目录存放：templates/<语言>/<模板名>.html 为 HTML 正文，同名 .txt 为纯文本正文
+//
+//go:embed templates
 var templateFS embed.FS
 
 type Sender interface {
 	Send(ctx context.Context, to, template string, params map[string]string) error
+	// SendMail 发送带附件、内嵌图片或指定语言的邮件
+	SendMail(ctx context.Context, m *Mail) error
+}
+
+// Provider 具体的投递方式，模板渲染由 templateSender 统一完成
+type Provider interface {
+	// Name 供应商标识，如 smtp、aliyun、webhook
+	Name() string
+	// Deliver 投递已渲染的邮件
+	Deliver(ctx context.Context, m *Message) error
+}
+
+// Message 渲染完成、待投递的邮件
+type Message struct {
+	From        string
+	To          string
+	Subject     string
+	HTML        string
+	Text        string // 未提供纯文本模板时为空
+	Locale      string
+	Template    string
+	Attachments []*Attachment
+	Inline      []*Attachment
+}
+
+// Mail 一封待发送的邮件
+type Mail struct {
+	To       string
+	Template string
+	Params   map[string]string
+	// Locales 收件人偏好的语言，按优先级排列；为空时取 Context 中的语言
+	Locales     []string
+	Attachments []*Attachment
+	// Inline 内嵌图片，HTML 中通过 <img src="cid:文件名"> 引用
+	Inline []*Attachment
+}
+
+// Attachment 附件或内嵌图片
+type Attachment struct {
+	Filename    string
+	ContentType string // 为空时按扩展名推断
+	Data        []byte
+}
+
+type localesKey struct{}
+
+// WithLocales 在 Context 中指定收件人偏好的语言
+func WithLocales(ctx context.Context, locales ...string) context.Context {
+	return context.WithValue(ctx, localesKey{}, locales)
+}
+
+// LocalesFromContext 获取 WithLocales 指定的语言
+func LocalesFromContext(ctx context.Context) []string {
+	locales, _ := ctx.Value(localesKey{}).([]string)
+	return locales
 }
@@ -20,3 +20,9 @@ func (m *MockSender) Send(ctx context.Context, target string, subject string, pa
 	m.log.Infof("mock send email: to=%s, subject=%s, params=%v", target, subject, params)
 	return nil
 }
+
+func (m *MockSender) SendMail(ctx context.Context, mail *Mail) error {
+	m.log.Infof("mock send email: to=%s, template=%s, locales=%v, params=%v, attachments=%d, inline=%d",
+		mail.To, mail.Template, resolveLocales(ctx, mail), mail.Params, len(mail.Attachments), len(mail.Inline))
+	return nil
+}
@@ -7,12 +7,24 @@ import (
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/env"
 )
 
-var ProviderSet = wire.NewSet(NewEmailSender)
+var ProviderSet = wire.NewSet(NewTemplates, NewEmailSender)
 
-func NewEmailSender(c *conf.Data, logger log.Logger) Sender {
+func NewEmailSender(c *conf.Data, templates *Templates, recorder Recorder, logger log.Logger) Sender {
 	if env.IsDev() {
 		return NewMockSender(logger)
 	}
-	// 默认使用 SMTP 实现
-	return NewSmtpSender(c.Email, logger)
+	// 根据配置选择投递方式，模板渲染共用
+	var provider Provider
+	switch c.Email.Provider {
+	case "aliyun":
+		provider = NewAliyunProvider(c.Email, logger)
+	case "webhook":
+		provider = NewWebhookProvider(c.Email.Webhook)
+	case "", "smtp":
+		// 默认使用 SMTP 实现
+		provider = NewSmtpProvider(c.Email.Smtp)
+	default:
+		log.NewHelper(logger).Fatalf("未知的邮件供应商: %s", c.Email.Provider)
+	}
+	return NewTemplateSender(c.Email, templates, provider, recorder, logger)
 }
@@ -0,0 +1,39 @@
+package email
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Attempt 一次邮件发送的记录
+type Attempt struct {
+	To       string
+	Template string
+	Provider string
+	Err      error // 发送失败原因（重试耗尽后）
+	Cost     time.Duration
+}
+
+// Recorder 记录邮件发送结果
+type Recorder interface {
+	Record(ctx context.Context, a *Attempt)
+}
+
+// RecorderFunc 函数适配器
+type RecorderFunc func(ctx context.Context, a *Attempt)
+
+func (f RecorderFunc) Record(ctx context.Context, a *Attempt) { f(ctx, a) }
+
+// MaskEmail 邮箱脱敏，如 alice@demo.com -> al***@demo.com
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return email
+	}
+	name := email[:at]
+	if len(name) > 2 {
+		name = name[:2]
+	}
+	return name + "***" + email[at:]
+}
@@ -0,0 +1,62 @@
+package email
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+// templateSender 渲染模板（数据库模板优先，内嵌模板兜底）后交由具体供应商投递，并记录发送结果
+type templateSender struct {
+	from      string
+	templates *Templates
+	provider  Provider
+	recorder  Recorder
+	log       *log.Helper
+}
+
+func NewTemplateSender(c *conf.Data_Email, templates *Templates, provider Provider, recorder Recorder, logger log.Logger) Sender {
+	return &templateSender{
+		from:      c.From,
+		templates: templates,
+		provider:  provider,
+		recorder:  recorder,
+		log:       log.NewHelper(logger),
+	}
+}
+
+func (s *templateSender) Send(ctx context.Context, target string, logicTemplate string, params map[string]string) error {
+	return s.SendMail(ctx, &Mail{To: target, Template: logicTemplate, Params: params})
+}
+
+func (s *templateSender) SendMail(ctx context.Context, mail *Mail) error {
+	c, err := s.templates.Render(mail.Template, resolveLocales(ctx, mail), mail.Params)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = s.provider.Deliver(ctx, &Message{
+		From:        s.from,
+		To:          mail.To,
+		Subject:     c.Subject,
+		HTML:        c.HTML,
+		Text:        c.Text,
+		Locale:      c.Locale,
+		Template:    mail.Template,
+		Attachments: mail.Attachments,
+		Inline:      mail.Inline,
+	})
+	if s.recorder != nil {
+		s.recorder.Record(ctx, &Attempt{
+			To:       mail.To,
+			Template: mail.Template,
+			Provider: s.provider.Name(),
+			Err:      err,
+			Cost:     time.Since(start),
+		})
+	}
+	return err
+}
@@ -0,0 +1,217 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/clientinfo"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/msgtpl"
+)
+
+const defaultLocale = "zh-CN"
+
+// 模板来源
+const (
+	SourceDatabase = "database"
+	SourceEmbedded = "embedded"
+)
+
+// Content 渲染后的邮件内容
+type Content struct {
+	Locale  string
+	Source  string // database / embedded
+	Subject string
+	HTML    string
+	Text    string // 未提供纯文本模板时为空
+}
+
+// Templates 邮件模板：数据库中维护的模板优先，内嵌模板兜底
+type Templates struct {
+	registry      *msgtpl.Registry
+	defaultLocale string
+	byLower       map[string]string                 // 小写语言标签 -> 目录名
+	html          map[string]*htmltemplate.Template // 语言目录名 -> 模板
+	text          map[string]*texttemplate.Template
+	subjects      map[string]map[string]string // 小写语言标签 -> 模板名 -> 标题
+	fallback      map[string]string            // 未按语言配置的标题
+}
+
+// NewTemplates 预编译所有语言的内嵌模板到内存池，模板名对应文件名，如 "zh-CN/bind_email.html"
+func NewTemplates(conf *conf.Data, registry *msgtpl.Registry) (*Templates, error) {
+	c := conf.Email
+	ts := &Templates{
+		registry:      registry,
+		defaultLocale: defaultLocale,
+		byLower:       make(map[string]string),
+		html:          make(map[string]*htmltemplate.Template),
+		text:          make(map[string]*texttemplate.Template),
+		subjects:      make(map[string]map[string]string),
+		fallback:      c.SubjectMapping,
+	}
+	if c.DefaultLocale != "" {
+		ts.defaultLocale = c.DefaultLocale
+	}
+	for locale, l := range c.Locales {
+		ts.subjects[strings.ToLower(locale)] = l.SubjectMapping
+	}
+
+	entries, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		dir := e.Name()
+		if matches, _ := fs.Glob(templateFS, path.Join("templates", dir, "*.html")); len(matches) > 0 {
+			t, err := htmltemplate.ParseFS(templateFS, matches...)
+			if err != nil {
+				return nil, fmt.Errorf("parse %s html templates: %w", dir, err)
+			}
+			ts.html[dir] = t
+		}
+		if matches, _ := fs.Glob(templateFS, path.Join("templates", dir, "*.txt")); len(matches) > 0 {
+			t, err := texttemplate.ParseFS(templateFS, matches...)
+			if err != nil {
+				return nil, fmt.Errorf("parse %s text templates: %w", dir, err)
+			}
+			ts.text[dir] = t
+		}
+		ts.byLower[strings.ToLower(dir)] = dir
+	}
+
+	if _, ok := ts.byLower[strings.ToLower(ts.defaultLocale)]; !ok {
+		return nil, fmt.Errorf("default locale %q has no templates", ts.defaultLocale)
+	}
+	return ts, nil
+}
+
+// Render 按语言回退链选择第一个存在该模板的语言进行渲染，同一语言下数据库模板优先
+func (ts *Templates) Render(name string, locales []string, params map[string]string) (*Content, error) {
+	for _, locale := range ts.candidates(locales, ts.registry.EmailLocales(name)) {
+		if t, ok := ts.registry.Email(name, locale); ok {
+			r, err := t.Render(params)
+			if err != nil {
+				return nil, err
+			}
+			c := &Content{Locale: t.Locale, Source: SourceDatabase, Subject: r.Subject, HTML: r.HTML, Text: r.Text}
+			if c.Subject == "" {
+				c.Subject = ts.subject(locale, name)
+			}
+			if c.Subject == "" {
+				return nil, ErrorTemplateNotConfigured
+			}
+			return c, nil
+		}
+
+		html := ts.html[locale]
+		if html == nil || html.Lookup(name+".html") == nil {
+			continue
+		}
+		subject := ts.subject(locale, name)
+		if subject == "" {
+			return nil, ErrorTemplateNotConfigured
+		}
+
+		c := &Content{Locale: locale, Source: SourceEmbedded, Subject: subject}
+		var buf bytes.Buffer
+		if err := html.ExecuteTemplate(&buf, name+".html", params); err != nil {
+			return nil, err
+		}
+		c.HTML = buf.String()
+
+		if text := ts.text[locale]; text != nil && text.Lookup(name+".txt") != nil {
+			buf.Reset()
+			if err := text.ExecuteTemplate(&buf, name+".txt", params); err != nil {
+				return nil, err
+			}
+			c.Text = buf.String()
+		}
+		return c, nil
+	}
+	return nil, ErrorTemplateNotConfigured
+}
+
+// candidates 语言回退链：依次尝试每个偏好语言本身、去掉末尾子标签后的语言（zh-Hant-TW -> zh-Hant -> zh）、
+// 同一主语言下的其他语言（zh -> zh-CN），最后为默认语言；extra 为数据库模板额外提供的语言
+func (ts *Templates) candidates(locales []string, extra []string) []string {
+	available := make(map[string]string, len(ts.byLower)+len(extra))
+	for lower, dir := range ts.byLower {
+		available[lower] = dir
+	}
+	for _, locale := range extra {
+		if _, ok := available[strings.ToLower(locale)]; !ok {
+			available[strings.ToLower(locale)] = locale
+		}
+	}
+	sorted := make([]string, 0, len(available))
+	for _, locale := range available {
+		sorted = append(sorted, locale)
+	}
+	sort.Strings(sorted)
+
+	var (
+		result []string
+		seen   = make(map[string]bool)
+	)
+	add := func(locale string) {
+		if !seen[locale] {
+			seen[locale] = true
+			result = append(result, locale)
+		}
+	}
+
+	for _, locale := range locales {
+		tag := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+		for tag != "" {
+			if l, ok := available[tag]; ok {
+				add(l)
+			}
+			i := strings.LastIndex(tag, "-")
+			if i < 0 {
+				break
+			}
+			tag = tag[:i]
+		}
+		for _, l := range sorted {
+			if strings.HasPrefix(strings.ToLower(l), tag+"-") {
+				add(l)
+			}
+		}
+	}
+	add(ts.byLower[strings.ToLower(ts.defaultLocale)])
+	return result
+}
+
+func (ts *Templates) subject(locale, name string) string {
+	if subject := ts.subjects[strings.ToLower(locale)][name]; subject != "" {
+		return subject
+	}
+	return ts.fallback[name]
+}
+
+// resolveLocales 收件人偏好语言：邮件中显式指定 > PreferredLocales
+func resolveLocales(ctx context.Context, m *Mail) []string {
+	if len(m.Locales) > 0 {
+		return m.Locales
+	}
+	return PreferredLocales(ctx)
+}
+
+// PreferredLocales Context 指定的语言，未指定时取当前请求的 Accept-Language
+// 异步发送时需在入队前调用，后台协程的 Context 中没有请求信息
+func PreferredLocales(ctx context.Context) []string {
+	if locales := LocalesFromContext(ctx); len(locales) > 0 {
+		return locales
+	}
+	return clientinfo.Languages(ctx)
+}
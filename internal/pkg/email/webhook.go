@@ -0,0 +1,132 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+const (
+	webhookDefaultTimeout = 10 * time.Second
+	// 错误响应最多读取的字节数
+	webhookMaxErrorBody = 1024
+
+	HeaderWebhookTimestamp = "X-Timestamp"
+	HeaderWebhookSignature = "X-Signature"
+)
+
+// webhookProvider 以 JSON 推送已渲染的邮件，由接收方完成投递，2xx 视为成功
+type webhookProvider struct {
+	conf   *conf.Data_Email_Webhook
+	client *http.Client
+}
+
+// webhookRequest 推送的请求体
+type webhookRequest struct {
+	From        string               `json:"from"`
+	To          string               `json:"to"`
+	Subject     string               `json:"subject"`
+	HTML        string               `json:"html"`
+	Text        string               `json:"text,omitempty"`
+	Locale      string               `json:"locale"`
+	Template    string               `json:"template"`
+	Attachments []*webhookAttachment `json:"attachments,omitempty"`
+	Inline      []*webhookAttachment `json:"inline,omitempty"`
+}
+
+type webhookAttachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type,omitempty"`
+	Content     []byte `json:"content"` // Base64
+}
+
+func NewWebhookProvider(c *conf.Data_Email_Webhook) Provider {
+	if c == nil || c.Url == "" {
+		panic("邮件 Webhook 未配置")
+	}
+	timeout := webhookDefaultTimeout
+	if c.Timeout != nil && c.Timeout.AsDuration() > 0 {
+		timeout = c.Timeout.AsDuration()
+	}
+	return &webhookProvider{
+		conf:   c,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *webhookProvider) Name() string {
+	return "webhook"
+}
+
+func (p *webhookProvider) Deliver(ctx context.Context, msg *Message) error {
+	body, err := json.Marshal(&webhookRequest{
+		From:        msg.From,
+		To:          msg.To,
+		Subject:     msg.Subject,
+		HTML:        msg.HTML,
+		Text:        msg.Text,
+		Locale:      msg.Locale,
+		Template:    msg.Template,
+		Attachments: toWebhookAttachments(msg.Attachments),
+		Inline:      toWebhookAttachments(msg.Inline),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.conf.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range p.conf.Headers {
+		req.Header.Set(k, v)
+	}
+	if p.conf.Secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(HeaderWebhookTimestamp, ts)
+		req.Header.Set(HeaderWebhookSignature, SignWebhook(p.conf.Secret, ts, body))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return ErrorProviderSendFailed.WithCause(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, webhookMaxErrorBody))
+		return ErrorProviderSendFailed.WithCause(fmt.Errorf("webhook status %d: %s", resp.StatusCode, detail))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// SignWebhook 签名算法：hex(HMAC-SHA256(secret, 时间戳 + "." + 请求体))，接收方按同样方式校验
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func toWebhookAttachments(list []*Attachment) []*webhookAttachment {
+	if len(list) == 0 {
+		return nil
+	}
+	result := make([]*webhookAttachment, 0, len(list))
+	for _, a := range list {
+		result = append(result, &webhookAttachment{Filename: a.Filename, ContentType: a.ContentType, Content: a.Data})
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+func TestWebhookProvider_Deliver(t *testing.T) {
+	msg := &Message{
+		From:        "noreply@example.com",
+		To:          "user@example.com",
+		Subject:     "验证码",
+		HTML:        "<p>123456</p>",
+		Text:        "123456",
+		Locale:      "zh-CN",
+		Template:    "bind_email",
+		Attachments: []*Attachment{{Filename: "a.txt", ContentType: "text/plain", Data: []byte("hello")}},
+	}
+
+	tests := []struct {
+		name    string
+		secret  string
+		headers map[string]string
+		status  int
+		wantErr bool
+	}{
+		{name: "signed", secret: "s3cret", headers: map[string]string{"Authorization": "Bearer token"}, status: http.StatusOK},
+		{name: "unsigned", status: http.StatusAccepted},
+		{name: "server error", secret: "s3cret", status: http.StatusBadGateway, wantErr: true},
+		{name: "client error", status: http.StatusBadRequest, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				got    webhookRequest
+				header http.Header
+				body   []byte
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("method = %s, want POST", r.Method)
+				}
+				header = r.Header.Clone()
+				body, _ = io.ReadAll(r.Body)
+				_ = json.Unmarshal(body, &got)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte("detail"))
+			}))
+			defer srv.Close()
+
+			p := NewWebhookProvider(&conf.Data_Email_Webhook{Url: srv.URL, Secret: tt.secret, Headers: tt.headers})
+			err := p.Deliver(context.Background(), msg)
+			if tt.wantErr {
+				if !errors.Is(err, ErrorProviderSendFailed) {
+					t.Fatalf("err = %v, want ErrorProviderSendFailed", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Deliver: %v", err)
+			}
+
+			if ct := header.Get("Content-Type"); ct != "application/json" {
+				t.Errorf("Content-Type = %q", ct)
+			}
+			for k, v := range tt.headers {
+				if header.Get(k) != v {
+					t.Errorf("header %s = %q, want %q", k, header.Get(k), v)
+				}
+			}
+			if got.To != msg.To || got.Subject != msg.Subject || got.HTML != msg.HTML || got.Text != msg.Text ||
+				got.Template != msg.Template || got.Locale != msg.Locale {
+				t.Errorf("request = %+v", got)
+			}
+			if len(got.Attachments) != 1 || string(got.Attachments[0].Content) != "hello" {
+				t.Errorf("attachments = %+v", got.Attachments)
+			}
+
+			ts, sig := header.Get(HeaderWebhookTimestamp), header.Get(HeaderWebhookSignature)
+			if tt.secret == "" {
+				if ts != "" || sig != "" {
+					t.Errorf("unsigned request carries %s=%q %s=%q", HeaderWebhookTimestamp, ts, HeaderWebhookSignature, sig)
+				}
+				return
+			}
+			sec, err := strconv.ParseInt(ts, 10, 64)
+			if err != nil || time.Since(time.Unix(sec, 0)).Abs() > time.Minute {
+				t.Errorf("%s = %q", HeaderWebhookTimestamp, ts)
+			}
+			if want := SignWebhook(tt.secret, ts, body); sig != want {
+				t.Errorf("%s = %q, want %q", HeaderWebhookSignature, sig, want)
+			}
+		})
+	}
+}
+
+func TestSignWebhook(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		body      string
+		want      string
+	}{
+		{
+			name:      "reference",
+			secret:    "key",
+			timestamp: "1700000000",
+			body:      `{"to":"user@example.com"}`,
+			want:      "7d761beff6baffda719d5ce474343609862ab6b0567f159c34ee63e23350234c",
+		},
+		{
+			name:      "empty body",
+			secret:    "key",
+			timestamp: "1700000000",
+			body:      "",
+			want:      "0f1cc1f811f42fd12af9618acf321769899fa521fe07a642f70a61785e130770",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SignWebhook(tt.secret, tt.timestamp, []byte(tt.body))
+			if got != tt.want {
+				t.Errorf("SignWebhook = %s, want %s", got, tt.want)
+			}
+			// 密钥、时间戳或请求体任一变化，签名随之变化
+			if SignWebhook(tt.secret, tt.timestamp+"1", []byte(tt.body)) == got ||
+				SignWebhook(tt.secret, tt.timestamp, []byte(tt.body+" ")) == got ||
+				SignWebhook(tt.secret+"x", tt.timestamp, []byte(tt.body)) == got {
+				t.Error("signature does not cover secret, timestamp and body")
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Package msgtpl 数据库中维护的短信、邮件模板，编译后整体替换，供发送时优先使用
+package msgtpl
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"sync/atomic"
+	texttemplate "text/template"
+)
+
+const (
+	ChannelSms   = "sms"
+	ChannelEmail = "email"
+
+	// LocaleIntl 短信模板的语言取值：空为大陆号码，intl 为国际/港澳台号码
+	LocaleIntl = "intl"
+)
+
+// Template 一条模板记录
+type Template struct {
+	Channel    string
+	Name       string // 逻辑模板名，如 bind_email
+	Locale     string
+	Provider   string // 短信供应商，为空时对所有供应商生效
+	Subject    string
+	Body       string // 邮件为 HTML 正文；短信为展示用的正文
+	TextBody   string // 邮件纯文本正文，可为空
+	VendorCode string // 短信供应商模板编号
+	Version    int64
+}
+
+// Compiled 编译后的邮件模板
+type Compiled struct {
+	*Template
+	subject *texttemplate.Template
+	html    *htmltemplate.Template
+	text    *texttemplate.Template
+}
+
+// Rendered 渲染结果
+type Rendered struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Compile 编译模板，用于保存前校验语法
+func Compile(t *Template) (*Compiled, error) {
+	c := &Compiled{Template: t}
+	var err error
+	if c.subject, err = texttemplate.New("subject").Option("missingkey=zero").Parse(t.Subject); err != nil {
+		return nil, fmt.Errorf("subject: %w", err)
+	}
+	if t.Channel == ChannelEmail {
+		if c.html, err = htmltemplate.New("html").Option("missingkey=zero").Parse(t.Body); err != nil {
+			return nil, fmt.Errorf("body: %w", err)
+		}
+	} else if c.text, err = texttemplate.New("body").Option("missingkey=zero").Parse(t.Body); err != nil {
+		return nil, fmt.Errorf("body: %w", err)
+	}
+	if t.Channel == ChannelEmail && t.TextBody != "" {
+		if c.text, err = texttemplate.New("text").Option("missingkey=zero").Parse(t.TextBody); err != nil {
+			return nil, fmt.Errorf("text_body: %w", err)
+		}
+	}
+	return c, nil
+}
+
+// Render 渲染模板；短信的正文渲染到 Text
+func (c *Compiled) Render(params map[string]string) (*Rendered, error) {
+	r := &Rendered{}
+	var buf bytes.Buffer
+	if err := c.subject.Execute(&buf, params); err != nil {
+		return nil, err
+	}
+	r.Subject = buf.String()
+	if c.html != nil {
+		buf.Reset()
+		if err := c.html.Execute(&buf, params); err != nil {
+			return nil, err
+		}
+		r.HTML = buf.String()
+	}
+	if c.text != nil {
+		buf.Reset()
+		if err := c.text.Execute(&buf, params); err != nil {
+			return nil, err
+		}
+		r.Text = buf.String()
+	}
+	return r, nil
+}
+
+type snapshot struct {
+	email   map[string]map[string]*Compiled // 模板名 -> 小写语言 -> 模板
+	sms     map[string]*Template            // key: 供应商/模板名/语言
+	version int64
+}
+
+// Registry 模板缓存，Replace 时整体替换，读取无锁
+type Registry struct {
+	current atomic.Pointer[snapshot]
+}
+
+func NewRegistry() *Registry {
+	r := &Registry{}
+	r.current.Store(&snapshot{})
+	return r
+}
+
+// Replace 编译并替换全部模板，任一模板编译失败时保留原有缓存
+func (r *Registry) Replace(list []*Template, version int64) error {
+	s := &snapshot{
+		email:   make(map[string]map[string]*Compiled),
+		sms:     make(map[string]*Template),
+		version: version,
+	}
+	for _, t := range list {
+		switch t.Channel {
+		case ChannelEmail:
+			c, err := Compile(t)
+			if err != nil {
+				return fmt.Errorf("template %s/%s: %w", t.Name, t.Locale, err)
+			}
+			if s.email[t.Name] == nil {
+				s.email[t.Name] = make(map[string]*Compiled)
+			}
+			s.email[t.Name][strings.ToLower(t.Locale)] = c
+		case ChannelSms:
+			s.sms[smsKey(t.Provider, t.Name, t.Locale)] = t
+		}
+	}
+	r.current.Store(s)
+	return nil
+}
+
+// Version 当前缓存对应的模板版本
+func (r *Registry) Version() int64 {
+	return r.current.Load().version
+}
+
+// Email 查找邮件模板，locale 不区分大小写
+func (r *Registry) Email(name, locale string) (*Compiled, bool) {
+	c, ok := r.current.Load().email[name][strings.ToLower(locale)]
+	return c, ok
+}
+
+// EmailLocales 邮件模板已配置的语言
+func (r *Registry) EmailLocales(name string) []string {
+	m := r.current.Load().email[name]
+	locales := make([]string, 0, len(m))
+	for _, c := range m {
+		locales = append(locales, c.Locale)
+	}
+	return locales
+}
+
+// Sms 查找短信模板，优先匹配指定供应商，其次匹配对所有供应商生效的模板
+func (r *Registry) Sms(provider, name string, intl bool) (*Template, bool) {
+	locale := ""
+	if intl {
+		locale = LocaleIntl
+	}
+	s := r.current.Load()
+	for _, p := range []string{provider, ""} {
+		if t, ok := s.sms[smsKey(p, name, locale)]; ok && t.VendorCode != "" {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// SmsVendorCode 查找短信供应商模板编号
+func (r *Registry) SmsVendorCode(provider, name string, intl bool) (string, bool) {
+	if t, ok := r.Sms(provider, name, intl); ok {
+		return t.VendorCode, true
+	}
+	return "", false
+}
+
+func smsKey(provider, name, locale string) string {
+	return provider + "/" + name + "/" + locale
+}
@@ -0,0 +1,128 @@
+package phone
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/wire"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+var (
+	ErrPhoneInvalid       = errors.BadRequest("PHONE_INVALID", "手机号格式错误")
+	ErrRegionNotSupported = errors.BadRequest("PHONE_REGION_NOT_SUPPORTED", "暂不支持该国家或地区的手机号")
+)
+
+const (
+	defaultRegionCode    = "CN"
+	maxCallingCodeLength = 3
+)
+
+var (
+	// 未配置时仅支持中国大陆号码，与原有校验规则一致
+	defaultRegionConfig = &conf.App_Phone_Region{CallingCode: "86", Pattern: `^1[3-9]\d{9}$`}
+	// 用户输入中常见的分隔符
+	formattingCharReplacer = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+)
+
+var ProviderSet = wire.NewSet(NewNormalizer)
+
+type region struct {
+	code        string
+	callingCode string
+	trunkPrefix string
+	pattern     *regexp.Regexp
+}
+
+// Normalizer 将用户输入的手机号解析并规范化为 E.164 格式，如 +8613812345678
+type Normalizer struct {
+	defaultRegion *region
+	// 国际区号 -> 地区规则，同一区号可能对应多个地区（如 +1 美国/加拿大）
+	regions map[string][]*region
+}
+
+func NewNormalizer(c *conf.App) (*Normalizer, error) {
+	defaultCode := defaultRegionCode
+	regions := map[string]*conf.App_Phone_Region{defaultRegionCode: defaultRegionConfig}
+	if c.Phone != nil {
+		if c.Phone.DefaultRegion != "" {
+			defaultCode = strings.ToUpper(c.Phone.DefaultRegion)
+		}
+		if len(c.Phone.Regions) > 0 {
+			regions = c.Phone.Regions
+		}
+	}
+
+	n := &Normalizer{regions: make(map[string][]*region)}
+	for code, rc := range regions {
+		callingCode := strings.TrimPrefix(rc.CallingCode, "+")
+		if callingCode == "" || len(callingCode) > maxCallingCodeLength {
+			return nil, fmt.Errorf("phone region %s: invalid calling code %q", code, rc.CallingCode)
+		}
+		pattern, err := regexp.Compile(rc.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("phone region %s: invalid pattern: %w", code, err)
+		}
+		r := &region{
+			code:        strings.ToUpper(code),
+			callingCode: callingCode,
+			trunkPrefix: rc.TrunkPrefix,
+			pattern:     pattern,
+		}
+		n.regions[callingCode] = append(n.regions[callingCode], r)
+		if r.code == defaultCode {
+			n.defaultRegion = r
+		}
+	}
+	if n.defaultRegion == nil {
+		return nil, fmt.Errorf("phone default region %s not configured", defaultCode)
+	}
+	return n, nil
+}
+
+// Normalize 规范化手机号
+// countryCode 为国际区号（如 86 或 +86），为空时：号码以 + 或 00 开头则按国际格式解析，否则使用默认地区
+func (n *Normalizer) Normalize(countryCode, number string) (string, error) {
+	number = formattingCharReplacer.Replace(strings.TrimSpace(number))
+	if strings.HasPrefix(number, "00") {
+		number = "+" + number[2:]
+	}
+	if strings.HasPrefix(number, "+") {
+		return n.parseInternational(number[1:])
+	}
+
+	callingCode := strings.TrimPrefix(formattingCharReplacer.Replace(strings.TrimSpace(countryCode)), "+")
+	if callingCode == "" {
+		callingCode = n.defaultRegion.callingCode
+	}
+	return n.format(callingCode, number)
+}
+
+// parseInternational 国际区号满足前缀码性质，按长度从短到长匹配即可
+func (n *Normalizer) parseInternational(digits string) (string, error) {
+	for l := 1; l <= maxCallingCodeLength && l < len(digits); l++ {
+		if _, ok := n.regions[digits[:l]]; ok {
+			return n.format(digits[:l], digits[l:])
+		}
+	}
+	return "", ErrRegionNotSupported
+}
+
+func (n *Normalizer) format(callingCode, national string) (string, error) {
+	regions, ok := n.regions[callingCode]
+	if !ok {
+		return "", ErrRegionNotSupported
+	}
+	for _, r := range regions {
+		nat := national
+		if r.trunkPrefix != "" {
+			nat = strings.TrimPrefix(nat, r.trunkPrefix)
+		}
+		if r.pattern.MatchString(nat) {
+			return "+" + callingCode + nat, nil
+		}
+	}
+	return "", ErrPhoneInvalid
+}
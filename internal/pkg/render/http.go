@@ -105,6 +105,12 @@ func ErrorEncoder(w http.ResponseWriter, r *http.Request, err error) {
 		Message: msg,
 		Data:    nil, // 错误时 data 为空
 	}
+	// 携带 metadata 的错误（如 CAPTCHA_REQUIRED）需要告知客户端下一步操作，放入 data 返回
+	if len(se.Metadata) > 0 {
+		if b, err := json.Marshal(se.Metadata); err == nil {
+			res.Data = b
+		}
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	if errors.Is(se, auth.ErrInvalidToken) || errors.Is(se, auth.ErrTokenExpired) {
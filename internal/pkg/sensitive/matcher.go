@@ -0,0 +1,138 @@
+package sensitive
+
+// node Aho-Corasick 自动机节点
+type node struct {
+	next  map[rune]int32
+	fail  int32
+	out   int32 // 以该节点结尾的词条下标，-1 表示无
+	dict  int32 // 沿失败链最近的有输出的节点，-1 表示无
+	depth int32
+}
+
+// Matcher 基于 Aho-Corasick 自动机的多模式匹配器，构建后只读，可并发使用
+type Matcher struct {
+	nodes []node
+	words []Word
+}
+
+// NewMatcher 构建匹配器，词条及其变体按归一化后的形式匹配；
+// 归一化后相同的词条保留动作级别更高的一个
+func NewMatcher(words []Word) *Matcher {
+	m := &Matcher{nodes: []node{newNode(0)}}
+	for _, w := range words {
+		m.insert(w.Text, w)
+		for _, v := range w.Variants {
+			m.insert(v, w)
+		}
+	}
+	m.build()
+	return m
+}
+
+// Len 词条数（不含变体）
+func (m *Matcher) Len() int {
+	return len(m.words)
+}
+
+func newNode(depth int32) node {
+	return node{next: make(map[rune]int32), out: -1, dict: -1, depth: depth}
+}
+
+func (m *Matcher) insert(pattern string, w Word) {
+	runes, _ := normalize(pattern)
+	if len(runes) == 0 {
+		return
+	}
+	cur := int32(0)
+	for _, r := range runes {
+		nx, ok := m.nodes[cur].next[r]
+		if !ok {
+			nx = int32(len(m.nodes))
+			m.nodes = append(m.nodes, newNode(m.nodes[cur].depth+1))
+			m.nodes[cur].next[r] = nx
+		}
+		cur = nx
+	}
+	if out := m.nodes[cur].out; out >= 0 {
+		if w.Action > m.words[out].Action {
+			m.words[out] = w
+		}
+		return
+	}
+	// 同一词条的多个变体共用一个下标
+	idx := int32(len(m.words))
+	if n := len(m.words); n > 0 && m.words[n-1].Text == w.Text {
+		idx = int32(n - 1)
+	} else {
+		m.words = append(m.words, w)
+	}
+	m.nodes[cur].out = idx
+}
+
+// build 广度优先计算失败指针与输出链
+func (m *Matcher) build() {
+	queue := make([]int32, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		m.nodes[child].fail = 0
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for {
+				if nx, ok := m.nodes[f].next[r]; ok && nx != child {
+					m.nodes[child].fail = nx
+					break
+				}
+				if f == 0 {
+					m.nodes[child].fail = 0
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			fail := m.nodes[child].fail
+			if m.nodes[fail].out >= 0 {
+				m.nodes[child].dict = fail
+			} else {
+				m.nodes[child].dict = m.nodes[fail].dict
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Match 返回文本中所有命中，区间为原文的 rune 下标，可能相互重叠
+func (m *Matcher) Match(text string) []Hit {
+	if len(m.words) == 0 {
+		return nil
+	}
+	runes, pos := normalize(text)
+	var hits []Hit
+	cur := int32(0)
+	for i, r := range runes {
+		for {
+			if nx, ok := m.nodes[cur].next[r]; ok {
+				cur = nx
+				break
+			}
+			if cur == 0 {
+				break
+			}
+			cur = m.nodes[cur].fail
+		}
+		for n := cur; n > 0; n = m.nodes[n].dict {
+			if out := m.nodes[n].out; out >= 0 {
+				w := m.words[out]
+				hits = append(hits, Hit{
+					Word:   w.Text,
+					Action: w.Action,
+					Start:  pos[i-int(m.nodes[n].depth)+1],
+					End:    pos[i] + 1,
+				})
+			}
+		}
+	}
+	return hits
+}
@@ -0,0 +1,50 @@
+package sensitive
+
+import (
+	"unicode"
+)
+
+// homoglyphs 形近字符，统一映射为拉丁字母后再匹配
+var homoglyphs = map[rune]rune{
+	// 西里尔字母
+	'а': 'a', 'в': 'b', 'е': 'e', 'ё': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o',
+	'р': 'p', 'с': 'c', 'т': 't', 'у': 'y', 'х': 'x', 'і': 'i', 'ј': 'j', 'ѕ': 's',
+	// 希腊字母
+	'α': 'a', 'β': 'b', 'ε': 'e', 'η': 'n', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o',
+	'ρ': 'p', 'τ': 't', 'υ': 'u', 'χ': 'x',
+	// 常见替代符号
+	'@': 'a', '$': 's',
+}
+
+// normalizeRune 归一化单个字符：全角转半角、转小写、形近字符映射；
+// 返回 false 表示该字符为空白、标点、符号等干扰字符，匹配时跳过
+func normalizeRune(r rune) (rune, bool) {
+	switch {
+	case r == '　':
+		return 0, false
+	case r >= '！' && r <= '～':
+		r -= 0xfee0
+	}
+	r = unicode.ToLower(r)
+	if h, ok := homoglyphs[r]; ok {
+		r = h
+	}
+	if unicode.IsLetter(r) || unicode.IsNumber(r) {
+		return r, true
+	}
+	return 0, false
+}
+
+// normalize 归一化文本，返回归一化后的字符及其在原文中的 rune 下标
+func normalize(text string) ([]rune, []int) {
+	runes := []rune(text)
+	out := make([]rune, 0, len(runes))
+	pos := make([]int, 0, len(runes))
+	for i, r := range runes {
+		if n, ok := normalizeRune(r); ok {
+			out = append(out, n)
+			pos = append(pos, i)
+		}
+	}
+	return out, pos
+}
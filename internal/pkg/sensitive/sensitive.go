@@ -0,0 +1,132 @@
+// Package sensitive 敏感词过滤：Aho-Corasick 多模式匹配，匹配前对文本做归一化
+// （全角转半角、大小写、形近字符、跳过空白和标点等干扰字符），词条可附带拼音、谐音等变体。
+// 词库可从文件或数据库加载，通过 Filter.Load 原子替换，替换过程中的检查不受影响。
+package sensitive
+
+import (
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+// Action 命中后的处理方式，数值越大级别越高
+type Action int
+
+const (
+	ActionNone   Action = iota
+	ActionMask          // 替换为掩码后放行
+	ActionReview        // 放行并标记待人工审核
+	ActionReject        // 拒绝
+)
+
+var actionNames = map[Action]string{
+	ActionNone:   "none",
+	ActionMask:   "mask",
+	ActionReview: "review",
+	ActionReject: "reject",
+}
+
+func (a Action) String() string {
+	if s, ok := actionNames[a]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// ParseAction 解析动作名称：mask、review、reject
+func ParseAction(s string) (Action, bool) {
+	for a, name := range actionNames {
+		if a != ActionNone && strings.EqualFold(strings.TrimSpace(s), name) {
+			return a, true
+		}
+	}
+	return ActionNone, false
+}
+
+// Word 词条
+type Word struct {
+	Text     string
+	Action   Action
+	Variants []string // 拼音、谐音等变体，命中时按该词条处理
+}
+
+// Hit 一次命中
+type Hit struct {
+	Word   string // 词库中的词条
+	Action Action
+	Start  int // 原文中的 rune 下标，含
+	End    int // 原文中的 rune 下标，不含
+}
+
+// Result 检查结果
+type Result struct {
+	Text   string // 动作为 mask 的命中部分替换为掩码后的文本
+	Action Action // 命中中级别最高的动作，未命中为 ActionNone
+	Hits   []Hit
+}
+
+// Words 命中的词条，去重并保持首次出现的顺序
+func (r *Result) Words() []string {
+	seen := make(map[string]struct{}, len(r.Hits))
+	words := make([]string, 0, len(r.Hits))
+	for _, h := range r.Hits {
+		if _, ok := seen[h.Word]; !ok {
+			seen[h.Word] = struct{}{}
+			words = append(words, h.Word)
+		}
+	}
+	return words
+}
+
+const defaultMask = '*'
+
+// Filter 敏感词过滤器，词库可热替换，并发安全
+type Filter struct {
+	matcher atomic.Pointer[Matcher]
+	mask    rune
+}
+
+// NewFilter 创建空词库的过滤器，mask 为空时使用 *
+func NewFilter(mask string) *Filter {
+	f := &Filter{mask: defaultMask}
+	if r := []rune(mask); len(r) > 0 {
+		f.mask = r[0]
+	}
+	f.matcher.Store(NewMatcher(nil))
+	return f
+}
+
+// Load 构建新词库并原子替换
+func (f *Filter) Load(words []Word) {
+	f.matcher.Store(NewMatcher(words))
+}
+
+// Len 当前词库的词条数
+func (f *Filter) Len() int {
+	return f.matcher.Load().Len()
+}
+
+// Check 检查文本，动作为 mask 的命中部分（含其中夹杂的干扰字符）替换为掩码；
+// review、reject 的命中保留原文，由调用方按 Action 处理
+func (f *Filter) Check(text string) *Result {
+	hits := f.matcher.Load().Match(text)
+	result := &Result{Text: text, Hits: hits}
+	if len(hits) == 0 {
+		return result
+	}
+	sort.Slice(hits, func(i, j int) bool { return hits[i].Start < hits[j].Start })
+	runes := []rune(text)
+	for _, h := range hits {
+		if h.Action > result.Action {
+			result.Action = h.Action
+		}
+		if h.Action != ActionMask {
+			continue
+		}
+		for i := h.Start; i < h.End; i++ {
+			runes[i] = f.mask
+		}
+	}
+	result.Text = string(runes)
+	return result
+}
@@ -0,0 +1,123 @@
+package sensitive
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantPos []int
+	}{
+		{name: "fullwidth and case", text: "ＡＢｃ", want: "abc", wantPos: []int{0, 1, 2}},
+		{name: "homoglyphs", text: "сасh$", want: "cachs", wantPos: []int{0, 1, 2, 3, 4}},
+		{name: "skip noise", text: "a b，c　d!", want: "abcd", wantPos: []int{0, 2, 4, 6}},
+		{name: "cjk kept", text: "赌-博", want: "赌博", wantPos: []int{0, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runes, pos := normalize(tt.text)
+			if string(runes) != tt.want || !reflect.DeepEqual(pos, tt.wantPos) {
+				t.Errorf("normalize(%q) = %q %v, want %q %v", tt.text, string(runes), pos, tt.want, tt.wantPos)
+			}
+		})
+	}
+}
+
+func TestMatcher_Match(t *testing.T) {
+	m := NewMatcher([]Word{
+		{Text: "he", Action: ActionMask},
+		{Text: "she", Action: ActionReview},
+		{Text: "hers", Action: ActionReject},
+		{Text: "赌博", Action: ActionReject, Variants: []string{"dubo"}},
+		{Text: "HE", Action: ActionReview}, // 归一化后与 he 相同，保留级别更高的动作
+	})
+	if m.Len() != 4 {
+		t.Fatalf("Len = %d, want 4", m.Len())
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []Hit
+	}{
+		{name: "no hit", text: "world", want: nil},
+		{
+			name: "overlapping",
+			text: "ushers",
+			want: []Hit{
+				{Word: "she", Action: ActionReview, Start: 1, End: 4},
+				{Word: "HE", Action: ActionReview, Start: 2, End: 4},
+				{Word: "hers", Action: ActionReject, Start: 2, End: 6},
+			},
+		},
+		{name: "noise inside word", text: "来赌 .博吧", want: []Hit{{Word: "赌博", Action: ActionReject, Start: 1, End: 5}}},
+		{name: "variant", text: "DU-BO", want: []Hit{{Word: "赌博", Action: ActionReject, Start: 0, End: 5}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Match(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Match(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+
+	if hits := NewMatcher(nil).Match("anything"); hits != nil {
+		t.Errorf("empty matcher hits = %+v", hits)
+	}
+}
+
+func TestFilter_Check(t *testing.T) {
+	f := NewFilter("#")
+	f.Load([]Word{
+		{Text: "fool", Action: ActionMask},
+		{Text: "scam", Action: ActionReview},
+		{Text: "bomb", Action: ActionReject},
+	})
+
+	tests := []struct {
+		name       string
+		text       string
+		wantText   string
+		wantAction Action
+		wantWords  []string
+	}{
+		{name: "clean", text: "hello", wantText: "hello", wantAction: ActionNone, wantWords: []string{}},
+		{name: "mask", text: "you f.o.o.l", wantText: "you #######", wantAction: ActionMask, wantWords: []string{"fool"}},
+		// review 词条保留原文待审核，同时命中的 mask 词条仍需掩码
+		{name: "mask and review", text: "fool scam fool", wantText: "#### scam ####", wantAction: ActionReview, wantWords: []string{"fool", "scam"}},
+		{name: "reject wins", text: "scam bomb", wantText: "scam bomb", wantAction: ActionReject, wantWords: []string{"scam", "bomb"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := f.Check(tt.text)
+			if r.Text != tt.wantText || r.Action != tt.wantAction || !reflect.DeepEqual(r.Words(), tt.wantWords) {
+				t.Errorf("Check(%q) = %q %s %v, want %q %s %v",
+					tt.text, r.Text, r.Action, r.Words(), tt.wantText, tt.wantAction, tt.wantWords)
+			}
+		})
+	}
+}
+
+func TestParseWords(t *testing.T) {
+	words, err := ParseWords(strings.NewReader("# comment\n\nfool\nscam|review\n赌博|reject|dubo,du bo\n"), ActionMask)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Word{
+		{Text: "fool", Action: ActionMask},
+		{Text: "scam", Action: ActionReview},
+		{Text: "赌博", Action: ActionReject, Variants: []string{"dubo", "du bo"}},
+	}
+	if !reflect.DeepEqual(words, want) {
+		t.Errorf("ParseWords = %+v, want %+v", words, want)
+	}
+
+	if _, err := ParseWords(strings.NewReader("fool|block"), ActionMask); err == nil {
+		t.Error("unknown action accepted")
+	}
+}
@@ -0,0 +1,107 @@
+package sensitive
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Source 词库来源
+type Source interface {
+	Load(ctx context.Context) ([]Word, error)
+}
+
+// SourceFunc 函数形式的词库来源
+type SourceFunc func(ctx context.Context) ([]Word, error)
+
+func (f SourceFunc) Load(ctx context.Context) ([]Word, error) {
+	return f(ctx)
+}
+
+// FileSource 从文本文件加载词库，格式见 ParseWords
+type FileSource struct {
+	paths         []string
+	defaultAction Action
+}
+
+// NewFileSource 未指定动作的词条使用 defaultAction
+func NewFileSource(defaultAction Action, paths ...string) *FileSource {
+	return &FileSource{paths: paths, defaultAction: defaultAction}
+}
+
+func (s *FileSource) Load(_ context.Context) ([]Word, error) {
+	var words []Word
+	for _, path := range s.paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		list, err := ParseWords(f, s.defaultAction)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		words = append(words, list...)
+	}
+	return words, nil
+}
+
+// ParseWords 解析词库文本，每行一个词条：
+//
+//	词条[|动作[|变体1,变体2]]
+//
+// 动作为 mask、review、reject，省略时使用 defaultAction；空行及 # 开头的行忽略
+func ParseWords(r io.Reader, defaultAction Action) ([]Word, error) {
+	var words []Word
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		parts := strings.Split(text, "|")
+		w := Word{Text: strings.TrimSpace(parts[0]), Action: defaultAction}
+		if w.Text == "" {
+			continue
+		}
+		if len(parts) > 1 && strings.TrimSpace(parts[1]) != "" {
+			a, ok := ParseAction(parts[1])
+			if !ok {
+				return nil, fmt.Errorf("line %d: unknown action %q", line, parts[1])
+			}
+			w.Action = a
+		}
+		if len(parts) > 2 {
+			w.Variants = SplitVariants(parts[2])
+		}
+		words = append(words, w)
+	}
+	return words, scanner.Err()
+}
+
+// SplitVariants 解析逗号分隔的变体列表
+func SplitVariants(s string) []string {
+	var variants []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			variants = append(variants, v)
+		}
+	}
+	return variants
+}
+
+// LoadAll 依次从各来源加载并合并词库，任一来源失败时返回错误，调用方应保留旧词库
+func LoadAll(ctx context.Context, sources ...Source) ([]Word, error) {
+	var words []Word
+	for _, s := range sources {
+		list, err := s.Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, list...)
+	}
+	return words, nil
+}
@@ -0,0 +1,286 @@
+// Package slider 滑块拼图验证码：生成带缺口的背景图及拼图块，并校验拖动结果与轨迹
+package slider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	DefaultWidth     = 300
+	DefaultHeight    = 150
+	DefaultPieceSize = 40
+)
+
+// Options 生成参数
+type Options struct {
+	Width     int
+	Height    int
+	PieceSize int // 拼图块主体边长
+}
+
+// Puzzle 生成结果，X 为答案，不返回给客户端
+type Puzzle struct {
+	Background string // Base64 PNG，带缺口
+	Piece      string // Base64 PNG，透明背景的拼图块
+	X          int    // 拼图块左上角在背景图中的横坐标
+	Y          int    // 拼图块左上角在背景图中的纵坐标，客户端据此摆放拼图块
+}
+
+// piece 拼图块形状：正方形主体，顶部与右侧各带一个半圆凸起
+type piece struct {
+	size   int // 主体边长
+	radius int // 凸起半径
+}
+
+func newPiece(size int) piece {
+	return piece{size: size, radius: size / 5}
+}
+
+// bounds 拼图块画布边长（主体 + 凸起）
+func (p piece) bounds() int {
+	return p.size + p.radius
+}
+
+// contains 判断画布内的点是否属于拼图块，主体位于画布左下角
+func (p piece) contains(x, y int) bool {
+	bodyTop := p.radius
+	if x >= 0 && x < p.size && y >= bodyTop && y < bodyTop+p.size {
+		return true
+	}
+	// 顶部凸起
+	if inCircle(x, y, p.size/2, bodyTop, p.radius) {
+		return true
+	}
+	// 右侧凸起
+	return inCircle(x, y, p.size, bodyTop+p.size/2, p.radius)
+}
+
+// edge 判断点是否位于拼图块轮廓上
+func (p piece) edge(x, y int) bool {
+	if !p.contains(x, y) {
+		return false
+	}
+	return !p.contains(x-1, y) || !p.contains(x+1, y) || !p.contains(x, y-1) || !p.contains(x, y+1)
+}
+
+func inCircle(x, y, cx, cy, r int) bool {
+	dx, dy := x-cx, y-cy
+	return dx*dx+dy*dy <= r*r
+}
+
+// Generate 生成拼图
+func Generate(opts Options) (*Puzzle, error) {
+	if opts.Width <= 0 {
+		opts.Width = DefaultWidth
+	}
+	if opts.Height <= 0 {
+		opts.Height = DefaultHeight
+	}
+	if opts.PieceSize <= 0 {
+		opts.PieceSize = DefaultPieceSize
+	}
+	p := newPiece(opts.PieceSize)
+	b := p.bounds()
+
+	bg := drawBackground(opts.Width, opts.Height)
+
+	// 缺口不出现在最左侧一块区域，避免拼图块初始位置即为答案
+	minX := b + b/2
+	maxX := opts.Width - b - 4
+	if maxX <= minX {
+		maxX = minX + 1
+	}
+	x := minX + rand.IntN(maxX-minX)
+	y := 0
+	if opts.Height > b {
+		y = rand.IntN(opts.Height - b)
+	}
+
+	pieceImg := image.NewRGBA(image.Rect(0, 0, b, b))
+	for py := 0; py < b; py++ {
+		for px := 0; px < b; px++ {
+			if !p.contains(px, py) {
+				continue
+			}
+			bx, by := x+px, y+py
+			if !(image.Point{X: bx, Y: by}).In(bg.Bounds()) {
+				continue
+			}
+			c := bg.RGBAAt(bx, by)
+			if p.edge(px, py) {
+				pieceImg.SetRGBA(px, py, color.RGBA{R: 255, G: 255, B: 255, A: 230})
+				bg.SetRGBA(bx, by, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+				continue
+			}
+			pieceImg.SetRGBA(px, py, c)
+			// 缺口处压暗
+			bg.SetRGBA(bx, by, color.RGBA{R: c.R / 3, G: c.G / 3, B: c.B / 3, A: 255})
+		}
+	}
+
+	background, err := encodePNG(bg)
+	if err != nil {
+		return nil, err
+	}
+	pieceB64, err := encodePNG(pieceImg)
+	if err != nil {
+		return nil, err
+	}
+	return &Puzzle{Background: background, Piece: pieceB64, X: x, Y: y}, nil
+}
+
+// drawBackground 随机渐变底色叠加半透明圆形与噪点，增加图像识别难度
+func drawBackground(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	from, to := randomColor(), randomColor()
+	for y := 0; y < h; y++ {
+		t := float64(y) / float64(h)
+		c := color.RGBA{
+			R: lerp(from.R, to.R, t),
+			G: lerp(from.G, to.G, t),
+			B: lerp(from.B, to.B, t),
+			A: 255,
+		}
+		for x := 0; x < w; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+
+	for i := 0; i < 12; i++ {
+		cx, cy := rand.IntN(w), rand.IntN(h)
+		r := 8 + rand.IntN(h/3+1)
+		c := randomColor()
+		for y := max(0, cy-r); y < min(h, cy+r); y++ {
+			for x := max(0, cx-r); x < min(w, cx+r); x++ {
+				if inCircle(x, y, cx, cy, r) {
+					img.SetRGBA(x, y, blend(img.RGBAAt(x, y), c, 0.45))
+				}
+			}
+		}
+	}
+
+	for i := 0; i < w*h/12; i++ {
+		x, y := rand.IntN(w), rand.IntN(h)
+		img.SetRGBA(x, y, blend(img.RGBAAt(x, y), randomColor(), 0.5))
+	}
+	return img
+}
+
+func randomColor() color.RGBA {
+	return color.RGBA{R: uint8(40 + rand.IntN(200)), G: uint8(40 + rand.IntN(200)), B: uint8(40 + rand.IntN(200)), A: 255}
+}
+
+func lerp(a, b uint8, t float64) uint8 {
+	return uint8(float64(a) + (float64(b)-float64(a))*t)
+}
+
+func blend(a, b color.RGBA, alpha float64) color.RGBA {
+	return color.RGBA{
+		R: lerp(a.R, b.R, alpha),
+		G: lerp(a.G, b.G, alpha),
+		B: lerp(a.B, b.B, alpha),
+		A: 255,
+	}
+}
+
+func encodePNG(img image.Image) (string, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Answer 客户端提交的拖动结果
+// JSON 格式：{"x":120,"duration":860,"track":[[0,0,0],[6,1,16],...]}，轨迹点为 [x, y, 距开始拖动的毫秒数]
+type Answer struct {
+	X        int      `json:"x"`
+	Duration int64    `json:"duration"` // 拖动时长（毫秒）
+	Track    [][3]int `json:"track"`
+}
+
+// ParseAnswer 解析客户端提交的答案
+func ParseAnswer(s string) (*Answer, error) {
+	var a Answer
+	if err := json.Unmarshal([]byte(s), &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// Rule 校验规则
+type Rule struct {
+	Tolerance      int           // 允许的横向偏移误差（像素）
+	MinDuration    time.Duration // 最短拖动时长，过快视为脚本
+	MinTrackPoints int           // 最少轨迹点数
+}
+
+// 匀速拖动视为脚本：相邻轨迹点速度的变异系数低于该值时校验失败
+const minSpeedVariation = 0.05
+
+// Verify 校验偏移量、拖动时长与轨迹
+func (a *Answer) Verify(x int, rule Rule) bool {
+	if abs(a.X-x) > rule.Tolerance {
+		return false
+	}
+	if time.Duration(a.Duration)*time.Millisecond < rule.MinDuration {
+		return false
+	}
+	if len(a.Track) < rule.MinTrackPoints || len(a.Track) < 2 {
+		return false
+	}
+
+	last := a.Track[len(a.Track)-1]
+	// 轨迹终点需与提交位置一致，时间戳不得超过拖动时长
+	if abs(last[0]-a.X) > rule.Tolerance || int64(last[2]) > a.Duration {
+		return false
+	}
+
+	speeds := make([]float64, 0, len(a.Track)-1)
+	for i := 1; i < len(a.Track); i++ {
+		dt := a.Track[i][2] - a.Track[i-1][2]
+		if dt < 0 {
+			return false
+		}
+		if dt == 0 {
+			continue
+		}
+		speeds = append(speeds, float64(a.Track[i][0]-a.Track[i-1][0])/float64(dt))
+	}
+	return speedVariation(speeds) >= minSpeedVariation
+}
+
+// speedVariation 速度变异系数（标准差 / 平均值的绝对值）
+func speedVariation(speeds []float64) float64 {
+	if len(speeds) < 2 {
+		return 0
+	}
+	var sum float64
+	for _, v := range speeds {
+		sum += v
+	}
+	mean := sum / float64(len(speeds))
+	if mean == 0 {
+		return 0
+	}
+	var variance float64
+	for _, v := range speeds {
+		variance += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(variance/float64(len(speeds))) / math.Abs(mean)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
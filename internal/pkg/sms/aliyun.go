@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	dysmsapi "github.com/alibabacloud-go/dysmsapi-20170525/v5/client"
@@ -14,28 +15,37 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/msgtpl"
 )
 
+const aliyunDefaultEndpoint = "dysmsapi.aliyuncs.com"
+
 type aliyunSender struct {
-	client *dysmsapi.Client
-	conf   *conf.Data_Sms
-	log    *log.Helper
+	client   *dysmsapi.Client
+	conf     *conf.Data_Sms_Provider
+	registry *msgtpl.Registry
+	log      *log.Helper
 }
 
-func NewAliyunSender(c *conf.Data, logger log.Logger) Sender {
+func NewAliyunSender(c *conf.Data_Sms_Provider, registry *msgtpl.Registry, logger log.Logger) Provider {
 	// 1. 使用官方推荐的凭据初始化方式
 	cred, err := credentials.NewCredential(&credentials.Config{
 		Type:            tea.String("access_key"),
-		AccessKeyId:     tea.String(c.Sms.AccessKey),
-		AccessKeySecret: tea.String(c.Sms.AccessSecret),
+		AccessKeyId:     tea.String(c.AccessKey),
+		AccessKeySecret: tea.String(c.AccessSecret),
 	})
 	if err != nil {
 		panic(fmt.Sprintf("初始化阿里云凭据失败: %v", err))
 	}
 
+	endpoint := aliyunDefaultEndpoint
+	if c.Endpoint != "" {
+		endpoint = c.Endpoint
+	}
+
 	config := &openapi.Config{
 		Credential: cred,
-		Endpoint:   tea.String("dysmsapi.aliyuncs.com"),
+		Endpoint:   tea.String(endpoint),
 	}
 
 	client, err := dysmsapi.NewClient(config)
@@ -44,23 +54,28 @@ func NewAliyunSender(c *conf.Data, logger log.Logger) Sender {
 	}
 
 	return &aliyunSender{
-		client: client,
-		conf:   c.Sms,
-		log:    log.NewHelper(logger),
+		client:   client,
+		conf:     c,
+		registry: registry,
+		log:      log.NewHelper(logger),
 	}
 }
 
-func (s *aliyunSender) Send(ctx context.Context, phone string, template string, params map[string]string) error {
-	templateCode, ok := s.conf.TemplateMapping[template]
-	if !ok || templateCode == "" {
-		return ErrorTemplateNotConfigured
+func (s *aliyunSender) Name() string {
+	return "aliyun"
+}
+
+func (s *aliyunSender) Send(ctx context.Context, phone string, template string, params map[string]string) (*Receipt, error) {
+	templateCode, signName, err := resolveTemplate(s.conf, s.registry, phone, template)
+	if err != nil {
+		return nil, err
 	}
 
 	jsonParams, _ := json.Marshal(params)
 
 	request := &dysmsapi.SendSmsRequest{
-		PhoneNumbers:  tea.String(phone),
-		SignName:      tea.String(s.conf.SignName),
+		PhoneNumbers:  tea.String(aliyunPhoneNumber(phone)),
+		SignName:      tea.String(signName),
 		TemplateCode:  tea.String(templateCode),
 		TemplateParam: tea.String(string(jsonParams)),
 	}
@@ -72,7 +87,7 @@ func (s *aliyunSender) Send(ctx context.Context, phone string, template string,
 	}
 
 	// 按照官方示例处理 Tea 框架的 Panic 和 Error
-	var sendErr error
+	var receipt *Receipt
 	tryErr := func() (e error) {
 		defer func() {
 			if r := tea.Recover(recover()); r != nil {
@@ -94,11 +109,15 @@ func (s *aliyunSender) Send(ctx context.Context, phone string, template string,
 		}
 
 		s.log.Infof("短信发送成功: RequestId=%s", tea.StringValue(resp.Body.RequestId))
+		receipt = &Receipt{
+			Provider:  s.Name(),
+			MessageID: tea.StringValue(resp.Body.BizId),
+			RequestID: tea.StringValue(resp.Body.RequestId),
+		}
 		return nil
 	}()
 
 	if tryErr != nil {
-		sendErr = tryErr
 		// 重点优化：解析阿里云特有的 SDKError 以获取诊断建议
 		var sdkErr *tea.SDKError
 		if errors.As(tryErr, &sdkErr) {
@@ -114,7 +133,16 @@ func (s *aliyunSender) Send(ctx context.Context, phone string, template string,
 				}
 			}
 		}
+		return nil, tryErr
 	}
 
-	return sendErr
+	return receipt, nil
+}
+
+// aliyunPhoneNumber 阿里云大陆号码不带区号，国际/港澳台号码为区号+号码且不带 +，如 85200000000
+func aliyunPhoneNumber(phone string) string {
+	if IsMainland(phone) {
+		return strings.TrimPrefix(phone, mainlandPrefix)
+	}
+	return strings.TrimPrefix(phone, "+")
 }
@@ -0,0 +1,140 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultFailureThreshold = 3
+	defaultCoolDown         = time.Minute
+)
+
+// breaker 简单熔断器：连续失败达到阈值后在冷却期内跳过该供应商，
+// 冷却期结束后放行请求试探，再次失败则立即重新熔断
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	coolDown  time.Duration
+	failures  int
+	openUntil time.Time
+}
+
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !now.Before(b.openUntil)
+}
+
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.openUntil = time.Time{}
+}
+
+// failure 记录一次失败，返回本次是否触发熔断
+func (b *breaker) failure(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.failures < b.threshold {
+		return false
+	}
+	b.openUntil = now.Add(b.coolDown)
+	return true
+}
+
+type member struct {
+	provider Provider
+	breaker  *breaker
+}
+
+// chainSender 按优先级依次尝试多个供应商，并对持续失败的供应商熔断
+type chainSender struct {
+	members  []*member
+	recorder Recorder
+	log      *log.Helper
+}
+
+func NewChainSender(providers []Provider, failureThreshold int, coolDown time.Duration, recorder Recorder, logger log.Logger) Sender {
+	if failureThreshold <= 0 {
+		failureThreshold = defaultFailureThreshold
+	}
+	if coolDown <= 0 {
+		coolDown = defaultCoolDown
+	}
+
+	members := make([]*member, 0, len(providers))
+	for _, p := range providers {
+		members = append(members, &member{
+			provider: p,
+			breaker:  &breaker{threshold: failureThreshold, coolDown: coolDown},
+		})
+	}
+
+	return &chainSender{
+		members:  members,
+		recorder: recorder,
+		log:      log.NewHelper(log.With(logger, "module", "sms/chain")),
+	}
+}
+
+func (s *chainSender) Send(ctx context.Context, phone string, template string, params map[string]string) error {
+	candidates := s.candidates(time.Now())
+	if len(candidates) == 0 {
+		return ErrorProviderNotConfigured
+	}
+
+	var lastErr error
+	for _, m := range candidates {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		start := time.Now()
+		receipt, err := m.provider.Send(ctx, phone, template, params)
+		s.recorder.Record(ctx, &Attempt{
+			Phone:    phone,
+			Template: template,
+			Provider: m.provider.Name(),
+			Receipt:  receipt,
+			Err:      err,
+			Cost:     time.Since(start),
+		})
+
+		if err == nil {
+			m.breaker.success()
+			return nil
+		}
+		lastErr = err
+
+		// 模板未配置属于配置问题，不计入供应商健康度
+		if errors.Is(err, ErrorTemplateNotConfigured) {
+			continue
+		}
+		if m.breaker.failure(time.Now()) {
+			s.log.Warnf("短信供应商 %s 连续失败，熔断 %v", m.provider.Name(), m.breaker.coolDown)
+		}
+	}
+
+	return lastErr
+}
+
+// candidates 返回当前健康的供应商；全部熔断时退化为按原顺序全部尝试，避免短信完全不可用
+func (s *chainSender) candidates(now time.Time) []*member {
+	healthy := make([]*member, 0, len(s.members))
+	for _, m := range s.members {
+		if m.breaker.allow(now) {
+			healthy = append(healthy, m)
+		}
+	}
+	if len(healthy) == 0 {
+		return s.members
+	}
+	return healthy
+}
@@ -1,27 +1,70 @@
 package sms
 
 import (
+	"time"
+
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/env"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/msgtpl"
 )
 
 // ProviderSet 给 Wire 使用
 var ProviderSet = wire.NewSet(NewSmsSender)
 
-func NewSmsSender(c *conf.Data, logger log.Logger) Sender {
+func NewSmsSender(c *conf.Data, registry *msgtpl.Registry, recorder Recorder, logger log.Logger) Sender {
 	// 1. 如果是开发环境，强制返回 Mock
 	if env.IsDev() {
 		return NewMockSender(logger)
 	}
 
-	// 2. 根据配置文件决定使用哪个供应商
-	switch c.Sms.Provider {
-	case "aliyun":
-		return NewAliyunSender(c, logger)
-	case "tencent":
-		// return NewTencentSender(c.Sms)
-	}
-	return NewMockSender(logger)
+	// 2. 根据配置文件组装供应商链，单供应商配置视为只有一个节点的链
+	var providers []Provider
+	for _, p := range providerConfigs(c.Sms) {
+		switch p.Name {
+		case "aliyun":
+			providers = append(providers, NewAliyunSender(p, registry, logger))
+		case "tencent":
+			providers = append(providers, NewTencentSender(p, registry, logger))
+		default:
+			log.NewHelper(logger).Warnf("未知的短信供应商: %s", p.Name)
+		}
+	}
+	if len(providers) == 0 {
+		return NewMockSender(logger)
+	}
+
+	var (
+		threshold int
+		coolDown  time.Duration
+	)
+	if cb := c.Sms.CircuitBreaker; cb != nil {
+		threshold = int(cb.FailureThreshold)
+		coolDown = cb.CoolDown.AsDuration()
+	}
+	if recorder == nil {
+		recorder = NewLogRecorder(logger)
+	}
+	return NewChainSender(providers, threshold, coolDown, recorder, logger)
+}
+
+// providerConfigs 优先使用 providers 列表，未配置时兼容旧的单供应商字段
+func providerConfigs(c *conf.Data_Sms) []*conf.Data_Sms_Provider {
+	if c == nil {
+		return nil
+	}
+	if len(c.Providers) > 0 {
+		return c.Providers
+	}
+	if c.Provider == "" {
+		return nil
+	}
+	return []*conf.Data_Sms_Provider{{
+		Name:            c.Provider,
+		TemplateMapping: c.TemplateMapping,
+		AccessKey:       c.AccessKey,
+		AccessSecret:    c.AccessSecret,
+		SignName:        c.SignName,
+	}}
 }
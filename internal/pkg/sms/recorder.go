@@ -0,0 +1,59 @@
+package sms
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Attempt 一次供应商发送尝试的记录
+type Attempt struct {
+	Phone    string
+	Template string
+	Provider string
+	Receipt  *Receipt // 发送成功时的供应商回执
+	Err      error    // 发送失败原因
+	Cost     time.Duration
+}
+
+// Recorder 记录每次供应商选择及发送结果
+type Recorder interface {
+	Record(ctx context.Context, a *Attempt)
+}
+
+// RecorderFunc 函数适配器
+type RecorderFunc func(ctx context.Context, a *Attempt)
+
+func (f RecorderFunc) Record(ctx context.Context, a *Attempt) { f(ctx, a) }
+
+type logRecorder struct {
+	log *log.Helper
+}
+
+// NewLogRecorder 仅输出日志的记录器
+func NewLogRecorder(logger log.Logger) Recorder {
+	return &logRecorder{log: log.NewHelper(log.With(logger, "module", "sms/recorder"))}
+}
+
+func (r *logRecorder) Record(ctx context.Context, a *Attempt) {
+	if a.Err != nil {
+		r.log.WithContext(ctx).Warnf("短信发送失败: phone=%s template=%s provider=%s cost=%v err=%v",
+			MaskPhone(a.Phone), a.Template, a.Provider, a.Cost, a.Err)
+		return
+	}
+	messageID := ""
+	if a.Receipt != nil {
+		messageID = a.Receipt.MessageID
+	}
+	r.log.WithContext(ctx).Infof("短信发送成功: phone=%s template=%s provider=%s message_id=%s cost=%v",
+		MaskPhone(a.Phone), a.Template, a.Provider, messageID, a.Cost)
+}
+
+// MaskPhone 手机号脱敏，如 13812345678 -> 138****5678
+func MaskPhone(phone string) string {
+	if len(phone) < 8 {
+		return phone
+	}
+	return phone[:len(phone)-8] + "****" + phone[len(phone)-4:]
+}
@@ -0,0 +1,60 @@
+package sms
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Report 供应商推送的短信状态回执
+type Report struct {
+	Provider   string
+	MessageID  string // 与发送时 Receipt.MessageID 对应
+	Phone      string
+	Success    bool
+	ErrCode    string
+	ErrMsg     string
+	ReportTime time.Time
+}
+
+// aliyunReport 阿里云短信状态报告（SmsReport）推送格式
+type aliyunReport struct {
+	PhoneNumber string `json:"phone_number"`
+	SendTime    string `json:"send_time"`
+	ReportTime  string `json:"report_time"`
+	Success     bool   `json:"success"`
+	ErrCode     string `json:"err_code"`
+	ErrMsg      string `json:"err_msg"`
+	SmsSize     string `json:"sms_size"`
+	BizID       string `json:"biz_id"`
+	OutID       string `json:"out_id"`
+}
+
+// 阿里云回执时间为北京时间，格式如 2017-02-02 22:23:23
+var aliyunReportLocation = time.FixedZone("CST", 8*3600)
+
+const aliyunReportTimeLayout = "2006-01-02 15:04:05"
+
+// ParseAliyunReports 解析阿里云 HTTP 批量推送的状态报告
+func ParseAliyunReports(body []byte) ([]*Report, error) {
+	var raw []aliyunReport
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+	reports := make([]*Report, 0, len(raw))
+	for _, r := range raw {
+		reportTime, err := time.ParseInLocation(aliyunReportTimeLayout, r.ReportTime, aliyunReportLocation)
+		if err != nil {
+			reportTime = time.Now()
+		}
+		reports = append(reports, &Report{
+			Provider:   "aliyun",
+			MessageID:  r.BizID,
+			Phone:      r.PhoneNumber,
+			Success:    r.Success,
+			ErrCode:    r.ErrCode,
+			ErrMsg:     r.ErrMsg,
+			ReportTime: reportTime,
+		})
+	}
+	return reports, nil
+}
@@ -2,14 +2,95 @@ package sms
 
 import (
 	"context"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/msgtpl"
 )
 
+const mainlandPrefix = "+86"
+
 var (
 	ErrorTemplateNotConfigured = errors.InternalServer("SMS_TEMPLATE_NOT_CONFIGURED", "短信模板未配置")
+	ErrorProviderNotConfigured = errors.InternalServer("SMS_PROVIDER_NOT_CONFIGURED", "短信供应商未配置")
 )
 
 type Sender interface {
 	Send(ctx context.Context, phone string, template string, params map[string]string) error
 }
+
+// Provider 具体的短信供应商，由 chainSender 按优先级调度
+type Provider interface {
+	// Name 供应商标识，如 aliyun、tencent
+	Name() string
+	// Send 发送短信，成功时返回供应商回执
+	Send(ctx context.Context, phone string, template string, params map[string]string) (*Receipt, error)
+}
+
+// Receipt 供应商受理回执
+type Receipt struct {
+	Provider  string // 供应商标识
+	MessageID string // 供应商侧消息 ID（阿里云 BizId / 腾讯云 SerialNo），用于匹配状态回执
+	RequestID string // 供应商请求 ID，用于排查问题
+}
+
+// IsMainland 是否为中国大陆号码，未带国际区号的号码按大陆处理
+func IsMainland(phone string) bool {
+	return !strings.HasPrefix(phone, "+") || strings.HasPrefix(phone, mainlandPrefix)
+}
+
+// 模板编号来源
+const (
+	SourceDatabase = "database"
+	SourceConfig   = "config"
+)
+
+// TemplateCode 某个供应商实际使用的模板编号
+type TemplateCode struct {
+	Provider string
+	Code     string
+	Source   string // database / config
+}
+
+// resolveTemplate 按号码归属选择模板与签名，国际/港澳台号码使用 intl 配置
+func resolveTemplate(c *conf.Data_Sms_Provider, registry *msgtpl.Registry, phone, template string) (string, string, error) {
+	mainland := IsMainland(phone)
+	signName := c.SignName
+	if !mainland && c.IntlSignName != "" {
+		signName = c.IntlSignName
+	}
+	code, _ := templateCode(c, registry, template, !mainland)
+	if code == "" {
+		return "", "", ErrorTemplateNotConfigured
+	}
+	return code, signName, nil
+}
+
+// templateCode 数据库中维护的模板编号优先，未维护时使用配置文件中的映射
+func templateCode(c *conf.Data_Sms_Provider, registry *msgtpl.Registry, template string, intl bool) (string, string) {
+	if registry != nil {
+		if code, ok := registry.SmsVendorCode(c.Name, template, intl); ok {
+			return code, SourceDatabase
+		}
+	}
+	mapping := c.TemplateMapping
+	if intl {
+		mapping = c.IntlTemplateMapping
+	}
+	if code := mapping[template]; code != "" {
+		return code, SourceConfig
+	}
+	return "", ""
+}
+
+// LookupTemplateCodes 按供应商优先级列出各供应商发送该模板时使用的模板编号，未配置的供应商不返回
+func LookupTemplateCodes(c *conf.Data_Sms, registry *msgtpl.Registry, template string, intl bool) []*TemplateCode {
+	var codes []*TemplateCode
+	for _, p := range providerConfigs(c) {
+		if code, source := templateCode(p, registry, template, intl); code != "" {
+			codes = append(codes, &TemplateCode{Provider: p.Name, Code: code, Source: source})
+		}
+	}
+	return codes
+}
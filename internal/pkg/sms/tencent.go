@@ -0,0 +1,231 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/msgtpl"
+)
+
+const (
+	tencentDefaultEndpoint = "sms.tencentcloudapi.com"
+	tencentDefaultRegion   = "ap-guangzhou"
+	tencentService         = "sms"
+	tencentAction          = "SendSms"
+	tencentVersion         = "2021-01-11"
+	tencentAlgorithm       = "TC3-HMAC-SHA256"
+)
+
+// tencentSender 腾讯云短信，直接调用 API 3.0（TC3-HMAC-SHA256 签名），避免引入完整 SDK
+type tencentSender struct {
+	client   *http.Client
+	conf     *conf.Data_Sms_Provider
+	registry *msgtpl.Registry
+	endpoint string
+	region   string
+	log      *log.Helper
+}
+
+func NewTencentSender(c *conf.Data_Sms_Provider, registry *msgtpl.Registry, logger log.Logger) Provider {
+	endpoint := tencentDefaultEndpoint
+	if c.Endpoint != "" {
+		endpoint = c.Endpoint
+	}
+	region := tencentDefaultRegion
+	if c.Region != "" {
+		region = c.Region
+	}
+	return &tencentSender{
+		client:   &http.Client{Timeout: 5 * time.Second},
+		conf:     c,
+		registry: registry,
+		endpoint: endpoint,
+		region:   region,
+		log:      log.NewHelper(logger),
+	}
+}
+
+type tencentSendSmsRequest struct {
+	PhoneNumberSet   []string `json:"PhoneNumberSet"`
+	SmsSdkAppId      string   `json:"SmsSdkAppId"`
+	SignName         string   `json:"SignName"`
+	TemplateId       string   `json:"TemplateId"`
+	TemplateParamSet []string `json:"TemplateParamSet,omitempty"`
+}
+
+type tencentSendSmsResponse struct {
+	Response struct {
+		SendStatusSet []struct {
+			SerialNo    string `json:"SerialNo"`
+			PhoneNumber string `json:"PhoneNumber"`
+			Code        string `json:"Code"`
+			Message     string `json:"Message"`
+		} `json:"SendStatusSet"`
+		Error *struct {
+			Code    string `json:"Code"`
+			Message string `json:"Message"`
+		} `json:"Error"`
+		RequestId string `json:"RequestId"`
+	} `json:"Response"`
+}
+
+func (s *tencentSender) Name() string {
+	return "tencent"
+}
+
+func (s *tencentSender) Send(ctx context.Context, phone string, template string, params map[string]string) (*Receipt, error) {
+	templateID, signName, err := resolveTemplate(s.conf, s.registry, phone, template)
+	if err != nil {
+		return nil, err
+	}
+
+	// 腾讯云要求 E.164 格式，未带国家码的号码按中国大陆处理
+	if !strings.HasPrefix(phone, "+") {
+		phone = mainlandPrefix + phone
+	}
+
+	payload, err := json.Marshal(&tencentSendSmsRequest{
+		PhoneNumberSet:   []string{phone},
+		SmsSdkAppId:      s.conf.AppId,
+		SignName:         signName,
+		TemplateId:       templateID,
+		TemplateParamSet: tencentTemplateParams(params),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, payload, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("腾讯云短信接口返回异常状态码: %d", resp.StatusCode)
+	}
+
+	var out tencentSendSmsResponse
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("解析腾讯云短信响应失败: %w", err)
+	}
+	if e := out.Response.Error; e != nil {
+		return nil, fmt.Errorf("腾讯云业务报错: %s - %s (RequestId=%s)", e.Code, e.Message, out.Response.RequestId)
+	}
+	if len(out.Response.SendStatusSet) == 0 {
+		return nil, fmt.Errorf("腾讯云短信响应缺少发送状态 (RequestId=%s)", out.Response.RequestId)
+	}
+
+	// 与阿里云一致：接口成功不代表短信发送成功，需判断每个号码的 Code
+	status := out.Response.SendStatusSet[0]
+	if !strings.EqualFold(status.Code, "Ok") {
+		return nil, fmt.Errorf("腾讯云业务报错: %s - %s", status.Code, status.Message)
+	}
+
+	s.log.Infof("短信发送成功: RequestId=%s", out.Response.RequestId)
+	return &Receipt{
+		Provider:  s.Name(),
+		MessageID: status.SerialNo,
+		RequestID: out.Response.RequestId,
+	}, nil
+}
+
+func (s *tencentSender) url() string {
+	if strings.Contains(s.endpoint, "://") {
+		return s.endpoint
+	}
+	return "https://" + s.endpoint
+}
+
+// sign 按 TC3-HMAC-SHA256 规范为请求签名
+// 参考：https://cloud.tencent.com/document/api/382/52072
+func (s *tencentSender) sign(req *http.Request, payload []byte, now time.Time) {
+	host := req.URL.Host
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	date := now.UTC().Format("2006-01-02")
+	contentType := "application/json; charset=utf-8"
+
+	canonicalHeaders := "content-type:" + contentType + "\n" +
+		"host:" + host + "\n" +
+		"x-tc-action:" + strings.ToLower(tencentAction) + "\n"
+	signedHeaders := "content-type;host;x-tc-action"
+	canonicalRequest := strings.Join([]string{
+		http.MethodPost,
+		"/",
+		"",
+		canonicalHeaders,
+		signedHeaders,
+		sha256Hex(payload),
+	}, "\n")
+
+	credentialScope := date + "/" + tencentService + "/tc3_request"
+	stringToSign := strings.Join([]string{
+		tencentAlgorithm,
+		timestamp,
+		credentialScope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	secretDate := hmacSHA256([]byte("TC3"+s.conf.AccessSecret), date)
+	secretService := hmacSHA256(secretDate, tencentService)
+	secretSigning := hmacSHA256(secretService, "tc3_request")
+	signature := hex.EncodeToString(hmacSHA256(secretSigning, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		tencentAlgorithm, s.conf.AccessKey, credentialScope, signedHeaders, signature))
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Host", host)
+	req.Header.Set("X-TC-Action", tencentAction)
+	req.Header.Set("X-TC-Timestamp", timestamp)
+	req.Header.Set("X-TC-Version", tencentVersion)
+	req.Header.Set("X-TC-Region", s.region)
+}
+
+// tencentTemplateParams 腾讯云模板参数为位置参数，按参数名字典序展开
+func tencentTemplateParams(params map[string]string) []string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, params[k])
+	}
+	return values
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, msg string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(msg))
+	return mac.Sum(nil)
+}
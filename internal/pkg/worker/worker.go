@@ -0,0 +1,104 @@
+// Package worker 后台常驻协程，以 Kratos transport.Server 的形式随应用启停
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 处理函数返回错误后的等待时间，避免依赖故障时空转
+const errorBackoff = time.Second
+
+// Func 单次处理逻辑，由协程循环调用直到服务停止；name 为协程标识，如 outbound-host-0
+type Func func(ctx context.Context, name string) error
+
+type worker struct {
+	name        string
+	concurrency int
+	fn          Func
+}
+
+type Server struct {
+	workers []worker
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	log     *log.Helper
+}
+
+func NewServer(logger log.Logger) *Server {
+	return &Server{
+		log: log.NewHelper(logger),
+	}
+}
+
+// Add 注册后台任务，concurrency 为协程数
+func (s *Server) Add(name string, concurrency int, fn Func) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	s.workers = append(s.workers, worker{name: name, concurrency: concurrency, fn: fn})
+	s.log.Infof("[Worker] 已注册任务: [%s] 协程数: %d", name, concurrency)
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	// 不使用 Start 的 ctx，停止时由 Stop 统一取消
+	runCtx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	host, _ := os.Hostname()
+	for _, w := range s.workers {
+		for i := 0; i < w.concurrency; i++ {
+			s.wg.Add(1)
+			go s.run(runCtx, w, fmt.Sprintf("%s-%s-%d", w.name, host, i))
+		}
+	}
+	return nil
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	if s.cancel == nil {
+		return nil
+	}
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Server) run(ctx context.Context, w worker, name string) {
+	defer s.wg.Done()
+	for ctx.Err() == nil {
+		if err := s.safeCall(ctx, w, name); err != nil && ctx.Err() == nil {
+			s.log.Errorf("[Worker] 任务 %s 执行失败: %v", name, err)
+			select {
+			case <-time.After(errorBackoff):
+			case <-ctx.Done():
+			}
+		}
+	}
+}
+
+// safeCall 捕获 panic，单次失败不影响协程继续运行
+func (s *Server) safeCall(ctx context.Context, w worker, name string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return w.fn(ctx, name)
+}
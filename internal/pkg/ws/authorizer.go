@@ -0,0 +1,45 @@
+package ws
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+)
+
+var ErrRoomForbidden = errors.New("ws: room forbidden")
+
+// AuthorizeFunc 判断用户能否加入房间，返回错误时拒绝加入
+type AuthorizeFunc func(ctx context.Context, uid, room string) error
+
+// Authorizer 按房间前缀分派授权检查，前缀为冒号前的部分，如 order:123 的 order；未注册的前缀一律拒绝
+type Authorizer struct {
+	mu    sync.RWMutex
+	rules map[string]AuthorizeFunc
+}
+
+func NewAuthorizer() *Authorizer {
+	return &Authorizer{rules: make(map[string]AuthorizeFunc)}
+}
+
+// Register 注册房间前缀的授权检查，由业务模块在初始化时调用
+func (a *Authorizer) Register(prefix string, fn AuthorizeFunc) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.rules[prefix] = fn
+}
+
+// Authorize 检查用户能否加入房间
+func (a *Authorizer) Authorize(ctx context.Context, uid, room string) error {
+	prefix, id, ok := strings.Cut(room, ":")
+	if !ok || prefix == "" || id == "" || len(room) > maxRoomLength {
+		return ErrInvalidRoom
+	}
+	a.mu.RLock()
+	fn := a.rules[prefix]
+	a.mu.RUnlock()
+	if fn == nil {
+		return ErrRoomForbidden
+	}
+	return fn(ctx, uid, room)
+}
@@ -0,0 +1,145 @@
+package ws
+
+import (
+	"context"
+	"sync"
+)
+
+// 跨实例投递的目标类型
+const (
+	TargetUser   = "user"   // 用户的所有连接
+	TargetDevice = "device" // 用户指定设备类型的连接
+	TargetRoom   = "room"   // 房间内的所有连接
+	TargetRevoke = "revoke" // 断开用户使用指定令牌的连接，不投递消息
+	TargetLeave  = "leave"  // 将用户的连接移出房间，不投递消息
+)
+
+// Envelope 实例间转发的消息
+type Envelope struct {
+	From   string   `json:"from"`             // 发布消息的实例
+	Target string   `json:"target"`           // 投递目标类型
+	UID    string   `json:"uid,omitempty"`    // 目标用户
+	Room   string   `json:"room,omitempty"`   // Target 为 room、leave 时的房间
+	Device string   `json:"device,omitempty"` // Target 为 device 时的设备类型
+	Except string   `json:"except,omitempty"` // 排除的连接 ID
+	Tokens []string `json:"tokens,omitempty"` // Target 为 revoke 时被撤销的令牌 jti，为空表示所有令牌
+	Msg    []byte   `json:"msg"`              // 原始消息
+}
+
+// Broker 集群模式下在实例间转发消息，并维护用户所在实例
+type Broker interface {
+	// NodeID 当前实例标识
+	NodeID() string
+	// Publish 将消息发布到指定实例
+	Publish(ctx context.Context, node string, env *Envelope) error
+	// Broadcast 将消息发布到所有实例，用于房间广播
+	Broadcast(ctx context.Context, env *Envelope) error
+	// Subscribe 接收发往当前实例的消息及广播，阻塞直到 ctx 取消或订阅出错
+	Subscribe(ctx context.Context, handler func(env *Envelope)) error
+	// SetOnline 记录用户在当前实例上线，需在有效期内重复调用续期
+	SetOnline(ctx context.Context, uids []string) error
+	// SetOffline 移除用户在当前实例的记录
+	SetOffline(ctx context.Context, uid string) error
+	// Nodes 用户当前有连接的实例
+	Nodes(ctx context.Context, uid string) ([]string, error)
+}
+
+// MemoryBus 进程内的消息总线，用于在同一进程中以多个 Hub 模拟集群
+type MemoryBus struct {
+	mu       sync.RWMutex
+	subs     map[string]func(env *Envelope)
+	presence map[string]map[string]struct{} // UID -> 实例
+}
+
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{
+		subs:     make(map[string]func(env *Envelope)),
+		presence: make(map[string]map[string]struct{}),
+	}
+}
+
+// Broker 返回挂在总线上、标识为 node 的实例
+func (b *MemoryBus) Broker(node string) Broker {
+	return &memoryBroker{bus: b, node: node}
+}
+
+type memoryBroker struct {
+	bus  *MemoryBus
+	node string
+}
+
+func (m *memoryBroker) NodeID() string {
+	return m.node
+}
+
+func (m *memoryBroker) Publish(_ context.Context, node string, env *Envelope) error {
+	m.bus.mu.RLock()
+	handler := m.bus.subs[node]
+	m.bus.mu.RUnlock()
+	if handler != nil {
+		handler(env)
+	}
+	return nil
+}
+
+func (m *memoryBroker) Broadcast(_ context.Context, env *Envelope) error {
+	m.bus.mu.RLock()
+	handlers := make([]func(env *Envelope), 0, len(m.bus.subs))
+	for node, handler := range m.bus.subs {
+		if node != m.node {
+			handlers = append(handlers, handler)
+		}
+	}
+	m.bus.mu.RUnlock()
+	for _, handler := range handlers {
+		handler(env)
+	}
+	return nil
+}
+
+func (m *memoryBroker) Subscribe(ctx context.Context, handler func(env *Envelope)) error {
+	m.bus.mu.Lock()
+	m.bus.subs[m.node] = handler
+	m.bus.mu.Unlock()
+
+	<-ctx.Done()
+
+	m.bus.mu.Lock()
+	delete(m.bus.subs, m.node)
+	m.bus.mu.Unlock()
+	return nil
+}
+
+func (m *memoryBroker) SetOnline(_ context.Context, uids []string) error {
+	m.bus.mu.Lock()
+	defer m.bus.mu.Unlock()
+	for _, uid := range uids {
+		if m.bus.presence[uid] == nil {
+			m.bus.presence[uid] = make(map[string]struct{})
+		}
+		m.bus.presence[uid][m.node] = struct{}{}
+	}
+	return nil
+}
+
+func (m *memoryBroker) SetOffline(_ context.Context, uid string) error {
+	m.bus.mu.Lock()
+	defer m.bus.mu.Unlock()
+	if nodes := m.bus.presence[uid]; nodes != nil {
+		delete(nodes, m.node)
+		if len(nodes) == 0 {
+			delete(m.bus.presence, uid)
+		}
+	}
+	return nil
+}
+
+func (m *memoryBroker) Nodes(_ context.Context, uid string) ([]string, error) {
+	m.bus.mu.RLock()
+	defer m.bus.mu.RUnlock()
+	nodes := make([]string, 0, len(m.bus.presence[uid]))
+	for node := range m.bus.presence[uid] {
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
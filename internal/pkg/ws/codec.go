@@ -0,0 +1,111 @@
+package ws
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/websocket"
+	wsV1 "github.com/sober-studio/bubble-boot-go-kratos/api/ws/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// 握手时协商的子协议，未协商时为 json
+const (
+	SubprotocolJSON     = "json"
+	SubprotocolProtobuf = "protobuf"
+)
+
+// Subprotocols 支持的子协议，客户端同时请求多个时按此顺序选择
+var Subprotocols = []string{SubprotocolProtobuf, SubprotocolJSON}
+
+// codec 连接的编解码方式。服务端内部（Hub、Broker、Mailbox）统一以 JSON 格式的 Message 传递消息，
+// 写入连接前再按子协议编码，请求的响应则直接编码以保留 proto 类型
+type codec interface {
+	// decode 解析客户端发来的帧，binary 表示 Data 为 proto 二进制
+	decode(frame []byte) (req *Request, binary bool, err error)
+	// encode 将内部的 JSON 消息编码为客户端帧
+	encode(msg []byte) (messageType int, frame []byte, err error)
+	// encodeReply 编码请求的响应，resp 为 proto 消息时按子协议编码
+	encodeReply(action, seq string, resp interface{}, err error) (messageType int, frame []byte)
+}
+
+func newCodec(subprotocol string) codec {
+	if subprotocol == SubprotocolProtobuf {
+		return protobufCodec{}
+	}
+	return jsonCodec{}
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) decode(frame []byte) (*Request, bool, error) {
+	var msg Message
+	if err := json.Unmarshal(frame, &msg); err != nil {
+		return nil, false, err
+	}
+	return &Request{Action: msg.Action, Seq: msg.Seq, Data: msg.Data}, false, nil
+}
+
+func (jsonCodec) encode(msg []byte) (int, []byte, error) {
+	return websocket.TextMessage, msg, nil
+}
+
+func (jsonCodec) encodeReply(action, seq string, resp interface{}, err error) (int, []byte) {
+	if err != nil {
+		return websocket.TextMessage, NewReply(action, seq, nil, err)
+	}
+	data, err := marshalData(resp)
+	return websocket.TextMessage, NewReply(action, seq, data, err)
+}
+
+type protobufCodec struct{}
+
+func (protobufCodec) decode(frame []byte) (*Request, bool, error) {
+	var f wsV1.Frame
+	if err := proto.Unmarshal(frame, &f); err != nil {
+		return nil, false, err
+	}
+	req := &Request{Action: f.Action, Seq: f.Seq, Data: f.Data}
+	return req, f.Encoding == wsV1.Frame_PROTO, nil
+}
+
+func (protobufCodec) encode(msg []byte) (int, []byte, error) {
+	var m Message
+	if err := json.Unmarshal(msg, &m); err != nil {
+		return 0, nil, err
+	}
+	f := &wsV1.Frame{Action: m.Action, Seq: m.Seq, Encoding: wsV1.Frame_JSON, Error: toFrameError(m.Error)}
+	if len(m.Data) > 0 && string(m.Data) != "null" {
+		f.Data = m.Data
+	}
+	b, err := proto.Marshal(f)
+	return websocket.BinaryMessage, b, err
+}
+
+func (protobufCodec) encodeReply(action, seq string, resp interface{}, err error) (int, []byte) {
+	f := &wsV1.Frame{Action: action, Seq: seq}
+	if err == nil {
+		f.Data, err = marshalBinary(resp, &f.Encoding)
+	}
+	if err != nil {
+		f.Data, f.Encoding, f.Error = nil, wsV1.Frame_PROTO, toFrameError(toError(err))
+	}
+	b, _ := proto.Marshal(f)
+	return websocket.BinaryMessage, b
+}
+
+// marshalBinary proto 消息按二进制编码，其他类型按 JSON 编码
+func marshalBinary(v interface{}, encoding *wsV1.Frame_Encoding) ([]byte, error) {
+	if m, ok := v.(proto.Message); ok {
+		*encoding = wsV1.Frame_PROTO
+		return proto.Marshal(m)
+	}
+	*encoding = wsV1.Frame_JSON
+	return json.Marshal(v)
+}
+
+func toFrameError(e *Error) *wsV1.Error {
+	if e == nil {
+		return nil
+	}
+	return &wsV1.Error{Code: e.Code, Reason: e.Reason, Message: e.Message, Metadata: e.Metadata}
+}
@@ -1,82 +1,849 @@
 package ws
 
 import (
+	"compress/flate"
+	"context"
+	"errors"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
 )
 
 const (
-	writeWait      = 10 * time.Second    // 写入超时
-	pongWait       = 60 * time.Second    // 等待 pong 超时
-	pingPeriod     = (pongWait * 9) / 10 // 发送 ping 周期
-	maxMessageSize = 512                 // 最大消息大小
+	defaultWriteWait            = 10 * time.Second // 写入超时
+	defaultPongWait             = 60 * time.Second // 等待 pong 超时，发送 ping 周期默认为其 9/10
+	defaultReadLimit            = 64 << 10         // 客户端单条消息最大字节数
+	sendBufferSize              = 256              // 默认发送缓冲区大小
+	defaultCompressionLevel     = 1                // permessage-deflate 压缩级别，最快
+	defaultCompressionThreshold = 1024             // 不小于该字节数的消息才压缩
+
+	defaultMaxConnectionsPerUser = 5
+	defaultPresenceTTL           = 60 * time.Second
+	brokerTimeout                = 3 * time.Second       // 单次访问 Broker 的超时
+	roomGuardTimeout             = 3 * time.Second       // 投递房间消息前复查授权的超时
+	heartbeatInterval            = 15 * time.Second      // 同一连接两次心跳回调的最小间隔
+	drainPollInterval            = 20 * time.Millisecond // 分批补发时检查发送缓冲区的间隔
+
+	// DeviceUnknown 未指定设备类型的连接
+	DeviceUnknown   = "unknown"
+	maxDeviceLength = 20
+
+	maxRoomsPerClient = 50 // 单个连接最多加入的房间数
+	maxRoomLength     = 64
+)
+
+// 超出连接上限时的处理策略
+const (
+	OverflowKickOldest = "kick_oldest"
+	OverflowReject     = "reject"
+)
+
+// 发送缓冲区已满（客户端消费过慢）时的处理策略
+const (
+	SlowConsumerDisconnect = "disconnect"
+	SlowConsumerDrop       = "drop"
+)
+
+// 连接的传输方式
+const (
+	TransportWebSocket = "websocket"
+	TransportSSE       = "sse" // Server-Sent Events，仅下行，上行请求走 HTTP 接口
+)
+
+// ActionKicked 连接被服务端断开：同一用户的新连接超出上限，或连接使用的令牌被撤销
+const ActionKicked = "kicked"
+
+// ActionRoomLeft 连接被服务端移出房间，如用户已不是群成员
+const ActionRoomLeft = "room_left"
+
+var (
+	ErrTooManyConnections = errors.New("ws: too many connections")
+	ErrTooManyRooms       = errors.New("ws: too many rooms")
+	ErrInvalidRoom        = errors.New("ws: invalid room")
 )
 
-type HandlerFunc func(uid string, payload []byte)
+type HandlerFunc func(c *Client, payload []byte)
+
+// Observer 连接生命周期回调，如维护在线状态；Disconnected 在独立协程中调用
+type Observer interface {
+	Connected(c *Client)
+	Heartbeat(c *Client)
+	Disconnected(c *Client)
+}
 
-// Client 封装单个连接
+// Client 封装单个连接，同一用户的每个设备/标签页对应一个 Client
 type Client struct {
-	Hub     *Hub
-	Conn    *websocket.Conn
-	UID     string
-	Send    chan []byte // 缓冲发送通道，防止并发写 panic
-	handler HandlerFunc // 处理器回调
+	Hub         *Hub
+	Conn        *websocket.Conn // SSE 连接为 nil
+	Transport   string          // 传输方式：websocket / sse
+	ID          string          // 连接 ID，每次连接生成
+	UID         string
+	Device      string // 设备类型，如 web、ios、android
+	TokenID     string // 建立连接时使用的令牌 jti，令牌撤销时断开
+	ConnectedAt time.Time
+	Subprotocol string      // 握手协商的子协议：json / protobuf
+	send        chan frame  // 缓冲发送通道，防止并发写 panic
+	codec       codec       // 按子协议编解码
+	handler     HandlerFunc // 处理器回调
+
+	mu     sync.Mutex
+	closed bool
+
+	rooms map[string]struct{} // 已加入的房间，由 Hub.mu 保护
+
+	lastHeartbeat time.Time // 由 mu 保护
+}
+
+// frame 待写入连接的消息：未编码的为内部 JSON 消息，写入前按连接的子协议编码
+type frame struct {
+	data        []byte
+	encoded     bool
+	messageType int // encoded 时有效
 }
 
 // Hub 维护所有活跃连接
 type Hub struct {
-	clients sync.Map // key: UID, value: *Client
-	log     *log.Helper
+	mu      sync.RWMutex
+	users   map[string]map[string]*Client // UID -> 连接 ID -> 连接
+	clients map[string]*Client            // 连接 ID -> 连接
+	rooms   map[string]map[string]*Client // 房间 -> 连接 ID -> 连接
+
+	maxPerUser   int
+	maxPerDevice int
+	policy       string
+	sendBuffer   int
+	slowConsumer string
+
+	readLimit      int64
+	writeWait      time.Duration
+	pongWait       time.Duration
+	pingPeriod     time.Duration
+	compress       bool
+	compressLevel  int
+	compressMinLen int
+
+	// 集群模式，单机部署时 broker 为 nil，消息只在本实例投递
+	broker      Broker
+	presenceTTL time.Duration
+
+	observers []Observer
+	guards    map[string]AuthorizeFunc // 房间前缀 -> 投递前的授权复查
+
+	log *log.Helper
 }
 
-func NewHub(logger log.Logger) *Hub {
-	return &Hub{
-		log: log.NewHelper(log.With(logger, "module", "ws/hub")),
+func NewHub(c *conf.Server, broker Broker, logger log.Logger) *Hub {
+	h := &Hub{
+		users:        make(map[string]map[string]*Client),
+		clients:      make(map[string]*Client),
+		rooms:        make(map[string]map[string]*Client),
+		guards:       make(map[string]AuthorizeFunc),
+		maxPerUser:   defaultMaxConnectionsPerUser,
+		policy:       OverflowKickOldest,
+		sendBuffer:   sendBufferSize,
+		slowConsumer: SlowConsumerDisconnect,
+		broker:       broker,
+		presenceTTL:  defaultPresenceTTL,
+		log:          log.NewHelper(log.With(logger, "module", "ws/hub")),
+
+		readLimit:      defaultReadLimit,
+		writeWait:      defaultWriteWait,
+		pongWait:       defaultPongWait,
+		compressLevel:  defaultCompressionLevel,
+		compressMinLen: defaultCompressionThreshold,
 	}
+	if wc := c.Websocket; wc != nil {
+		if wc.MaxConnectionsPerUser > 0 {
+			h.maxPerUser = int(wc.MaxConnectionsPerUser)
+		}
+		h.maxPerDevice = int(wc.MaxConnectionsPerDevice)
+		if wc.OverflowPolicy == OverflowReject {
+			h.policy = OverflowReject
+		}
+		if wc.SendBufferSize > 0 {
+			h.sendBuffer = int(wc.SendBufferSize)
+		}
+		if wc.SlowConsumerPolicy == SlowConsumerDrop {
+			h.slowConsumer = SlowConsumerDrop
+		}
+		if cc := wc.Cluster; cc != nil && cc.PresenceTtl != nil && cc.PresenceTtl.AsDuration() > 0 {
+			h.presenceTTL = cc.PresenceTtl.AsDuration()
+		}
+		if wc.ReadLimit > 0 {
+			h.readLimit = wc.ReadLimit
+		}
+		if d := wc.WriteWait.AsDuration(); d > 0 {
+			h.writeWait = d
+		}
+		if d := wc.PongWait.AsDuration(); d > 0 {
+			h.pongWait = d
+		}
+		if d := wc.PingPeriod.AsDuration(); d > 0 && d < h.pongWait {
+			h.pingPeriod = d
+		}
+		if cc := wc.Compression; cc.GetEnabled() {
+			h.compress = true
+			if cc.Level >= flate.BestSpeed && cc.Level <= flate.BestCompression {
+				h.compressLevel = int(cc.Level)
+			}
+			if cc.Threshold > 0 {
+				h.compressMinLen = int(cc.Threshold)
+			}
+		}
+	}
+	if h.pingPeriod == 0 {
+		h.pingPeriod = h.pongWait * 9 / 10
+	}
+	return h
+}
+
+// Compression 是否启用 permessage-deflate，用于配置 Upgrader.EnableCompression
+func (h *Hub) Compression() bool {
+	return h.compress
 }
 
-// Register 注册并启动客户端监听
-func (h *Hub) Register(uid string, conn *websocket.Conn, handler HandlerFunc) {
-	client := &Client{
-		Hub:     h,
-		Conn:    conn,
-		UID:     uid,
-		Send:    make(chan []byte, 256),
-		handler: handler, // 注入处理器
+// Observe 注册连接生命周期回调，需在连接建立前（初始化时）调用
+func (h *Hub) Observe(o Observer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.observers = append(h.observers, o)
+}
+
+// GuardRoom 注册房间前缀投递前的授权复查，需在初始化时调用；用于授权随业务关系变化而失效的房间，
+// 如联系人的在线状态。每条房间消息投递前复查成员，无权限的用户被移出房间
+func (h *Hub) GuardRoom(prefix string, fn AuthorizeFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.guards[prefix] = fn
+}
+
+// Clustered 是否开启集群模式
+func (h *Hub) Clustered() bool {
+	return h.broker != nil
+}
+
+// NormalizeDevice 规范化客户端上报的设备类型
+func NormalizeDevice(device string) string {
+	device = strings.ToLower(strings.TrimSpace(device))
+	if device == "" || len(device) > maxDeviceLength {
+		return DeviceUnknown
+	}
+	return device
+}
+
+// Register 注册并启动客户端监听；超出连接上限时按策略断开最早的连接，或返回 ErrTooManyConnections
+func (h *Hub) Register(uid, device, tokenID string, conn *websocket.Conn, handler HandlerFunc) (*Client, error) {
+	client := h.newClient(uid, device, tokenID, TransportWebSocket, conn.Subprotocol())
+	client.Conn = conn
+	client.handler = handler // 注入处理器
+	if h.compress {
+		_ = conn.SetCompressionLevel(h.compressLevel)
+	}
+	if err := h.add(client); err != nil {
+		return nil, err
 	}
-	h.clients.Store(uid, client)
 
 	// 启动读写协程
 	go client.writePump()
 	go client.readPump()
+	return client, nil
+}
+
+// RegisterSSE 注册仅下行的 SSE 连接，与 WebSocket 连接共用连接上限、房间和推送；
+// 之后需在 HTTP 请求协程中调用 Client.ServeSSE 写出消息
+func (h *Hub) RegisterSSE(uid, device, tokenID string) (*Client, error) {
+	client := h.newClient(uid, device, tokenID, TransportSSE, SubprotocolJSON)
+	if err := h.add(client); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (h *Hub) newClient(uid, device, tokenID, transport, subprotocol string) *Client {
+	if subprotocol == "" {
+		subprotocol = SubprotocolJSON
+	}
+	return &Client{
+		Hub:         h,
+		Transport:   transport,
+		ID:          uuid.NewString(),
+		UID:         uid,
+		Device:      NormalizeDevice(device),
+		TokenID:     tokenID,
+		ConnectedAt: time.Now(),
+		Subprotocol: subprotocol,
+		send:        make(chan frame, h.sendBuffer),
+		codec:       newCodec(subprotocol),
+		rooms:       make(map[string]struct{}),
+	}
+}
+
+// add 将连接加入索引并通知 Observer，超出连接上限时按策略处理
+func (h *Hub) add(client *Client) error {
+	uid := client.UID
+	h.mu.Lock()
+	kicked, err := h.evict(client)
+	if err != nil {
+		h.mu.Unlock()
+		return err
+	}
+	if h.users[uid] == nil {
+		h.users[uid] = make(map[string]*Client)
+	}
+	h.users[uid][client.ID] = client
+	h.clients[client.ID] = client
+	first := len(h.users[uid]) == 1
+	h.mu.Unlock()
+
+	if first && h.broker != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), brokerTimeout)
+		if err := h.broker.SetOnline(ctx, []string{uid}); err != nil {
+			h.log.Errorf("记录用户所在实例失败: uid=%s err=%v", uid, err)
+		}
+		cancel()
+	}
+
+	for _, c := range kicked {
+		h.log.Infof("连接数超出上限，断开最早的连接: uid=%s conn=%s device=%s", uid, c.ID, c.Device)
+		c.trySend(NewMessage(ActionKicked, map[string]string{"reason": "too_many_connections", "conn_id": c.ID}))
+		c.close()
+		go h.notifyDisconnected(c)
+	}
+	client.lastHeartbeat = client.ConnectedAt
+	for _, o := range h.observers {
+		o.Connected(client)
+	}
+	return nil
+}
+
+// evict 计算新连接加入后需要断开的连接并从索引中移除，调用方持有写锁
+func (h *Hub) evict(client *Client) ([]*Client, error) {
+	conns := h.users[client.UID]
+	var sameDevice []*Client
+	all := make([]*Client, 0, len(conns))
+	for _, c := range conns {
+		all = append(all, c)
+		if c.Device == client.Device {
+			sameDevice = append(sameDevice, c)
+		}
+	}
+	overDevice := h.maxPerDevice > 0 && len(sameDevice) >= h.maxPerDevice
+	overUser := len(all) >= h.maxPerUser
+	if !overDevice && !overUser {
+		return nil, nil
+	}
+	if h.policy == OverflowReject {
+		return nil, ErrTooManyConnections
+	}
+
+	var kicked []*Client
+	if overDevice {
+		// 优先断开同一设备类型中最早的连接
+		sortByConnectedAt(sameDevice)
+		kicked = append(kicked, sameDevice[:len(sameDevice)-h.maxPerDevice+1]...)
+	}
+	if remaining := len(all) - len(kicked); remaining >= h.maxPerUser {
+		sortByConnectedAt(all)
+		for _, c := range all {
+			if remaining < h.maxPerUser {
+				break
+			}
+			if !containsClient(kicked, c) {
+				kicked = append(kicked, c)
+				remaining--
+			}
+		}
+	}
+	for _, c := range kicked {
+		h.remove(c)
+	}
+	return kicked, nil
+}
+
+// remove 从索引中移除连接，调用方持有写锁；仅当索引中的连接就是 c 时才移除
+func (h *Hub) remove(c *Client) bool {
+	if h.clients[c.ID] != c {
+		return false
+	}
+	delete(h.clients, c.ID)
+	if conns := h.users[c.UID]; conns != nil {
+		delete(conns, c.ID)
+		if len(conns) == 0 {
+			delete(h.users, c.UID)
+		}
+	}
+	// 断开时自动退出所有房间
+	for room := range c.rooms {
+		h.leave(c, room)
+	}
+	return true
+}
+
+// Join 连接加入房间，重复加入无副作用
+func (h *Hub) Join(c *Client, room string) error {
+	if room == "" || len(room) > maxRoomLength {
+		return ErrInvalidRoom
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.clients[c.ID] != c {
+		// 连接已注销
+		return nil
+	}
+	if _, ok := c.rooms[room]; ok {
+		return nil
+	}
+	if len(c.rooms) >= maxRoomsPerClient {
+		return ErrTooManyRooms
+	}
+	c.rooms[room] = struct{}{}
+	if h.rooms[room] == nil {
+		h.rooms[room] = make(map[string]*Client)
+	}
+	h.rooms[room][c.ID] = c
+	return nil
+}
+
+// Leave 连接退出房间
+func (h *Hub) Leave(c *Client, room string) {
+	h.mu.Lock()
+	h.leave(c, room)
+	h.mu.Unlock()
+}
+
+// leave 调用方持有写锁，房间为空时一并删除
+func (h *Hub) leave(c *Client, room string) {
+	delete(c.rooms, room)
+	if members := h.rooms[room]; members != nil {
+		delete(members, c.ID)
+		if len(members) == 0 {
+			delete(h.rooms, room)
+		}
+	}
+}
+
+// Rooms 连接已加入的房间
+func (h *Hub) Rooms(c *Client) []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	rooms := make([]string, 0, len(c.rooms))
+	for room := range c.rooms {
+		rooms = append(rooms, room)
+	}
+	sort.Strings(rooms)
+	return rooms
+}
+
+// RoomMembers 房间在本实例上的成员连接
+func (h *Hub) RoomMembers(room string) []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	members := make([]*Client, 0, len(h.rooms[room]))
+	for _, c := range h.rooms[room] {
+		members = append(members, c)
+	}
+	return members
+}
+
+// SendToRoom 广播给房间内的所有连接，集群模式下包括其他实例上的成员
+func (h *Hub) SendToRoom(room string, msg []byte) {
+	h.SendToRoomExcept(room, "", msg)
+}
+
+// SendToRoomExcept 广播给房间内除 exceptConnID 以外的所有连接，用于不回显发送者自己的消息
+func (h *Hub) SendToRoomExcept(room, exceptConnID string, msg []byte) {
+	env := &Envelope{Target: TargetRoom, Room: room, Except: exceptConnID, Msg: msg}
+	h.deliver(env)
+	if h.broker == nil {
+		return
+	}
+	env.From = h.broker.NodeID()
+	ctx, cancel := context.WithTimeout(context.Background(), brokerTimeout)
+	defer cancel()
+	if err := h.broker.Broadcast(ctx, env); err != nil {
+		h.log.Errorf("广播房间消息失败: room=%s err=%v", room, err)
+	}
 }
 
-func (h *Hub) Unregister(uid string) {
-	if client, ok := h.clients.LoadAndDelete(uid); ok {
-		close(client.(*Client).Send)
-		client.(*Client).Conn.Close()
+// Unregister 注销连接，只影响该连接本身，不影响同一用户的其他连接
+func (h *Hub) Unregister(c *Client) {
+	h.mu.Lock()
+	removed := h.remove(c)
+	last := removed && len(h.users[c.UID]) == 0
+	h.mu.Unlock()
+	c.close()
+	if removed {
+		go h.notifyDisconnected(c)
+	}
+
+	// 与并发的 Register 可能乱序，由 Run 中的定期续期兜底
+	if last && h.broker != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), brokerTimeout)
+		if err := h.broker.SetOffline(ctx, c.UID); err != nil {
+			h.log.Errorf("移除用户所在实例失败: uid=%s err=%v", c.UID, err)
+		}
+		cancel()
 	}
 }
 
+// Heartbeat 连接收到心跳（pong 或客户端 ping），按最小间隔通知 Observer
+func (h *Hub) Heartbeat(c *Client) {
+	c.mu.Lock()
+	if c.closed || time.Since(c.lastHeartbeat) < heartbeatInterval {
+		c.mu.Unlock()
+		return
+	}
+	c.lastHeartbeat = time.Now()
+	c.mu.Unlock()
+
+	for _, o := range h.observers {
+		o.Heartbeat(c)
+	}
+}
+
+func (h *Hub) notifyDisconnected(c *Client) {
+	for _, o := range h.observers {
+		o.Disconnected(c)
+	}
+}
+
+// SendToUser 发送给用户的所有连接，集群模式下包括其他实例上的连接
 func (h *Hub) SendToUser(uid string, msg []byte) {
-	if client, ok := h.clients.Load(uid); ok {
-		client.(*Client).Send <- msg
+	h.deliver(&Envelope{Target: TargetUser, UID: uid, Msg: msg})
+	h.forward(&Envelope{Target: TargetUser, UID: uid, Msg: msg})
+}
+
+// SendToConn 发送给本实例上的指定连接，连接不存在时返回 false
+func (h *Hub) SendToConn(connID string, msg []byte) bool {
+	h.mu.RLock()
+	c, ok := h.clients[connID]
+	h.mu.RUnlock()
+	if !ok {
+		return false
+	}
+	h.sendTo([]*Client{c}, msg)
+	return true
+}
+
+// SendToDevice 发送给用户指定设备类型的所有连接
+func (h *Hub) SendToDevice(uid, device string, msg []byte) {
+	env := &Envelope{Target: TargetDevice, UID: uid, Device: NormalizeDevice(device), Msg: msg}
+	h.deliver(env)
+	h.forward(env)
+}
+
+// SendToUserExcept 发送给用户除 exceptConnID 以外的所有连接，用于多端同步发送方自己的操作
+func (h *Hub) SendToUserExcept(uid, exceptConnID string, msg []byte) {
+	env := &Envelope{Target: TargetUser, UID: uid, Except: exceptConnID, Msg: msg}
+	h.deliver(env)
+	h.forward(env)
+}
+
+// CloseTokens 断开用户使用指定令牌建立的连接，tokenIDs 为空时断开用户的所有连接；集群模式下包括其他实例上的连接
+func (h *Hub) CloseTokens(uid string, tokenIDs []string) {
+	env := &Envelope{Target: TargetRevoke, UID: uid, Tokens: tokenIDs}
+	h.deliver(env)
+	h.forward(env)
+}
+
+// closeTokens 断开本实例上匹配的连接，断开前通知客户端原因
+func (h *Hub) closeTokens(uid string, tokenIDs []string) {
+	revoked := make(map[string]struct{}, len(tokenIDs))
+	for _, id := range tokenIDs {
+		revoked[id] = struct{}{}
+	}
+	clients := h.userClients(uid, func(c *Client) bool {
+		_, ok := revoked[c.TokenID]
+		return len(revoked) == 0 || ok
+	})
+	for _, c := range clients {
+		h.log.Infof("令牌已撤销，断开连接: uid=%s conn=%s device=%s", uid, c.ID, c.Device)
+		c.trySend(NewMessage(ActionKicked, map[string]string{"reason": "token_revoked", "conn_id": c.ID}))
+		h.Unregister(c)
+	}
+}
+
+// LeaveRoom 将用户的所有连接移出房间并通知客户端，用于房间授权失效时；集群模式下包括其他实例上的连接
+func (h *Hub) LeaveRoom(uid, room string) {
+	env := &Envelope{Target: TargetLeave, UID: uid, Room: room}
+	h.deliver(env)
+	h.forward(env)
+}
+
+// leaveRoom 将本实例上该用户已加入房间的连接移出
+func (h *Hub) leaveRoom(uid, room string) {
+	var left []*Client
+	h.mu.Lock()
+	for _, c := range h.users[uid] {
+		if _, ok := c.rooms[room]; ok {
+			h.leave(c, room)
+			left = append(left, c)
+		}
+	}
+	h.mu.Unlock()
+	for _, c := range left {
+		c.trySend(NewMessage(ActionRoomLeft, map[string]string{"room": room}))
+	}
+}
+
+// deliver 投递给本实例上匹配的连接
+func (h *Hub) deliver(env *Envelope) {
+	switch env.Target {
+	case TargetRevoke:
+		h.closeTokens(env.UID, env.Tokens)
+		return
+	case TargetLeave:
+		h.leaveRoom(env.UID, env.Room)
+		return
+	}
+	var clients []*Client
+	if env.Target == TargetRoom {
+		clients = h.guard(env.Room, h.RoomMembers(env.Room))
+	} else {
+		clients = h.userClients(env.UID, func(c *Client) bool {
+			return env.Target != TargetDevice || c.Device == env.Device
+		})
+	}
+	if env.Except != "" {
+		kept := clients[:0]
+		for _, c := range clients {
+			if c.ID != env.Except {
+				kept = append(kept, c)
+			}
+		}
+		clients = kept
+	}
+	h.sendTo(clients, env.Msg)
+}
+
+// guard 按房间前缀复查成员授权，只保留仍有权限的连接；复查出错时本次不投递给该用户，但不移出房间
+func (h *Hub) guard(room string, clients []*Client) []*Client {
+	prefix, _, _ := strings.Cut(room, ":")
+	h.mu.RLock()
+	fn := h.guards[prefix]
+	h.mu.RUnlock()
+	if fn == nil || len(clients) == 0 {
+		return clients
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), roomGuardTimeout)
+	defer cancel()
+	allowed := make(map[string]bool)
+	kept := clients[:0]
+	for _, c := range clients {
+		ok, checked := allowed[c.UID]
+		if !checked {
+			err := fn(ctx, c.UID, room)
+			ok = err == nil
+			allowed[c.UID] = ok
+			if errors.Is(err, ErrRoomForbidden) {
+				h.log.Infof("房间授权已失效，移出房间: uid=%s room=%s", c.UID, room)
+				h.leaveRoom(c.UID, room)
+			} else if err != nil {
+				h.log.Errorf("复查房间授权失败: uid=%s room=%s err=%v", c.UID, room, err)
+			}
+		}
+		if ok {
+			kept = append(kept, c)
+		}
+	}
+	return kept
+}
+
+// forward 集群模式下转发给用户所在的其他实例
+func (h *Hub) forward(env *Envelope) {
+	if h.broker == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), brokerTimeout)
+	defer cancel()
+
+	nodes, err := h.broker.Nodes(ctx, env.UID)
+	if err != nil {
+		h.log.Errorf("查询用户所在实例失败: uid=%s err=%v", env.UID, err)
+		return
+	}
+	self := h.broker.NodeID()
+	env.From = self
+	for _, node := range nodes {
+		if node == self {
+			continue
+		}
+		if err := h.broker.Publish(ctx, node, env); err != nil {
+			h.log.Errorf("转发消息失败: uid=%s node=%s err=%v", env.UID, node, err)
+		}
+	}
+}
+
+// Run 集群模式下接收其他实例转发的消息，并定期续期本实例在线用户的记录；由后台协程调用
+func (h *Hub) Run(ctx context.Context, _ string) error {
+	if h.broker == nil {
+		<-ctx.Done()
+		return nil
+	}
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go h.refreshPresence(runCtx)
+
+	return h.broker.Subscribe(runCtx, func(env *Envelope) {
+		if env.From == h.broker.NodeID() {
+			return
+		}
+		h.deliver(env)
+	})
+}
+
+// refreshPresence 续期本实例在线用户的记录，实例异常退出后记录随有效期过期
+func (h *Hub) refreshPresence(ctx context.Context) {
+	ticker := time.NewTicker(h.presenceTTL / 3)
+	defer ticker.Stop()
+	for {
+		if uids := h.onlineUIDs(); len(uids) > 0 {
+			refreshCtx, cancel := context.WithTimeout(ctx, brokerTimeout)
+			if err := h.broker.SetOnline(refreshCtx, uids); err != nil {
+				h.log.Errorf("续期用户所在实例失败: count=%d err=%v", len(uids), err)
+			}
+			cancel()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Hub) onlineUIDs() []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	uids := make([]string, 0, len(h.users))
+	for uid := range h.users {
+		uids = append(uids, uid)
+	}
+	return uids
+}
+
+// Clients 用户在本实例上的所有连接
+func (h *Hub) Clients(uid string) []*Client {
+	return h.userClients(uid, func(*Client) bool { return true })
+}
+
+// IsOnline 用户在本实例上是否有任一连接在线
+func (h *Hub) IsOnline(uid string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.users[uid]) > 0
+}
+
+func (h *Hub) userClients(uid string, match func(*Client) bool) []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	conns := h.users[uid]
+	result := make([]*Client, 0, len(conns))
+	for _, c := range conns {
+		if match(c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// sendTo 非阻塞发送，发送缓冲区已满时按策略断开连接或丢弃消息，避免拖慢其他连接
+func (h *Hub) sendTo(clients []*Client, msg []byte) {
+	for _, c := range clients {
+		h.enqueue(c, frame{data: msg})
+	}
+}
+
+// enqueue 写入连接的发送缓冲区，缓冲区已满时按策略丢弃或断开
+func (h *Hub) enqueue(c *Client, f frame) {
+	if c.tryEnqueue(f) {
+		return
+	}
+	if h.slowConsumer == SlowConsumerDrop {
+		h.log.Warnf("发送缓冲区已满，丢弃消息: uid=%s conn=%s", c.UID, c.ID)
+		return
+	}
+	h.log.Warnf("发送缓冲区已满，断开连接: uid=%s conn=%s", c.UID, c.ID)
+	h.Unregister(c)
+}
+
+// Reply 按连接的子协议编码请求的响应并发送，resp 为 proto 消息时 protobuf 连接收到二进制编码
+func (c *Client) Reply(action, seq string, resp interface{}, err error) {
+	messageType, data := c.codec.encodeReply(action, seq, resp, err)
+	c.Hub.enqueue(c, frame{data: data, encoded: true, messageType: messageType})
+}
+
+// trySend 写入发送缓冲区，连接已关闭时忽略，缓冲区已满时返回 false
+func (c *Client) trySend(msg []byte) bool {
+	return c.tryEnqueue(frame{data: msg})
+}
+
+func (c *Client) tryEnqueue(f frame) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return true
+	}
+	select {
+	case c.send <- f:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitDrained 等待发送缓冲区中的消息降到 n 条以内，连接关闭或 ctx 结束时返回 false
+func (c *Client) waitDrained(ctx context.Context, n int) bool {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for len(c.send) > n {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+		if c.isClosed() {
+			return false
+		}
+	}
+	return !c.isClosed()
+}
+
+func (c *Client) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+// close 关闭发送通道，writePump（SSE 连接为 ServeSSE）发送完缓冲区中的消息后关闭连接
+func (c *Client) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.closed = true
+		close(c.send)
 	}
 }
 
 // readPump 从连接读取消息并处理（心跳处理核心）
 func (c *Client) readPump() {
 	defer func() {
-		c.Hub.Unregister(c.UID)
+		c.Hub.Unregister(c)
 	}()
 
-	c.Conn.SetReadLimit(maxMessageSize)
+	pongWait := c.Hub.pongWait
+	c.Conn.SetReadLimit(c.Hub.readLimit)
 	_ = c.Conn.SetReadDeadline(time.Now().Add(pongWait))
 	c.Conn.SetPongHandler(func(string) error {
 		_ = c.Conn.SetReadDeadline(time.Now().Add(pongWait))
+		c.Hub.Heartbeat(c)
 		return nil
 	})
 
@@ -87,14 +854,15 @@ func (c *Client) readPump() {
 		}
 		// 调用分发器
 		if c.handler != nil {
-			c.handler(c.UID, payload)
+			c.handler(c, payload)
 		}
 	}
 }
 
-// writePump 将消息从通道写回连接（解决并发写问题）
+// writePump 将消息从通道写回连接（解决并发写问题），写入前按子协议编码，超过阈值的消息压缩
 func (c *Client) writePump() {
-	ticker := time.NewTicker(pingPeriod)
+	h := c.Hub
+	ticker := time.NewTicker(h.pingPeriod)
 	defer func() {
 		ticker.Stop()
 		c.Conn.Close()
@@ -102,20 +870,44 @@ func (c *Client) writePump() {
 
 	for {
 		select {
-		case message, ok := <-c.Send:
-			_ = c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
+		case f, ok := <-c.send:
+			_ = c.Conn.SetWriteDeadline(time.Now().Add(h.writeWait))
 			if !ok {
 				_ = c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
 				return
 			}
-			if err := c.Conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			messageType, data := f.messageType, f.data
+			if !f.encoded {
+				var err error
+				if messageType, data, err = c.codec.encode(f.data); err != nil {
+					h.log.Errorf("编码消息失败: uid=%s conn=%s err=%v", c.UID, c.ID, err)
+					continue
+				}
+			}
+			if h.compress {
+				c.Conn.EnableWriteCompression(len(data) >= h.compressMinLen)
+			}
+			if err := c.Conn.WriteMessage(messageType, data); err != nil {
 				return
 			}
 		case <-ticker.C:
-			_ = c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
+			_ = c.Conn.SetWriteDeadline(time.Now().Add(h.writeWait))
 			if err := c.Conn.WriteMessage(websocket.PingMessage, nil); err != nil {
 				return
 			}
 		}
 	}
 }
+
+func sortByConnectedAt(clients []*Client) {
+	sort.Slice(clients, func(i, j int) bool { return clients[i].ConnectedAt.Before(clients[j].ConnectedAt) })
+}
+
+func containsClient(clients []*Client, c *Client) bool {
+	for _, k := range clients {
+		if k == c {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,284 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/gorilla/websocket"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+const testTimeout = 2 * time.Second
+
+// testNode 挂在 MemoryBus 上的一个实例
+type testNode struct {
+	hub        *Hub
+	srv        *httptest.Server
+	registered chan *Client
+}
+
+// newTestCluster 在同一进程中以多个 Hub 模拟集群
+func newTestCluster(t *testing.T, nodes ...string) (*MemoryBus, map[string]*testNode) {
+	t.Helper()
+	bus := NewMemoryBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	cluster := make(map[string]*testNode, len(nodes))
+	for _, node := range nodes {
+		n := &testNode{
+			hub:        NewHub(&conf.Server{}, bus.Broker(node), log.NewStdLogger(io.Discard)),
+			registered: make(chan *Client, 1),
+		}
+		upgrader := websocket.Upgrader{}
+		n.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			q := r.URL.Query()
+			c, err := n.hub.Register(q.Get("uid"), q.Get("device"), q.Get("token"), conn, nil)
+			if err != nil {
+				_ = conn.Close()
+				return
+			}
+			n.registered <- c
+		}))
+		t.Cleanup(n.srv.Close)
+		go func() { _ = n.hub.Run(ctx, "") }()
+		cluster[node] = n
+	}
+	eventually(t, func() bool {
+		bus.mu.RLock()
+		defer bus.mu.RUnlock()
+		return len(bus.subs) == len(nodes)
+	})
+	return bus, cluster
+}
+
+// dial 以 uid 及令牌 jti 建立连接，返回客户端连接及服务端的 Client
+func (n *testNode) dial(t *testing.T, uid, token string) (*websocket.Conn, *Client) {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(n.srv.URL, "http") + "/?uid=" + uid + "&device=web&token=" + token
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	select {
+	case c := <-n.registered:
+		return conn, c
+	case <-time.After(testTimeout):
+		t.Fatal("register timeout")
+		return nil, nil
+	}
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) Message {
+	t.Helper()
+	_ = conn.SetReadDeadline(time.Now().Add(testTimeout))
+	_, data, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	var msg Message
+	if err := json.Unmarshal(data, &msg); err != nil {
+		t.Fatalf("unmarshal %s: %v", data, err)
+	}
+	return msg
+}
+
+func eventually(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(testTimeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func nodesOf(t *testing.T, bus *MemoryBus, uid string) []string {
+	t.Helper()
+	nodes, err := bus.Broker("").Nodes(context.Background(), uid)
+	if err != nil {
+		t.Fatalf("nodes: %v", err)
+	}
+	return nodes
+}
+
+func TestHub_SendToUserAcrossNodes(t *testing.T) {
+	_, cluster := newTestCluster(t, "a", "b")
+	onA, _ := cluster["a"].dial(t, "1", "t1")
+	onB, _ := cluster["b"].dial(t, "1", "t2")
+
+	cluster["a"].hub.SendToUser("1", NewMessage("hello", map[string]string{"k": "v"}))
+
+	for name, conn := range map[string]*websocket.Conn{"a": onA, "b": onB} {
+		msg := readMessage(t, conn)
+		if msg.Action != "hello" || string(msg.Data) != `{"k":"v"}` {
+			t.Errorf("node %s got %+v", name, msg)
+		}
+	}
+}
+
+func TestHub_RoomBroadcastAcrossNodes(t *testing.T) {
+	_, cluster := newTestCluster(t, "a", "b")
+	onA, ca := cluster["a"].dial(t, "1", "t1")
+	onB, cb := cluster["b"].dial(t, "2", "t2")
+	if err := cluster["a"].hub.Join(ca, "group:42"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cluster["b"].hub.Join(cb, "group:42"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 发送者自己的连接被排除，只有另一实例上的成员收到
+	cluster["a"].hub.SendToRoomExcept("group:42", ca.ID, NewMessage("room", nil))
+	cluster["a"].hub.SendToRoom("group:42", NewMessage("room_all", nil))
+
+	if msg := readMessage(t, onB); msg.Action != "room" {
+		t.Errorf("node b got %+v, want room", msg)
+	}
+	if msg := readMessage(t, onB); msg.Action != "room_all" {
+		t.Errorf("node b got %+v, want room_all", msg)
+	}
+	if msg := readMessage(t, onA); msg.Action != "room_all" {
+		t.Errorf("node a got %+v, want room_all", msg)
+	}
+}
+
+func TestHub_CloseTokensAcrossNodes(t *testing.T) {
+	bus, cluster := newTestCluster(t, "a", "b")
+	onA, _ := cluster["a"].dial(t, "1", "keep")
+	onB, _ := cluster["b"].dial(t, "1", "revoked")
+
+	cluster["a"].hub.CloseTokens("1", []string{"revoked"})
+
+	msg := readMessage(t, onB)
+	if msg.Action != ActionKicked || !strings.Contains(string(msg.Data), "token_revoked") {
+		t.Fatalf("node b got %+v, want kicked", msg)
+	}
+	_ = onB.SetReadDeadline(time.Now().Add(testTimeout))
+	if _, _, err := onB.ReadMessage(); err == nil {
+		t.Fatal("revoked connection still open")
+	}
+	eventually(t, func() bool { return !cluster["b"].hub.IsOnline("1") })
+	if !cluster["a"].hub.IsOnline("1") {
+		t.Fatal("connection with other token closed")
+	}
+	if nodes := nodesOf(t, bus, "1"); len(nodes) != 1 || nodes[0] != "a" {
+		t.Fatalf("nodes = %v, want [a]", nodes)
+	}
+
+	// 未指定令牌时断开用户的所有连接
+	cluster["b"].hub.CloseTokens("1", nil)
+	if msg := readMessage(t, onA); msg.Action != ActionKicked {
+		t.Fatalf("node a got %+v, want kicked", msg)
+	}
+	eventually(t, func() bool { return !cluster["a"].hub.IsOnline("1") })
+}
+
+func TestHub_UnregisterClearsPresence(t *testing.T) {
+	bus, cluster := newTestCluster(t, "a", "b")
+	onA, _ := cluster["a"].dial(t, "1", "t1")
+	_, cb := cluster["b"].dial(t, "1", "t2")
+	eventually(t, func() bool { return len(nodesOf(t, bus, "1")) == 2 })
+
+	// 客户端断开后由 readPump 注销
+	_ = onA.Close()
+	eventually(t, func() bool {
+		nodes := nodesOf(t, bus, "1")
+		return len(nodes) == 1 && nodes[0] == "b"
+	})
+
+	cluster["b"].hub.Unregister(cb)
+	eventually(t, func() bool { return len(nodesOf(t, bus, "1")) == 0 })
+	if cluster["b"].hub.IsOnline("1") {
+		t.Fatal("user still online on node b")
+	}
+}
+
+func TestHub_LeaveRoomAcrossNodes(t *testing.T) {
+	_, cluster := newTestCluster(t, "a", "b")
+	onA, ca := cluster["a"].dial(t, "1", "t1")
+	onB, cb := cluster["b"].dial(t, "2", "t2")
+	if err := cluster["a"].hub.Join(ca, "group:42"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cluster["b"].hub.Join(cb, "group:42"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 在实例 a 上移出实例 b 上的用户
+	cluster["a"].hub.LeaveRoom("2", "group:42")
+	msg := readMessage(t, onB)
+	if msg.Action != ActionRoomLeft || string(msg.Data) != `{"room":"group:42"}` {
+		t.Fatalf("node b got %+v, want room_left", msg)
+	}
+	if rooms := cluster["b"].hub.Rooms(cb); len(rooms) != 0 {
+		t.Fatalf("rooms = %v, want none", rooms)
+	}
+
+	// 被移出的连接不再收到房间消息，其他成员不受影响
+	cluster["b"].hub.SendToRoom("group:42", NewMessage("room", nil))
+	if msg := readMessage(t, onA); msg.Action != "room" {
+		t.Errorf("node a got %+v, want room", msg)
+	}
+	cluster["b"].hub.SendToUser("2", NewMessage("direct", nil))
+	if msg := readMessage(t, onB); msg.Action != "direct" {
+		t.Errorf("node b got %+v, want direct", msg)
+	}
+}
+
+func TestHub_GuardRoomRechecksOnDelivery(t *testing.T) {
+	_, cluster := newTestCluster(t, "a", "b")
+	var revoked atomic.Value
+	revoked.Store("")
+	guard := func(_ context.Context, uid, _ string) error {
+		if uid == revoked.Load() {
+			return ErrRoomForbidden
+		}
+		return nil
+	}
+	cluster["a"].hub.GuardRoom("presence", guard)
+	cluster["b"].hub.GuardRoom("presence", guard)
+
+	onA, ca := cluster["a"].dial(t, "1", "t1")
+	onB, cb := cluster["b"].dial(t, "2", "t2")
+	if err := cluster["a"].hub.Join(ca, "presence:9"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cluster["b"].hub.Join(cb, "presence:9"); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster["a"].hub.SendToRoom("presence:9", NewMessage("online", nil))
+	for name, conn := range map[string]*websocket.Conn{"a": onA, "b": onB} {
+		if msg := readMessage(t, conn); msg.Action != "online" {
+			t.Errorf("node %s got %+v, want online", name, msg)
+		}
+	}
+
+	// 订阅后授权失效，下一次推送时移出房间而不是投递
+	revoked.Store("2")
+	cluster["a"].hub.SendToRoom("presence:9", NewMessage("offline", nil))
+	if msg := readMessage(t, onB); msg.Action != ActionRoomLeft {
+		t.Fatalf("node b got %+v, want room_left", msg)
+	}
+	if msg := readMessage(t, onA); msg.Action != "offline" {
+		t.Errorf("node a got %+v, want offline", msg)
+	}
+	if rooms := cluster["b"].hub.Rooms(cb); len(rooms) != 0 {
+		t.Fatalf("rooms = %v, want none", rooms)
+	}
+}
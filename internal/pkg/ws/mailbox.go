@@ -0,0 +1,349 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+const (
+	// ActionAck 客户端确认已收到的消息，Seq 为已连续收到的最大序号
+	ActionAck = "ack"
+	// ActionResumed 重连补发完成
+	ActionResumed = "resumed"
+
+	defaultRetryInterval = 5 * time.Second
+	defaultMaxRetries    = 5
+	defaultMailboxSize   = 500
+)
+
+// StoredMessage 信箱中的一条消息
+type StoredMessage struct {
+	Seq int64
+	Msg []byte
+}
+
+// MailboxStore 可靠消息的存储：按用户分配递增序号，保留最近的消息用于重发和重连补发
+type MailboxStore interface {
+	// NextSeq 分配用户的下一个序号
+	NextSeq(ctx context.Context, uid string) (int64, error)
+	// Save 保存消息，超出保留数量或时长的旧消息被清理
+	Save(ctx context.Context, uid string, seq int64, msg []byte) error
+	// Pending 序号大于 afterSeq 的消息，按序号升序
+	Pending(ctx context.Context, uid string, afterSeq int64, limit int) ([]StoredMessage, error)
+	// LastSeqs 用户已分配的最大序号
+	LastSeqs(ctx context.Context, uids []string) (map[string]int64, error)
+	// Ack 记录用户某设备类型已确认的最大序号，只增不减
+	Ack(ctx context.Context, uid, device string, seq int64) error
+	// Acked 用户某设备类型已确认的最大序号，无记录时为 0
+	Acked(ctx context.Context, uid, device string) (int64, error)
+}
+
+// ackState 可靠连接的确认状态
+type ackState struct {
+	client       *Client
+	acked        int64
+	waitingSince time.Time // 开始等待确认的时间，无未确认消息时为零值
+	attempts     int
+	skipped      map[int64]struct{} // 排除该连接发送的序号（如发送者自己的消息），不重发，确认到其前一条时一并视为已确认
+}
+
+// advance 已确认序号之后紧接着被排除的序号一并视为已确认，调用方持有锁
+func (st *ackState) advance() {
+	for {
+		if _, ok := st.skipped[st.acked+1]; !ok {
+			break
+		}
+		delete(st.skipped, st.acked+1)
+		st.acked++
+	}
+	for seq := range st.skipped {
+		if seq <= st.acked {
+			delete(st.skipped, seq)
+		}
+	}
+}
+
+// Mailbox 可靠投递：消息带序号发送并存入信箱，可靠连接（ack=1）确认前定期重发，重连后从最后确认的序号补发；
+// 其他连接照常收到消息，但不重发
+type Mailbox struct {
+	hub   *Hub
+	store MailboxStore
+
+	retryInterval time.Duration
+	maxRetries    int
+	size          int
+
+	mu     sync.Mutex
+	states map[string]*ackState // 连接 ID -> 确认状态
+
+	log *log.Helper
+}
+
+func NewMailbox(hub *Hub, store MailboxStore, c *conf.Server, logger log.Logger) *Mailbox {
+	m := &Mailbox{
+		hub:           hub,
+		store:         store,
+		retryInterval: defaultRetryInterval,
+		maxRetries:    defaultMaxRetries,
+		size:          defaultMailboxSize,
+		states:        make(map[string]*ackState),
+		log:           log.NewHelper(log.With(logger, "module", "ws/mailbox")),
+	}
+	if wc := c.Websocket; wc != nil && wc.Delivery != nil {
+		d := wc.Delivery
+		if d.RetryInterval != nil && d.RetryInterval.AsDuration() > 0 {
+			m.retryInterval = d.RetryInterval.AsDuration()
+		}
+		if d.MaxRetries > 0 {
+			m.maxRetries = int(d.MaxRetries)
+		}
+		if d.MailboxSize > 0 {
+			m.size = int(d.MailboxSize)
+		}
+	}
+	hub.Observe(m)
+	return m
+}
+
+// Send 可靠发送给用户的所有连接，用户离线时消息留在信箱中等待重连补发
+func (m *Mailbox) Send(ctx context.Context, uid, action string, data interface{}) (int64, error) {
+	return m.send(ctx, uid, "", action, data)
+}
+
+// SendExcept 可靠发送给用户除 exceptConnID 以外的所有连接，用于多端同步发送方自己的操作
+func (m *Mailbox) SendExcept(ctx context.Context, uid, exceptConnID, action string, data interface{}) (int64, error) {
+	return m.send(ctx, uid, exceptConnID, action, data)
+}
+
+func (m *Mailbox) send(ctx context.Context, uid, except, action string, data interface{}) (int64, error) {
+	seq, err := m.store.NextSeq(ctx, uid)
+	if err == nil {
+		msg := newSeqMessage(action, data, seq)
+		if err = m.store.Save(ctx, uid, seq, msg); err == nil {
+			if except != "" {
+				m.skip(except, seq)
+			}
+			m.hub.SendToUserExcept(uid, except, msg)
+			return seq, nil
+		}
+	}
+	// 信箱不可用时退化为尽力投递
+	m.log.Errorf("保存可靠消息失败，改为直接发送: uid=%s action=%s err=%v", uid, action, err)
+	m.hub.SendToUserExcept(uid, except, NewMessage(action, data))
+	return 0, err
+}
+
+// skip 被排除的连接不会收到该序号：记录下来，重发时跳过，确认到其前一条时视为已确认
+func (m *Mailbox) skip(connID string, seq int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st := m.states[connID]
+	if st == nil || seq <= st.acked {
+		return
+	}
+	if st.skipped == nil {
+		st.skipped = make(map[int64]struct{})
+	}
+	st.skipped[seq] = struct{}{}
+	st.advance()
+}
+
+// Resume 连接以可靠模式加入，补发 lastSeq 之后的消息；lastSeq 小于 0 时使用该设备类型最后确认的序号
+func (m *Mailbox) Resume(ctx context.Context, c *Client, lastSeq int64) error {
+	if lastSeq < 0 {
+		acked, err := m.store.Acked(ctx, c.UID, c.Device)
+		if err != nil {
+			return err
+		}
+		lastSeq = acked
+	}
+
+	m.mu.Lock()
+	m.states[c.ID] = &ackState{client: c, acked: lastSeq}
+	m.mu.Unlock()
+	if c.isClosed() {
+		// 连接在加入前已断开，Disconnected 可能先于此执行
+		m.Disconnected(c)
+		return nil
+	}
+	return m.Replay(ctx, c, lastSeq)
+}
+
+// Replay 补发 lastSeq 之后的消息，不等待确认也不重发；用于 SSE 等重连时自带断点（Last-Event-ID）的连接。
+// 消息按发送缓冲区分批写入，每批写出后再发送下一批，避免一次性写满缓冲区被当作慢消费者断开；
+// 调用方需与连接的写出并行执行
+func (m *Mailbox) Replay(ctx context.Context, c *Client, lastSeq int64) error {
+	window := m.window()
+	after := lastSeq
+	count := 0
+	truncated := false
+	for count < m.size {
+		pending, err := m.store.Pending(ctx, c.UID, after, window)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			// 信箱已清理掉部分消息时提示客户端通过接口拉取历史
+			truncated = len(pending) > 0 && pending[0].Seq > lastSeq+1
+		}
+		for _, p := range pending {
+			m.hub.SendToConn(c.ID, p.Msg)
+		}
+		count += len(pending)
+		if len(pending) < window {
+			break
+		}
+		after = pending[len(pending)-1].Seq
+		if !c.waitDrained(ctx, window) {
+			return nil
+		}
+	}
+	m.hub.SendToConn(c.ID, NewMessage(ActionResumed, map[string]interface{}{
+		"from":      lastSeq,
+		"count":     count,
+		"truncated": truncated,
+	}))
+	return nil
+}
+
+// window 单批补发或重发的消息数，不超过发送缓冲区的一半，为实时消息留出空间
+func (m *Mailbox) window() int {
+	return max(1, min(m.size, m.hub.sendBuffer/2))
+}
+
+// Ack 处理客户端确认，seq 为已连续收到的最大序号
+func (m *Mailbox) Ack(ctx context.Context, c *Client, seq int64) {
+	m.mu.Lock()
+	st := m.states[c.ID]
+	if st == nil || seq <= st.acked {
+		m.mu.Unlock()
+		return
+	}
+	st.acked = seq
+	st.advance()
+	seq = st.acked
+	st.waitingSince = time.Time{}
+	st.attempts = 0
+	m.mu.Unlock()
+
+	if err := m.store.Ack(ctx, c.UID, c.Device, seq); err != nil {
+		m.log.Errorf("保存确认序号失败: uid=%s device=%s seq=%d err=%v", c.UID, c.Device, seq, err)
+	}
+}
+
+// Run 定期重发未确认的消息，由后台协程调用
+func (m *Mailbox) Run(ctx context.Context, _ string) error {
+	ticker := time.NewTicker(m.retryInterval / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.retry(ctx)
+		}
+	}
+}
+
+func (m *Mailbox) retry(ctx context.Context) {
+	m.mu.Lock()
+	states := make([]*ackState, 0, len(m.states))
+	uids := make([]string, 0, len(m.states))
+	seen := make(map[string]struct{})
+	for _, st := range m.states {
+		states = append(states, st)
+		if _, ok := seen[st.client.UID]; !ok {
+			seen[st.client.UID] = struct{}{}
+			uids = append(uids, st.client.UID)
+		}
+	}
+	m.mu.Unlock()
+	if len(states) == 0 {
+		return
+	}
+
+	latest, err := m.store.LastSeqs(ctx, uids)
+	if err != nil {
+		m.log.Errorf("查询最新序号失败: %v", err)
+		return
+	}
+	now := time.Now()
+	for _, st := range states {
+		c := st.client
+		m.mu.Lock()
+		acked := st.acked
+		due := false
+		kick := false
+		switch {
+		case acked >= latest[c.UID]:
+			st.waitingSince = time.Time{}
+			st.attempts = 0
+		case st.waitingSince.IsZero():
+			st.waitingSince = now
+		case now.Sub(st.waitingSince) >= m.retryInterval:
+			if st.attempts >= m.maxRetries {
+				kick = true
+			} else {
+				st.attempts++
+				st.waitingSince = now
+				due = true
+			}
+		}
+		m.mu.Unlock()
+
+		if kick {
+			m.log.Warnf("多次重发未确认，断开连接: uid=%s conn=%s acked=%d", c.UID, c.ID, acked)
+			m.hub.Unregister(c)
+			continue
+		}
+		if !due {
+			continue
+		}
+		// 每次只重发一批，其余的在之后的重发中继续
+		pending, err := m.store.Pending(ctx, c.UID, acked, m.window())
+		if err != nil {
+			m.log.Errorf("查询未确认消息失败: uid=%s err=%v", c.UID, err)
+			continue
+		}
+		m.mu.Lock()
+		skipped := make(map[int64]struct{}, len(st.skipped))
+		for seq := range st.skipped {
+			skipped[seq] = struct{}{}
+		}
+		m.mu.Unlock()
+		for _, p := range pending {
+			if _, ok := skipped[p.Seq]; ok {
+				continue
+			}
+			m.hub.SendToConn(c.ID, p.Msg)
+		}
+	}
+}
+
+// Connected 连接默认不可靠，由 Resume 显式加入
+func (m *Mailbox) Connected(*Client) {}
+
+func (m *Mailbox) Heartbeat(*Client) {}
+
+func (m *Mailbox) Disconnected(c *Client) {
+	m.mu.Lock()
+	delete(m.states, c.ID)
+	m.mu.Unlock()
+}
+
+// newSeqMessage 构造带序号的消息
+func newSeqMessage(action string, data interface{}, seq int64) []byte {
+	payload, _ := json.Marshal(data)
+	msg, _ := json.Marshal(Message{
+		Action: action,
+		Data:   payload,
+		Seq:    strconv.FormatInt(seq, 10),
+	})
+	return msg
+}
@@ -1,12 +1,25 @@
 package ws
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+)
 
 // Message 统一的消息格式
 type Message struct {
-	Action string          `json:"action"`        // 业务动作，如 "chat", "ping", "subscribe"
-	Data   json.RawMessage `json:"data"`          // 业务数据
-	Seq    string          `json:"seq,omitempty"` // 序列号，用于客户端匹配请求(可选)
+	Action string          `json:"action"`          // 业务动作，如 "chat", "ping", "subscribe", "unsubscribe"
+	Data   json.RawMessage `json:"data"`            // 业务数据
+	Seq    string          `json:"seq,omitempty"`   // 序列号，用于客户端匹配请求(可选)
+	Error  *Error          `json:"error,omitempty"` // 请求失败时的错误，与 HTTP 接口的 Kratos 错误一致
+}
+
+// Error 响应中的错误
+type Error struct {
+	Code     int32             `json:"code"`
+	Reason   string            `json:"reason,omitempty"`
+	Message  string            `json:"message"`
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
 // NewMessage 构造响应消息
@@ -20,3 +33,29 @@ func NewMessage(action string, data interface{}) []byte {
 	})
 	return finalMsg
 }
+
+// NewReply 构造请求的响应，带回请求的 seq；err 非空时只返回错误
+func NewReply(action, seq string, data json.RawMessage, err error) []byte {
+	msg := Message{Action: action, Seq: seq, Data: data}
+	if err != nil {
+		msg.Data = nil
+		msg.Error = toError(err)
+	}
+	b, _ := json.Marshal(msg)
+	return b
+}
+
+// toError 转换为响应中的错误，非业务错误（5xx）不向客户端暴露内部信息
+func toError(err error) *Error {
+	se := kerrors.FromError(err)
+	e := &Error{
+		Code:     se.Code,
+		Reason:   se.Reason,
+		Message:  se.Message,
+		Metadata: se.Metadata,
+	}
+	if se.Code >= 500 && se.Reason == "" {
+		e.Message = "服务器内部错误"
+	}
+	return e
+}
@@ -0,0 +1,128 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	ErrRateLimited = kerrors.BadRequest("WS_RATE_LIMITED", "请求过于频繁，请稍后再试")
+	ErrInternal    = kerrors.InternalServer("WS_INTERNAL", "服务器内部错误")
+)
+
+// Recovery 捕获处理器中的 panic，返回 ErrInternal
+func Recovery(logger log.Logger) Middleware {
+	l := log.NewHelper(log.With(logger, "module", "ws/recovery"))
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (resp interface{}, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					buf := make([]byte, 64<<10)
+					buf = buf[:runtime.Stack(buf, false)]
+					l.Errorf("panic: action=%s uid=%s err=%v\n%s", req.Action, req.Client.UID, r, buf)
+					resp, err = nil, ErrInternal
+				}
+			}()
+			return next(ctx, req)
+		}
+	}
+}
+
+// Logging 记录每次请求的动作、用户、耗时及错误，服务端错误以 Error 级别记录
+func Logging(logger log.Logger) Middleware {
+	l := log.NewHelper(log.With(logger, "module", "ws/access"))
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (interface{}, error) {
+			start := time.Now()
+			resp, err := next(ctx, req)
+			latency := time.Since(start)
+			if err == nil {
+				l.Debugf("action=%s uid=%s conn=%s seq=%s latency=%s", req.Action, req.Client.UID, req.Client.ID, req.Seq, latency)
+				return resp, nil
+			}
+			se := kerrors.FromError(err)
+			if se.Code >= 500 {
+				l.Errorf("action=%s uid=%s conn=%s seq=%s latency=%s code=%d reason=%s err=%v", req.Action, req.Client.UID, req.Client.ID, req.Seq, latency, se.Code, se.Reason, err)
+			} else {
+				l.Infof("action=%s uid=%s conn=%s seq=%s latency=%s code=%d reason=%s", req.Action, req.Client.UID, req.Client.ID, req.Seq, latency, se.Code, se.Reason)
+			}
+			return resp, err
+		}
+	}
+}
+
+// Auth 鉴权中间件，check 返回错误时拒绝请求，可返回新的 context（如注入用户身份）
+func Auth(check func(ctx context.Context, c *Client) (context.Context, error)) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (interface{}, error) {
+			ctx, err := check(ctx, req.Client)
+			if err != nil {
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// RateLimit 按连接及动作的固定窗口限流，每个窗口最多 limit 次请求；limit 或 window 不大于 0 时不限制
+func RateLimit(limit int, window time.Duration) Middleware {
+	if limit <= 0 || window <= 0 {
+		return func(next Handler) Handler { return next }
+	}
+	l := &rateLimiter{limit: limit, window: window, windows: make(map[string]*rateWindow)}
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (interface{}, error) {
+			if !l.allow(fmt.Sprintf("%s:%s", req.Client.ID, req.Action), time.Now()) {
+				return nil, ErrRateLimited
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// 窗口数超过该值时清理已过期的窗口（对应已断开的连接）
+const rateLimiterSweepSize = 1024
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+type rateLimiter struct {
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	windows   map[string]*rateWindow
+	lastSweep time.Time
+}
+
+func (l *rateLimiter) allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.windows) > rateLimiterSweepSize && now.Sub(l.lastSweep) > l.window {
+		for k, w := range l.windows {
+			if now.Sub(w.start) >= l.window {
+				delete(l.windows, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		l.windows[key] = &rateWindow{start: now, count: 1}
+		return true
+	}
+	if w.count >= l.limit {
+		return false
+	}
+	w.count++
+	return true
+}
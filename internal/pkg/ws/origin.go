@@ -0,0 +1,40 @@
+package ws
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// CheckOrigin 按允许列表检查握手请求的 Origin，用于 websocket.Upgrader.CheckOrigin：
+// 列表项支持通配符（path.Match 语法，如 https://*.example.com、http://localhost:*），"*" 允许所有；
+// 列表为空时只允许同源；不带 Origin 的请求（非浏览器客户端）始终允许
+func CheckOrigin(allowed []string) func(r *http.Request) bool {
+	patterns := make([]string, 0, len(allowed))
+	for _, p := range allowed {
+		if p = strings.ToLower(strings.TrimRight(strings.TrimSpace(p), "/")); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		if len(patterns) == 0 {
+			u, err := url.Parse(origin)
+			return err == nil && strings.EqualFold(u.Host, r.Host)
+		}
+		origin = strings.ToLower(origin)
+		for _, p := range patterns {
+			if p == "*" || p == origin {
+				return true
+			}
+			if ok, _ := path.Match(p, origin); ok {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,130 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrActionNotFound = kerrors.NotFound("WS_ACTION_NOT_FOUND", "不支持的动作")
+	ErrBadRequest     = kerrors.BadRequest("WS_BAD_REQUEST", "请求格式错误")
+)
+
+var (
+	protoUnmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+	protoMarshal   = protojson.MarshalOptions{EmitUnpopulated: true}
+)
+
+// Request 客户端发来的一次请求
+type Request struct {
+	Client *Client
+	Action string
+	Seq    string // 客户端请求序号，响应原样带回
+	Data   json.RawMessage
+
+	binary bool // Data 为 proto 二进制（protobuf 子协议），否则为 JSON
+}
+
+// Handler 处理请求，返回的响应（或错误）以相同的 action、seq 回复客户端；两者均为 nil 时不回复
+type Handler func(ctx context.Context, req *Request) (interface{}, error)
+
+// Middleware 动作中间件，如鉴权、限流、日志
+type Middleware func(Handler) Handler
+
+// Router 按 action 将客户端消息路由到处理器，实现请求/响应语义
+type Router struct {
+	handlers    map[string]Handler
+	middlewares []Middleware
+	log         *log.Helper
+}
+
+func NewRouter(logger log.Logger) *Router {
+	return &Router{
+		handlers: make(map[string]Handler),
+		log:      log.NewHelper(log.With(logger, "module", "ws/router")),
+	}
+}
+
+// Use 添加作用于所有动作的中间件，先添加的在外层
+func (r *Router) Use(m ...Middleware) {
+	r.middlewares = append(r.middlewares, m...)
+}
+
+// Handle 注册动作处理器，m 为仅作用于该动作的中间件，位于全局中间件内层
+func (r *Router) Handle(action string, h Handler, m ...Middleware) {
+	r.handlers[action] = chain(h, m)
+}
+
+// HandleProto 注册 proto 类型的处理器：请求数据按连接的子协议解码（proto 二进制或 JSON）并校验，响应按子协议编码
+func HandleProto[T any, Req interface {
+	*T
+	proto.Message
+}, Resp proto.Message](r *Router, action string, h func(ctx context.Context, c *Client, req Req) (Resp, error), m ...Middleware) {
+	r.Handle(action, func(ctx context.Context, req *Request) (interface{}, error) {
+		in := Req(new(T))
+		if len(req.Data) > 0 {
+			var err error
+			if req.binary {
+				err = proto.Unmarshal(req.Data, in)
+			} else {
+				err = protoUnmarshal.Unmarshal(req.Data, in)
+			}
+			if err != nil {
+				return nil, ErrBadRequest.WithCause(err)
+			}
+		}
+		if v, ok := interface{}(in).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return nil, kerrors.BadRequest("INVALID_ARGUMENT", err.Error()).WithCause(err)
+			}
+		}
+		return h(ctx, req.Client, in)
+	}, m...)
+}
+
+// WithoutClient 适配不需要连接信息的处理器，如直接复用 HTTP/gRPC 接口的实现
+func WithoutClient[Req, Resp any](h func(ctx context.Context, req Req) (Resp, error)) func(ctx context.Context, c *Client, req Req) (Resp, error) {
+	return func(ctx context.Context, _ *Client, req Req) (Resp, error) {
+		return h(ctx, req)
+	}
+}
+
+// Dispatch 按连接的子协议解析客户端消息并调用对应的处理器，可作为连接的处理函数
+func (r *Router) Dispatch(ctx context.Context, c *Client, payload []byte) {
+	req, binary, err := c.codec.decode(payload)
+	if err != nil {
+		c.Reply("", "", nil, ErrBadRequest)
+		return
+	}
+	req.Client, req.binary = c, binary
+
+	h, ok := r.handlers[req.Action]
+	if !ok {
+		h = func(context.Context, *Request) (interface{}, error) { return nil, ErrActionNotFound }
+	}
+	resp, err := chain(h, r.middlewares)(ctx, req)
+	if resp == nil && err == nil {
+		return
+	}
+	c.Reply(req.Action, req.Seq, resp, err)
+}
+
+// chain 依次包装中间件，m[0] 在最外层
+func chain(h Handler, m []Middleware) Handler {
+	for i := len(m) - 1; i >= 0; i-- {
+		h = m[i](h)
+	}
+	return h
+}
+
+func marshalData(v interface{}) (json.RawMessage, error) {
+	if m, ok := v.(proto.Message); ok {
+		return protoMarshal.Marshal(m)
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,84 @@
+package ws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultSSERetry 建议客户端断线后的重连间隔，写入事件流的 retry 字段
+const defaultSSERetry = 3 * time.Second
+
+// ServeSSE 以 Server-Sent Events 写出 SSE 连接的消息，直到连接被注销或客户端断开，返回时注销连接；
+// 在 HTTP 请求协程中调用。每条消息的 data 与 json 子协议的消息相同，可靠消息的序号作为事件 id，
+// 客户端重连时通过 Last-Event-ID 补发
+func (c *Client) ServeSSE(ctx context.Context, w http.ResponseWriter) {
+	h := c.Hub
+	defer h.Unregister(c)
+
+	rc := http.NewResponseController(w)
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no") // 禁止 Nginx 缓冲
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.WriteString(w, "retry: "+strconv.FormatInt(defaultSSERetry.Milliseconds(), 10)+"\n\n"); err != nil || rc.Flush() != nil {
+		return
+	}
+
+	// 注释行作为保活，同时用于发现已断开的客户端
+	ticker := time.NewTicker(h.pingPeriod)
+	defer ticker.Stop()
+	done := ctx.Done()
+	for {
+		select {
+		case f, ok := <-c.send:
+			if !ok {
+				return
+			}
+			// SSE 连接按 json 子协议编码，已编码的响应同样是 JSON 消息
+			_ = rc.SetWriteDeadline(time.Now().Add(h.writeWait))
+			if err := writeEvent(w, f.data); err != nil || rc.Flush() != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = rc.SetWriteDeadline(time.Now().Add(h.writeWait))
+			if _, err := io.WriteString(w, ": ping\n\n"); err != nil || rc.Flush() != nil {
+				return
+			}
+			h.Heartbeat(c)
+		case <-done:
+			// 客户端断开时 context 被取消；服务端请求超时只说明 context 到期，之后靠保活写入失败发现断开
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return
+			}
+			done = nil
+		}
+	}
+}
+
+// writeEvent 写出一条事件，可靠消息带 id
+func writeEvent(w io.Writer, msg []byte) error {
+	var buf bytes.Buffer
+	var m struct {
+		Seq string `json:"seq"`
+	}
+	// SSE 连接不发送请求，消息中的 seq 只会是可靠消息的序号
+	if json.Unmarshal(msg, &m) == nil && m.Seq != "" {
+		buf.WriteString("id: " + m.Seq + "\n")
+	}
+	for _, line := range bytes.Split(msg, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+	_, err := w.Write(buf.Bytes())
+	return err
+}
@@ -0,0 +1,62 @@
+package ws
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+)
+
+var ErrTicketInvalid = kerrors.Unauthorized("WS_TICKET_INVALID", "连接凭证无效或已过期")
+
+const (
+	defaultTicketTTL = 30 * time.Second
+	ticketBytes      = 32
+)
+
+// TicketStore 一次性连接凭证的存储
+type TicketStore interface {
+	// Save 保存凭证对应的令牌，ttl 后过期
+	Save(ctx context.Context, ticket, token string, ttl time.Duration) error
+	// Take 取出并删除凭证对应的令牌，不存在或已过期时返回 ErrTicketInvalid
+	Take(ctx context.Context, ticket string) (string, error)
+}
+
+// Tickets 一次性连接凭证：已登录的客户端通过 HTTP 接口换取短时有效的凭证，建立连接时放在 query 中，
+// 避免长期有效的令牌出现在访问日志里
+type Tickets struct {
+	store TicketStore
+	ttl   time.Duration
+}
+
+func NewTickets(store TicketStore, c *conf.Server) *Tickets {
+	t := &Tickets{store: store, ttl: defaultTicketTTL}
+	if d := c.GetWebsocket().GetTicketTtl().AsDuration(); d > 0 {
+		t.ttl = d
+	}
+	return t
+}
+
+// Issue 为令牌签发凭证，返回凭证及过期时间
+func (t *Tickets) Issue(ctx context.Context, token string) (string, time.Time, error) {
+	b := make([]byte, ticketBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", time.Time{}, err
+	}
+	ticket := base64.RawURLEncoding.EncodeToString(b)
+	if err := t.store.Save(ctx, ticket, token, t.ttl); err != nil {
+		return "", time.Time{}, err
+	}
+	return ticket, time.Now().Add(t.ttl), nil
+}
+
+// Redeem 使用凭证，返回签发时的令牌；每个凭证只能使用一次
+func (t *Tickets) Redeem(ctx context.Context, ticket string) (string, error) {
+	if ticket == "" {
+		return "", ErrTicketInvalid
+	}
+	return t.store.Take(ctx, ticket)
+}
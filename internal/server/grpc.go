@@ -1,7 +1,12 @@
 package server
 
 import (
+	chatV1 "github.com/sober-studio/bubble-boot-go-kratos/api/chat/v1"
+	presenceV1 "github.com/sober-studio/bubble-boot-go-kratos/api/presence/v1"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/clientinfo"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -9,10 +14,20 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, logger log.Logger) *grpc.Server {
+func NewGRPCServer(
+	c *conf.Server,
+	app *conf.App,
+	tokenService auth.TokenService,
+	presence *service.PresenceService,
+	chat *service.ChatService,
+	group *service.GroupService,
+	logger log.Logger,
+) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			clientinfo.Middleware(nil),
+			auth.Middleware(tokenService, auth.PathAccessConfigWithPublicList(app.Auth.PublicPaths)),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -25,5 +40,10 @@ func NewGRPCServer(c *conf.Server, logger log.Logger) *grpc.Server {
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
+
+	presenceV1.RegisterPresenceServer(srv, presence)
+	chatV1.RegisterChatServer(srv, chat)
+	chatV1.RegisterGroupServer(srv, group)
+
 	return srv
 }
@@ -5,10 +5,17 @@ import (
 	"strings"
 
 	"github.com/go-kratos/kratos/v2/transport/http/binding"
+	adminV1 "github.com/sober-studio/bubble-boot-go-kratos/api/admin/v1"
+	chatV1 "github.com/sober-studio/bubble-boot-go-kratos/api/chat/v1"
+	notificationV1 "github.com/sober-studio/bubble-boot-go-kratos/api/notification/v1"
 	passportV1 "github.com/sober-studio/bubble-boot-go-kratos/api/passport/v1"
+	presenceV1 "github.com/sober-studio/bubble-boot-go-kratos/api/presence/v1"
 	publicV1 "github.com/sober-studio/bubble-boot-go-kratos/api/public/v1"
+	uploadV1 "github.com/sober-studio/bubble-boot-go-kratos/api/upload/v1"
+	wsV1 "github.com/sober-studio/bubble-boot-go-kratos/api/ws/v1"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/clientinfo"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/debug"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/render"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/service"
@@ -26,13 +33,22 @@ func NewHTTPServer(
 	passport *service.PassportService,
 	tokenService auth.TokenService,
 	wsSvc *service.WebsocketService,
+	chat *service.ChatService,
+	group *service.GroupService,
+	delivery *service.DeliveryService,
+	templates *service.MessageTemplateService,
+	notification *service.NotificationService,
+	presence *service.PresenceService,
+	upload *service.UploadService,
 	logger log.Logger,
 ) *http.Server {
 
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			clientinfo.Middleware(c.GetHttp().GetTrustedProxies()),
 			auth.Middleware(tokenService, auth.PathAccessConfigWithPublicList(app.Auth.PublicPaths)),
+			auth.AdminMiddleware(tokenService, app.Auth.AdminUserIds),
 		),
 		http.Filter(debug.Filter),
 		http.RequestDecoder(MultipartRequestDecoder),
@@ -55,9 +71,21 @@ func NewHTTPServer(
 	// 同端口集成点：手动绑定路由
 	// 注意：这里用 Handlers.HandleFunc 是绕过 Kratos 的 Proto 解析，直接处理原始 HTTP 请求
 	srv.HandleFunc("/ws", wsSvc.WSHandler)
+	// WebSocket 不可用时的降级通道，仅下行
+	srv.HandleFunc("/events", wsSvc.SSEHandler)
+	// 供应商回执回调，由供应商服务端调用，不经过 JWT 认证
+	srv.HandleFunc("/callback/sms/aliyun", delivery.AliyunSmsReportHandler)
 
 	passportV1.RegisterPassportHTTPServer(srv, passport)
 	publicV1.RegisterPublicHTTPServer(srv, public)
+	notificationV1.RegisterNotificationHTTPServer(srv, notification)
+	presenceV1.RegisterPresenceHTTPServer(srv, presence)
+	chatV1.RegisterChatHTTPServer(srv, chat)
+	chatV1.RegisterGroupHTTPServer(srv, group)
+	uploadV1.RegisterUploadHTTPServer(srv, upload)
+	wsV1.RegisterWebsocketHTTPServer(srv, wsSvc)
+	adminV1.RegisterDeliveryHTTPServer(srv, delivery)
+	adminV1.RegisterMessageTemplateHTTPServer(srv, templates)
 
 	return srv
 }
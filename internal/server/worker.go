@@ -0,0 +1,40 @@
+package server
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/worker"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
+)
+
+func NewWorkerServer(
+	logger log.Logger,
+	outbound *biz.OutboundUseCase,
+	templates *biz.MessageTemplateUseCase,
+	sensitive *biz.SensitiveUseCase,
+	hub *ws.Hub,
+	mailbox *ws.Mailbox,
+) *worker.Server {
+	srv := worker.NewServer(logger)
+
+	// 短信/邮件模板：启动时加载，之后定期检查其他实例的修改
+	srv.Add("message-template", 1, templates.Watch)
+
+	// 敏感词库：启动时加载，之后定期重新加载并原子替换
+	srv.Add("sensitive", 1, sensitive.Watch)
+
+	// 出站消息队列：关闭时短信、邮件同步发送，无需消费协程
+	if outbound.Enabled() {
+		srv.Add("outbound", outbound.Workers(), outbound.Consume)
+	}
+
+	// WebSocket 可靠投递：重发未确认的消息
+	srv.Add("ws-mailbox", 1, mailbox.Run)
+
+	// WebSocket 集群模式：接收其他实例转发的消息
+	if hub.Clustered() {
+		srv.Add("ws-broker", 1, hub.Run)
+	}
+
+	return srv
+}
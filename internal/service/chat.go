@@ -2,44 +2,262 @@ package service
 
 import (
 	"context"
-	"encoding/json"
+	"strconv"
 
+	pb "github.com/sober-studio/bubble-boot-go-kratos/api/chat/v1"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
 )
 
 type ChatService struct {
-	hub *ws.Hub
-	uc  *biz.ChatUseCase
+	pb.UnimplementedChatServer
+	hub     *ws.Hub
+	mailbox *ws.Mailbox
+	uc      *biz.ChatUseCase
 }
 
-func NewChatService(hub *ws.Hub, uc *biz.ChatUseCase) *ChatService {
-	return &ChatService{hub: hub, uc: uc}
+func NewChatService(hub *ws.Hub, mailbox *ws.Mailbox, uc *biz.ChatUseCase) *ChatService {
+	return &ChatService{hub: hub, mailbox: mailbox, uc: uc}
 }
 
-// HandleChat 处理客户端发来的聊天消息
-func (s *ChatService) HandleChat(ctx context.Context, uid string, data []byte) {
-	// 1. 解析业务数据
-	var req struct {
-		ToUID   string `json:"to_uid"`
-		Content string `json:"content"`
+// SendChat chat 动作：发送单聊消息，响应持久化后的消息 ID
+func (s *ChatService) SendChat(ctx context.Context, c *ws.Client, req *pb.SendChatRequest) (*pb.SendChatReply, error) {
+	// 1. 调用业务逻辑层（存入数据库、敏感词过滤等）
+	from, err := s.uc.CurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := s.uc.ProcessMessage(ctx, from, req.ToUid, biz.MessageType(req.Type), req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 可靠推送给接收者的所有设备（离线时重连补发），并同步到发送者的其他设备
+	// content 中的私有附件不带签名 URL，避免补发时已过期，客户端通过历史消息接口获取
+	toUID := strconv.FormatInt(req.ToUid, 10)
+	newChat := map[string]interface{}{
+		"msg_id":          strconv.FormatInt(msg.ID, 10),
+		"conversation_id": msg.ConversationID,
+		"from_uid":        c.UID,
+		"to_uid":          toUID,
+		"type":            msg.Type,
+		"content":         msg.Content,
+		"created_at":      msg.CreatedAt.Unix(),
+	}
+	_, _ = s.mailbox.Send(ctx, toUID, "new_chat", newChat)
+	_, _ = s.mailbox.SendExcept(ctx, c.UID, c.ID, "new_chat", newChat)
+
+	return toSendChatReply(msg), nil
+}
+
+// SendGroupChat group_chat 动作：发送群聊消息
+func (s *ChatService) SendGroupChat(ctx context.Context, c *ws.Client, req *pb.SendGroupChatRequest) (*pb.SendChatReply, error) {
+	// 1. 业务逻辑层校验成员身份、禁言并持久化
+	from, err := s.uc.CurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, members, err := s.uc.ProcessGroupMessage(ctx, from, req.GroupId, biz.MessageType(req.Type), req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 扇出给在线成员的所有设备，离线成员通过会话未读数及历史消息补齐
+	out := ws.NewMessage("new_group_chat", map[string]interface{}{
+		"msg_id":          strconv.FormatInt(msg.ID, 10),
+		"conversation_id": msg.ConversationID,
+		"group_id":        strconv.FormatInt(req.GroupId, 10),
+		"from_uid":        c.UID,
+		"type":            msg.Type,
+		"content":         msg.Content,
+		"created_at":      msg.CreatedAt.Unix(),
+	})
+	for _, id := range members {
+		uid := strconv.FormatInt(id, 10)
+		if uid == c.UID {
+			s.hub.SendToUserExcept(uid, c.ID, out)
+			continue
+		}
+		s.hub.SendToUser(uid, out)
+	}
+
+	return toSendChatReply(msg), nil
+}
+
+func toSendChatReply(msg *biz.Message) *pb.SendChatReply {
+	return &pb.SendChatReply{
+		MsgId:          msg.ID,
+		ConversationId: msg.ConversationID,
+		CreatedAt:      msg.CreatedAt.Unix(),
 	}
-	json.Unmarshal(data, &req)
+}
+
+func (s *ChatService) ListConversations(ctx context.Context, req *pb.ListConversationsRequest) (*pb.ListConversationsReply, error) {
+	list, total, err := s.uc.ListConversations(ctx, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListConversationsReply{
+		List:  make([]*pb.ConversationInfo, 0, len(list)),
+		Total: total,
+	}
+	for _, c := range list {
+		info := &pb.ConversationInfo{
+			ConversationId:    c.ConversationID,
+			PeerId:            c.PeerID,
+			GroupId:           c.GroupID,
+			UnreadCount:       c.UnreadCount,
+			LastReadMessageId: c.LastReadMessageID,
+		}
+		if c.LastMessage != nil {
+			info.LastMessage = toMessageInfo(c.LastMessage)
+		}
+		reply.List = append(reply.List, info)
+	}
+	return reply, nil
+}
+
+func (s *ChatService) ListMessages(ctx context.Context, req *pb.ListMessagesRequest) (*pb.ListMessagesReply, error) {
+	list, next, err := s.uc.ListMessages(ctx, req.ConversationId, req.BeforeId, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListMessagesReply{
+		List:         make([]*pb.MessageInfo, 0, len(list)),
+		NextBeforeId: next,
+	}
+	for _, m := range list {
+		reply.List = append(reply.List, toMessageInfo(m))
+	}
+	return reply, nil
+}
 
-	// 2. 调用业务逻辑层（存入数据库、敏感词过滤等）
-	msg, err := s.uc.ProcessMessage(ctx, uid, req.ToUID, req.Content)
+func (s *ChatService) MarkConversationRead(ctx context.Context, req *pb.MarkConversationReadRequest) (*pb.MarkConversationReadReply, error) {
+	userID, err := s.uc.CurrentUserID(ctx)
 	if err != nil {
-		// 向发送者响应失败
-		s.hub.SendToUser(uid, ws.NewMessage("error", map[string]string{"msg": "发送失败"}))
+		return nil, err
+	}
+	receipt, err := s.uc.MarkConversationRead(ctx, userID, req.ConversationId)
+	if err != nil {
+		return nil, err
+	}
+	s.pushReceipt(ctx, receipt)
+	return &pb.MarkConversationReadReply{}, nil
+}
+
+func (s *ChatService) GetMessageReceipt(ctx context.Context, req *pb.GetMessageReceiptRequest) (*pb.GetMessageReceiptReply, error) {
+	receipt, err := s.uc.GetMessageReceipt(ctx, req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMessageReceiptReply{
+		ReadCount:   receipt.ReadCount,
+		UnreadCount: receipt.UnreadCount,
+	}, nil
+}
+
+func (s *ChatService) RecallMessage(ctx context.Context, req *pb.RecallMessageRequest) (*pb.RecallMessageReply, error) {
+	userID, err := s.uc.CurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, participants, err := s.uc.RecallMessage(ctx, userID, req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	s.pushRecalled(ctx, msg, participants)
+	return &pb.RecallMessageReply{}, nil
+}
+
+func (s *ChatService) EditMessage(ctx context.Context, req *pb.EditMessageRequest) (*pb.MessageInfo, error) {
+	userID, err := s.uc.CurrentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	msg, participants, err := s.uc.EditMessage(ctx, userID, req.MessageId, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	s.pushEdited(ctx, msg, participants)
+	return toMessageInfo(msg), nil
+}
+
+func (s *ChatService) ListMessageEdits(ctx context.Context, req *pb.ListMessageEditsRequest) (*pb.ListMessageEditsReply, error) {
+	list, err := s.uc.ListMessageEdits(ctx, req.MessageId)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListMessageEditsReply{List: make([]*pb.MessageEditInfo, 0, len(list))}
+	for _, e := range list {
+		reply.List = append(reply.List, &pb.MessageEditInfo{
+			Content:  e.Content,
+			EditedAt: e.EditedAt.Unix(),
+		})
+	}
+	return reply, nil
+}
+
+// pushReceipt 向被读消息的发送者推送已读回执，并同步到阅读者的其他设备
+func (s *ChatService) pushReceipt(ctx context.Context, receipt *biz.ReadReceipt) {
+	if receipt == nil {
 		return
 	}
+	s.broadcast(ctx, receipt.ConversationID, receipt.NotifyUserIDs, "chat_read", map[string]string{
+		"conversation_id":      receipt.ConversationID,
+		"reader_uid":           strconv.FormatInt(receipt.ReaderID, 10),
+		"last_read_message_id": strconv.FormatInt(receipt.LastReadMessageID, 10),
+	})
+}
 
-	// 3. 响应发送者 (确认发送成功)
-	s.hub.SendToUser(uid, ws.NewMessage("chat_ack", map[string]string{"msg_id": msg.ID}))
+func (s *ChatService) pushRecalled(ctx context.Context, msg *biz.Message, participants []int64) {
+	s.broadcast(ctx, msg.ConversationID, participants, "chat_recalled", map[string]interface{}{
+		"msg_id":          strconv.FormatInt(msg.ID, 10),
+		"conversation_id": msg.ConversationID,
+		"recalled_at":     msg.RecalledAt.Unix(),
+	})
+}
+
+func (s *ChatService) pushEdited(ctx context.Context, msg *biz.Message, participants []int64) {
+	if msg.EditedAt == nil {
+		return
+	}
+	s.broadcast(ctx, msg.ConversationID, participants, "chat_edited", map[string]interface{}{
+		"msg_id":          strconv.FormatInt(msg.ID, 10),
+		"conversation_id": msg.ConversationID,
+		"content":         msg.Content,
+		"edited_at":       msg.EditedAt.Unix(),
+	})
+}
 
-	// 4. 推送给接收者 (如果接收者在线)
-	s.hub.SendToUser(req.ToUID, ws.NewMessage("new_chat", map[string]string{
-		"from_uid": uid,
-		"content":  req.Content,
-	}))
+// broadcast 推送给会话参与者的所有设备：单聊与 new_chat 一致走可靠信箱，群聊推送给在线成员
+func (s *ChatService) broadcast(ctx context.Context, conversationID string, userIDs []int64, action string, data interface{}) {
+	if _, ok := biz.ParseGroupConversationID(conversationID); ok {
+		msg := ws.NewMessage(action, data)
+		for _, id := range userIDs {
+			s.hub.SendToUser(strconv.FormatInt(id, 10), msg)
+		}
+		return
+	}
+	for _, id := range userIDs {
+		_, _ = s.mailbox.Send(ctx, strconv.FormatInt(id, 10), action, data)
+	}
+}
+
+func toMessageInfo(m *biz.Message) *pb.MessageInfo {
+	info := &pb.MessageInfo{
+		Id:             m.ID,
+		ConversationId: m.ConversationID,
+		SenderId:       m.SenderID,
+		Type:           string(m.Type),
+		Content:        m.Content,
+		Status:         int32(m.Status),
+		CreatedAt:      m.CreatedAt.Unix(),
+	}
+	if m.EditedAt != nil {
+		info.EditedAt = m.EditedAt.Unix()
+	}
+	if m.RecalledAt != nil {
+		info.RecalledAt = m.RecalledAt.Unix()
+	}
+	return info
 }
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/sober-studio/bubble-boot-go-kratos/api/admin/v1"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/phone"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/sms"
+)
+
+// 回执请求体上限，阿里云单次最多推送 100 条
+const reportMaxBodySize = 1 << 20
+
+type DeliveryService struct {
+	pb.UnimplementedDeliveryServer
+	uc            *biz.DeliveryUseCase
+	phone         *phone.Normalizer
+	callbackToken string
+	log           *log.Helper
+}
+
+func NewDeliveryService(uc *biz.DeliveryUseCase, phone *phone.Normalizer, c *conf.Data, logger log.Logger) *DeliveryService {
+	s := &DeliveryService{uc: uc, phone: phone, log: log.NewHelper(log.With(logger, "module", "service/delivery"))}
+	if c.Sms != nil {
+		s.callbackToken = c.Sms.CallbackToken
+	}
+	if s.callbackToken == "" {
+		s.log.Warn("未配置短信回执回调令牌（sms.callback_token），将拒绝所有回执回调")
+	}
+	return s
+}
+
+func (s *DeliveryService) ListDeliveries(ctx context.Context, req *pb.ListDeliveriesRequest) (*pb.ListDeliveriesReply, error) {
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		end = time.Unix(req.EndTime, 0)
+	}
+
+	// 短信记录按 E.164 号码哈希，查询时同样规范化
+	receiver := req.Receiver
+	if receiver != "" && !strings.Contains(receiver, "@") {
+		if mobile, err := s.phone.Normalize("", receiver); err == nil {
+			receiver = mobile
+		}
+	}
+
+	list, total, err := s.uc.ListDeliveries(ctx, biz.DeliveryChannel(req.Channel), receiver, start, end, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListDeliveriesReply{
+		List:  make([]*pb.DeliveryInfo, 0, len(list)),
+		Total: total,
+	}
+	for _, d := range list {
+		info := &pb.DeliveryInfo{
+			Id:                d.ID,
+			Channel:           string(d.Channel),
+			Receiver:          d.Receiver,
+			Template:          d.Template,
+			Provider:          d.Provider,
+			ProviderMessageId: d.ProviderMessageID,
+			ProviderRequestId: d.ProviderRequestID,
+			Status:            string(d.Status),
+			Error:             d.Error,
+			CreatedAt:         d.CreatedAt.Unix(),
+		}
+		if d.ReportedAt != nil {
+			info.ReportedAt = d.ReportedAt.Unix()
+		}
+		reply.List = append(reply.List, info)
+	}
+	return reply, nil
+}
+
+// AliyunSmsReportHandler 接收阿里云短信状态报告推送
+// 应答格式须为 {"code":0,"msg":"成功"}，否则阿里云会重推
+func (s *DeliveryService) AliyunSmsReportHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !s.checkCallbackToken(r) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, reportMaxBodySize))
+	if err != nil {
+		s.log.Errorf("读取阿里云短信回执失败: %v", err)
+		s.writeAliyunReply(w, -1, "读取请求失败")
+		return
+	}
+	reports, err := sms.ParseAliyunReports(body)
+	if err != nil {
+		s.log.Errorf("解析阿里云短信回执失败: %v", err)
+		s.writeAliyunReply(w, -1, "请求格式错误")
+		return
+	}
+	if err := s.uc.ApplySmsReports(r.Context(), reports); err != nil {
+		s.log.Errorf("处理阿里云短信回执失败: %v", err)
+		s.writeAliyunReply(w, -1, "处理失败")
+		return
+	}
+	s.writeAliyunReply(w, 0, "成功")
+}
+
+// checkCallbackToken 校验回调地址中的令牌，未配置时拒绝，避免伪造回执篡改投递状态
+func (s *DeliveryService) checkCallbackToken(r *http.Request) bool {
+	if s.callbackToken == "" {
+		return false
+	}
+	token := r.URL.Query().Get("token")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.callbackToken)) == 1
+}
+
+func (s *DeliveryService) writeAliyunReply(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"code": code, "msg": msg})
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/sober-studio/bubble-boot-go-kratos/api/chat/v1"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
+)
+
+// groupRoomPrefix 群组房间 group:{group_id}，仅群成员可订阅（如输入状态等临时事件），退群、被移出或解散时移出房间
+const groupRoomPrefix = "group"
+
+type GroupService struct {
+	pb.UnimplementedGroupServer
+	uc *biz.GroupUseCase
+}
+
+func NewGroupService(uc *biz.GroupUseCase, authorizer *ws.Authorizer) *GroupService {
+	authorizer.Register(groupRoomPrefix, func(ctx context.Context, uid, room string) error {
+		groupID, err := strconv.ParseInt(strings.TrimPrefix(room, groupRoomPrefix+":"), 10, 64)
+		if err != nil {
+			return ws.ErrRoomForbidden
+		}
+		userID, err := strconv.ParseInt(uid, 10, 64)
+		if err != nil {
+			return ws.ErrRoomForbidden
+		}
+		ok, err := uc.IsMember(ctx, groupID, userID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ws.ErrRoomForbidden
+		}
+		return nil
+	})
+	return &GroupService{uc: uc}
+}
+
+// wsGroupRooms 将用户的连接移出群组房间 group:{group_id}
+type wsGroupRooms struct {
+	hub *ws.Hub
+}
+
+func NewGroupRooms(hub *ws.Hub) biz.GroupRooms {
+	return &wsGroupRooms{hub: hub}
+}
+
+func (r *wsGroupRooms) Leave(groupID int64, userIDs []int64) {
+	room := groupRoomPrefix + ":" + strconv.FormatInt(groupID, 10)
+	for _, id := range userIDs {
+		r.hub.LeaveRoom(strconv.FormatInt(id, 10), room)
+	}
+}
+
+func (s *GroupService) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.GroupInfo, error) {
+	g, err := s.uc.CreateGroup(ctx, req.Name, req.MemberIds)
+	if err != nil {
+		return nil, err
+	}
+	return toGroupInfo(g), nil
+}
+
+func (s *GroupService) GetGroup(ctx context.Context, req *pb.GetGroupRequest) (*pb.GroupInfo, error) {
+	g, err := s.uc.GetGroup(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	return toGroupInfo(g), nil
+}
+
+func (s *GroupService) DisbandGroup(ctx context.Context, req *pb.DisbandGroupRequest) (*pb.DisbandGroupReply, error) {
+	if err := s.uc.DisbandGroup(ctx, req.GroupId); err != nil {
+		return nil, err
+	}
+	return &pb.DisbandGroupReply{}, nil
+}
+
+func (s *GroupService) ListGroupMembers(ctx context.Context, req *pb.ListGroupMembersRequest) (*pb.ListGroupMembersReply, error) {
+	list, err := s.uc.ListMembers(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	reply := &pb.ListGroupMembersReply{List: make([]*pb.GroupMemberInfo, 0, len(list))}
+	for _, m := range list {
+		info := &pb.GroupMemberInfo{
+			UserId:   m.UserID,
+			Role:     string(m.Role),
+			JoinedAt: m.JoinedAt.Unix(),
+		}
+		if m.Muted(now) {
+			info.MutedUntil = m.MutedUntil.Unix()
+		}
+		reply.List = append(reply.List, info)
+	}
+	return reply, nil
+}
+
+func (s *GroupService) InviteGroupMembers(ctx context.Context, req *pb.InviteGroupMembersRequest) (*pb.InviteGroupMembersReply, error) {
+	added, err := s.uc.InviteMembers(ctx, req.GroupId, req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InviteGroupMembersReply{AddedUserIds: added}, nil
+}
+
+func (s *GroupService) KickGroupMember(ctx context.Context, req *pb.KickGroupMemberRequest) (*pb.KickGroupMemberReply, error) {
+	if err := s.uc.KickMember(ctx, req.GroupId, req.UserId); err != nil {
+		return nil, err
+	}
+	return &pb.KickGroupMemberReply{}, nil
+}
+
+func (s *GroupService) LeaveGroup(ctx context.Context, req *pb.LeaveGroupRequest) (*pb.LeaveGroupReply, error) {
+	if err := s.uc.LeaveGroup(ctx, req.GroupId); err != nil {
+		return nil, err
+	}
+	return &pb.LeaveGroupReply{}, nil
+}
+
+func (s *GroupService) SetGroupMemberRole(ctx context.Context, req *pb.SetGroupMemberRoleRequest) (*pb.SetGroupMemberRoleReply, error) {
+	if err := s.uc.SetMemberRole(ctx, req.GroupId, req.UserId, biz.GroupRole(req.Role)); err != nil {
+		return nil, err
+	}
+	return &pb.SetGroupMemberRoleReply{}, nil
+}
+
+func (s *GroupService) MuteGroupMember(ctx context.Context, req *pb.MuteGroupMemberRequest) (*pb.MuteGroupMemberReply, error) {
+	if err := s.uc.MuteMember(ctx, req.GroupId, req.UserId, time.Duration(req.Duration)*time.Second); err != nil {
+		return nil, err
+	}
+	return &pb.MuteGroupMemberReply{}, nil
+}
+
+func toGroupInfo(g *biz.Group) *pb.GroupInfo {
+	return &pb.GroupInfo{
+		Id:             g.ID,
+		Name:           g.Name,
+		OwnerId:        g.OwnerID,
+		MemberCount:    g.MemberCount,
+		MaxMembers:     g.MaxMembers,
+		ConversationId: biz.GroupConversationID(g.ID),
+		CreatedAt:      g.CreatedAt.Unix(),
+	}
+}
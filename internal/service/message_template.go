@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/sober-studio/bubble-boot-go-kratos/api/admin/v1"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+)
+
+type MessageTemplateService struct {
+	pb.UnimplementedMessageTemplateServer
+	uc *biz.MessageTemplateUseCase
+}
+
+func NewMessageTemplateService(uc *biz.MessageTemplateUseCase) *MessageTemplateService {
+	return &MessageTemplateService{uc: uc}
+}
+
+func (s *MessageTemplateService) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesReply, error) {
+	list, total, err := s.uc.ListTemplates(ctx, biz.DeliveryChannel(req.Channel), req.Name, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListTemplatesReply{
+		List:  make([]*pb.TemplateInfo, 0, len(list)),
+		Total: total,
+	}
+	for _, t := range list {
+		reply.List = append(reply.List, toTemplateInfo(t))
+	}
+	return reply, nil
+}
+
+func (s *MessageTemplateService) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.TemplateInfo, error) {
+	t, err := s.uc.CreateTemplate(ctx, fromTemplateContent(req.Content))
+	if err != nil {
+		return nil, err
+	}
+	return toTemplateInfo(t), nil
+}
+
+func (s *MessageTemplateService) UpdateTemplate(ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.TemplateInfo, error) {
+	t, err := s.uc.UpdateTemplate(ctx, &biz.MessageTemplate{
+		ID:         req.Id,
+		Subject:    req.Subject,
+		Body:       req.Body,
+		TextBody:   req.TextBody,
+		VendorCode: req.VendorCode,
+		Version:    req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toTemplateInfo(t), nil
+}
+
+func (s *MessageTemplateService) DeleteTemplate(ctx context.Context, req *pb.DeleteTemplateRequest) (*pb.DeleteTemplateReply, error) {
+	if err := s.uc.DeleteTemplate(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteTemplateReply{}, nil
+}
+
+func (s *MessageTemplateService) PreviewTemplate(ctx context.Context, req *pb.PreviewTemplateRequest) (*pb.RenderTemplateReply, error) {
+	r, err := s.uc.Preview(ctx, fromTemplateContent(req.Content), req.Params)
+	if err != nil {
+		return nil, err
+	}
+	return toRenderTemplateReply(r), nil
+}
+
+func (s *MessageTemplateService) RenderTemplate(ctx context.Context, req *pb.RenderTemplateRequest) (*pb.RenderTemplateReply, error) {
+	r, err := s.uc.Render(ctx, biz.DeliveryChannel(req.Channel), req.Name, req.Locales, req.Params)
+	if err != nil {
+		return nil, err
+	}
+	return toRenderTemplateReply(r), nil
+}
+
+func fromTemplateContent(c *pb.TemplateContent) *biz.MessageTemplate {
+	return &biz.MessageTemplate{
+		Channel:    biz.DeliveryChannel(c.Channel),
+		Name:       c.Name,
+		Locale:     c.Locale,
+		Provider:   c.Provider,
+		Subject:    c.Subject,
+		Body:       c.Body,
+		TextBody:   c.TextBody,
+		VendorCode: c.VendorCode,
+	}
+}
+
+func toTemplateInfo(t *biz.MessageTemplate) *pb.TemplateInfo {
+	return &pb.TemplateInfo{
+		Id: t.ID,
+		Content: &pb.TemplateContent{
+			Channel:    string(t.Channel),
+			Name:       t.Name,
+			Locale:     t.Locale,
+			Provider:   t.Provider,
+			Subject:    t.Subject,
+			Body:       t.Body,
+			TextBody:   t.TextBody,
+			VendorCode: t.VendorCode,
+		},
+		Version:   t.Version,
+		CreatedAt: t.CreatedAt.Unix(),
+		UpdatedAt: t.UpdatedAt.Unix(),
+	}
+}
+
+func toRenderTemplateReply(r *biz.MessageTemplateRendered) *pb.RenderTemplateReply {
+	reply := &pb.RenderTemplateReply{
+		Locale:  r.Locale,
+		Source:  r.Source,
+		Subject: r.Subject,
+		Html:    r.HTML,
+		Text:    r.Text,
+	}
+	for _, c := range r.VendorCodes {
+		reply.VendorCodes = append(reply.VendorCodes, &pb.VendorTemplateCode{
+			Provider: c.Provider,
+			Code:     c.Code,
+			Source:   c.Source,
+		})
+	}
+	return reply
+}
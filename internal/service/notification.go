@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	pb "github.com/sober-studio/bubble-boot-go-kratos/api/notification/v1"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
+)
+
+type NotificationService struct {
+	pb.UnimplementedNotificationServer
+	uc *biz.NotificationUseCase
+}
+
+func NewNotificationService(uc *biz.NotificationUseCase) *NotificationService {
+	return &NotificationService{uc: uc}
+}
+
+// wsPusher 通过 WebSocket 可靠推送站内通知，离线期间的通知重连后补发
+type wsPusher struct {
+	mailbox *ws.Mailbox
+}
+
+func NewNotificationPusher(mailbox *ws.Mailbox) biz.NotificationPusher {
+	return &wsPusher{mailbox: mailbox}
+}
+
+func (p *wsPusher) PushToUser(userID int64, action string, data interface{}) {
+	_, _ = p.mailbox.Send(context.Background(), strconv.FormatInt(userID, 10), action, data)
+}
+
+func (s *NotificationService) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsReply, error) {
+	list, total, err := s.uc.ListNotifications(ctx, req.UnreadOnly, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListNotificationsReply{
+		List:  make([]*pb.NotificationInfo, 0, len(list)),
+		Total: total,
+	}
+	for _, n := range list {
+		info := &pb.NotificationInfo{
+			Id:        n.ID,
+			Type:      n.Type,
+			Title:     n.Title,
+			Content:   n.Content,
+			Data:      n.Params,
+			CreatedAt: n.CreatedAt.Unix(),
+		}
+		if n.ReadAt != nil {
+			info.Read = true
+			info.ReadAt = n.ReadAt.Unix()
+		}
+		reply.List = append(reply.List, info)
+	}
+	return reply, nil
+}
+
+func (s *NotificationService) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.MarkNotificationsReadReply, error) {
+	ids := req.Ids
+	if req.All {
+		ids = nil
+	} else if len(ids) == 0 {
+		return &pb.MarkNotificationsReadReply{}, nil
+	}
+	updated, err := s.uc.MarkRead(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MarkNotificationsReadReply{Updated: updated}, nil
+}
+
+func (s *NotificationService) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountReply, error) {
+	count, err := s.uc.UnreadCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUnreadCountReply{Count: count}, nil
+}
+
+func (s *NotificationService) GetPreferences(ctx context.Context, req *pb.GetPreferencesRequest) (*pb.Preferences, error) {
+	pref, err := s.uc.GetPreference(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.toPreferences(pref), nil
+}
+
+func (s *NotificationService) UpdatePreferences(ctx context.Context, req *pb.UpdatePreferencesRequest) (*pb.Preferences, error) {
+	p := &biz.NotificationPreference{Channels: make(map[string][]biz.NotificationChannel, len(req.Types))}
+	for _, t := range req.Types {
+		channels := make([]biz.NotificationChannel, 0, len(t.Channels))
+		for _, ch := range t.Channels {
+			channels = append(channels, biz.NotificationChannel(ch))
+		}
+		p.Channels[t.Type] = channels
+	}
+	if q := req.QuietHours; q != nil {
+		p.QuietHoursStart = q.Start
+		p.QuietHoursEnd = q.End
+		p.Timezone = q.Timezone
+	}
+	pref, err := s.uc.UpdatePreference(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return s.toPreferences(pref), nil
+}
+
+func (s *NotificationService) toPreferences(pref *biz.NotificationPreference) *pb.Preferences {
+	reply := &pb.Preferences{
+		QuietHours: &pb.QuietHours{
+			Start:    pref.QuietHoursStart,
+			End:      pref.QuietHoursEnd,
+			Timezone: pref.Timezone,
+		},
+	}
+	for _, t := range s.uc.Types() {
+		tp := &pb.TypePreference{
+			Type:              t.Name,
+			Channels:          make([]string, 0, len(pref.Channels[t.Name])),
+			AvailableChannels: make([]string, 0, len(t.Channels)),
+			Mandatory:         t.Mandatory,
+		}
+		for _, ch := range pref.Channels[t.Name] {
+			tp.Channels = append(tp.Channels, string(ch))
+		}
+		for _, ch := range t.Channels {
+			tp.AvailableChannels = append(tp.AvailableChannels, string(ch))
+		}
+		reply.Types = append(reply.Types, tp)
+	}
+	return reply
+}
@@ -6,20 +6,24 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	pb "github.com/sober-studio/bubble-boot-go-kratos/api/passport/v1"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/clientinfo"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/phone"
 )
 
 type PassportService struct {
 	pb.UnimplementedPassportServer
-	uc      *biz.PassportUseCase
-	otp     *biz.OtpUseCase
-	captcha *biz.CaptchaUseCase
+	uc    *biz.PassportUseCase
+	otp   *biz.OtpUseCase
+	risk  *biz.RiskUseCase
+	phone *phone.Normalizer
 }
 
-func NewPassportService(uc *biz.PassportUseCase, otp *biz.OtpUseCase, captcha *biz.CaptchaUseCase) *PassportService {
+func NewPassportService(uc *biz.PassportUseCase, otp *biz.OtpUseCase, risk *biz.RiskUseCase, phone *phone.Normalizer) *PassportService {
 	return &PassportService{
-		uc:      uc,
-		otp:     otp,
-		captcha: captcha,
+		uc:    uc,
+		otp:   otp,
+		risk:  risk,
+		phone: phone,
 	}
 }
 
@@ -28,17 +32,21 @@ func (s *PassportService) Register(ctx context.Context, req *pb.RegisterRequest)
 		return nil, errors.BadRequest("PASSWORD_MISMATCH", "两次输入密码不一致")
 	}
 
-	phone := ""
+	mobile := ""
 	// 如果手机号和验证码都不为空，则进行验证码校验
 	if req.Mobile != "" && req.Code != "" {
+		normalized, err := s.phone.Normalize(req.CountryCode, req.Mobile)
+		if err != nil {
+			return nil, err
+		}
 		// 校验短信验证码
-		if valid, err := s.otp.VerifyPhoneOtp(ctx, req.Mobile, biz.Register, req.Code); err != nil || !valid {
+		if valid, err := s.otp.VerifyPhoneOtp(ctx, normalized, biz.Register, req.Code); err != nil || !valid {
 			return nil, biz.ErrorOtpInvalid
 		}
-		phone = req.Mobile
+		mobile = normalized
 	}
 
-	token, err := s.uc.Register(ctx, req.Username, req.Password, phone)
+	token, err := s.uc.Register(ctx, req.Username, req.Password, mobile)
 	if err != nil {
 		return nil, err
 	}
@@ -46,25 +54,41 @@ func (s *PassportService) Register(ctx context.Context, req *pb.RegisterRequest)
 }
 
 func (s *PassportService) LoginByPassword(ctx context.Context, req *pb.LoginByPasswordRequest) (*pb.LoginReply, error) {
-	// 校验验证码
-	if err := s.captcha.Verify(ctx, req.CaptchaId, req.Captcha); err != nil {
+	subject := &biz.RiskSubject{
+		Action:  biz.RiskActionLogin,
+		Account: req.Username,
+		IP:      clientinfo.IP(ctx),
+		Device:  clientinfo.DeviceFingerprint(ctx),
+	}
+	// 风险评估，高风险时校验图形验证码
+	if err := s.risk.Guard(ctx, subject, req.CaptchaId, req.Captcha); err != nil {
 		return nil, err
 	}
 
 	token, err := s.uc.LoginByPassword(ctx, req.Username, req.Password)
 	if err != nil {
+		if errors.Is(err, biz.ErrPasswordInvalid) || errors.Is(err, biz.ErrUserNotFound) {
+			s.risk.RecordFailure(ctx, subject)
+			return nil, s.risk.WithCaptchaHint(ctx, subject, err)
+		}
 		return nil, err
 	}
+	s.risk.RecordSuccess(ctx, subject)
 	return &pb.LoginReply{Token: token}, nil
 }
 
 func (s *PassportService) LoginByOtp(ctx context.Context, req *pb.LoginByOtpRequest) (*pb.LoginReply, error) {
+	mobile, err := s.phone.Normalize(req.CountryCode, req.Mobile)
+	if err != nil {
+		return nil, err
+	}
+
 	// 校验短信验证码
-	if valid, err := s.otp.VerifyPhoneOtp(ctx, req.Mobile, biz.Login, req.Code); err != nil || !valid {
+	if valid, err := s.otp.VerifyPhoneOtp(ctx, mobile, biz.Login, req.Code); err != nil || !valid {
 		return nil, biz.ErrorOtpInvalid
 	}
 
-	token, err := s.uc.LoginByOtp(ctx, req.Mobile)
+	token, err := s.uc.LoginByOtp(ctx, mobile)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +115,7 @@ func (s *PassportService) UserInfo(ctx context.Context, req *pb.UserInfoRequest)
 		Username: u.Username,
 		Mobile:   u.Phone,
 		Status:   status,
+		Nickname: u.Nickname,
 	}, nil
 }
 
@@ -107,12 +132,17 @@ func (s *PassportService) UpdatePassword(ctx context.Context, req *pb.UpdatePass
 }
 
 func (s *PassportService) BindMobile(ctx context.Context, req *pb.BindMobileRequest) (*pb.BindMobileReply, error) {
+	mobile, err := s.phone.Normalize(req.CountryCode, req.Mobile)
+	if err != nil {
+		return nil, err
+	}
+
 	// 校验短信验证码
-	if valid, err := s.otp.VerifyPhoneOtp(ctx, req.Mobile, biz.Bind, req.Code); err != nil || !valid {
+	if valid, err := s.otp.VerifyPhoneOtp(ctx, mobile, biz.Bind, req.Code); err != nil || !valid {
 		return nil, biz.ErrorOtpInvalid
 	}
 
-	err := s.uc.BindMobile(ctx, req.Mobile)
+	err = s.uc.BindMobile(ctx, mobile)
 	if err != nil {
 		return nil, err
 	}
@@ -120,29 +150,46 @@ func (s *PassportService) BindMobile(ctx context.Context, req *pb.BindMobileRequ
 }
 
 func (s *PassportService) UpdateMobile(ctx context.Context, req *pb.UpdateMobileRequest) (*pb.UpdateMobileReply, error) {
+	mobile, err := s.phone.Normalize(req.CountryCode, req.Mobile)
+	if err != nil {
+		return nil, err
+	}
+
 	// 校验短信验证码
-	if valid, err := s.otp.VerifyPhoneOtp(ctx, req.Mobile, biz.Bind, req.Code); err != nil || !valid {
+	if valid, err := s.otp.VerifyPhoneOtp(ctx, mobile, biz.Bind, req.Code); err != nil || !valid {
 		return nil, biz.ErrorOtpInvalid
 	}
 
-	err := s.uc.UpdateMobile(ctx, req.Mobile)
+	err = s.uc.UpdateMobile(ctx, mobile)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.UpdateMobileReply{}, nil
 }
 
+func (s *PassportService) UpdateNickname(ctx context.Context, req *pb.UpdateNicknameRequest) (*pb.UpdateNicknameReply, error) {
+	if err := s.uc.UpdateNickname(ctx, req.Nickname); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateNicknameReply{}, nil
+}
+
 func (s *PassportService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordReply, error) {
 	if req.NewPassword != req.ConfirmPassword {
 		return nil, errors.BadRequest("PASSWORD_MISMATCH", "两次输入密码不一致")
 	}
 
+	mobile, err := s.phone.Normalize(req.CountryCode, req.Mobile)
+	if err != nil {
+		return nil, err
+	}
+
 	// 校验短信验证码
-	if valid, err := s.otp.VerifyPhoneOtp(ctx, req.Mobile, biz.Reset, req.SmsCode); err != nil || !valid {
+	if valid, err := s.otp.VerifyPhoneOtp(ctx, mobile, biz.Reset, req.SmsCode); err != nil || !valid {
 		return nil, biz.ErrorOtpInvalid
 	}
 
-	err := s.uc.ResetPassword(ctx, req.Mobile, req.NewPassword)
+	err = s.uc.ResetPassword(ctx, mobile, req.NewPassword)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pb "github.com/sober-studio/bubble-boot-go-kratos/api/presence/v1"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
+)
+
+const (
+	// presenceRoomPrefix 在线状态订阅房间 presence:{user_id}，状态变化时推送给房间成员
+	presenceRoomPrefix = "presence"
+	presenceTimeout    = 3 * time.Second
+)
+
+// PresenceService 在线状态查询，同时作为 ws.Observer 维护连接的在线状态
+type PresenceService struct {
+	pb.UnimplementedPresenceServer
+	uc  *biz.PresenceUseCase
+	hub *ws.Hub
+	log *log.Helper
+}
+
+func NewPresenceService(uc *biz.PresenceUseCase, hub *ws.Hub, authorizer *ws.Authorizer, logger log.Logger) *PresenceService {
+	s := &PresenceService{
+		uc:  uc,
+		hub: hub,
+		log: log.NewHelper(log.With(logger, "module", "service/presence")),
+	}
+	hub.Observe(s)
+	// 与批量查询接口一致，只能订阅自己及联系人（有单聊会话或同在一个群组的用户）；
+	// 联系人关系可能在订阅后解除，每次推送前复查
+	authorizer.Register(presenceRoomPrefix, s.authorize)
+	hub.GuardRoom(presenceRoomPrefix, s.authorize)
+	return s
+}
+
+// authorize 订阅者 uid 能否查看房间 presence:{user_id} 对应用户的在线状态
+func (s *PresenceService) authorize(ctx context.Context, uid, room string) error {
+	target, err := strconv.ParseInt(strings.TrimPrefix(room, presenceRoomPrefix+":"), 10, 64)
+	if err != nil {
+		return ws.ErrRoomForbidden
+	}
+	viewer, err := strconv.ParseInt(uid, 10, 64)
+	if err != nil {
+		return ws.ErrRoomForbidden
+	}
+	ok, err := s.uc.CanView(ctx, viewer, target)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ws.ErrRoomForbidden
+	}
+	return nil
+}
+
+func (s *PresenceService) GetPresence(ctx context.Context, req *pb.GetPresenceRequest) (*pb.GetPresenceReply, error) {
+	list, err := s.uc.GetPresence(ctx, req.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.GetPresenceReply{List: make([]*pb.PresenceInfo, 0, len(list))}
+	for _, p := range list {
+		info := &pb.PresenceInfo{
+			UserId:  p.UserID,
+			Online:  p.Online,
+			Devices: p.Devices,
+		}
+		if !p.LastSeen.IsZero() {
+			info.LastSeen = p.LastSeen.Unix()
+		}
+		reply.List = append(reply.List, info)
+	}
+	return reply, nil
+}
+
+func (s *PresenceService) Connected(c *ws.Client) {
+	s.track(c, s.uc.Connect)
+}
+
+func (s *PresenceService) Heartbeat(c *ws.Client) {
+	s.track(c, s.uc.Heartbeat)
+}
+
+func (s *PresenceService) Disconnected(c *ws.Client) {
+	s.track(c, s.uc.Disconnect)
+}
+
+// track 更新连接的在线状态，用户上线或离线时推送给订阅者
+func (s *PresenceService) track(c *ws.Client, fn func(ctx context.Context, userID int64, connID, device string) (*biz.Presence, error)) {
+	userID, err := strconv.ParseInt(c.UID, 10, 64)
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), presenceTimeout)
+	defer cancel()
+
+	p, err := fn(ctx, userID, c.ID, c.Device)
+	if err != nil {
+		s.log.Errorf("更新在线状态失败: uid=%s conn=%s err=%v", c.UID, c.ID, err)
+		return
+	}
+	if p == nil {
+		return
+	}
+	s.hub.SendToRoom(presenceRoomPrefix+":"+c.UID, ws.NewMessage(biz.ActionPresence, map[string]interface{}{
+		"user_id":   c.UID,
+		"online":    p.Online,
+		"last_seen": p.LastSeen.Unix(),
+	}))
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/go-kratos/kratos/v2/log"
 	pb "github.com/sober-studio/bubble-boot-go-kratos/api/public/v1"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/biz"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/clientinfo"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/phone"
 )
 
 type PublicService struct {
@@ -14,25 +16,66 @@ type PublicService struct {
 	captcha  *biz.CaptchaUseCase
 	otp      *biz.OtpUseCase
 	passport *biz.PassportUseCase
+	risk     *biz.RiskUseCase
+	phone    *phone.Normalizer
 	log      *log.Helper
 }
 
-func NewPublicService(captcha *biz.CaptchaUseCase, otp *biz.OtpUseCase, passport *biz.PassportUseCase, logger log.Logger) *PublicService {
-	return &PublicService{captcha: captcha, otp: otp, passport: passport, log: log.NewHelper(logger)}
+func NewPublicService(captcha *biz.CaptchaUseCase, otp *biz.OtpUseCase, passport *biz.PassportUseCase, risk *biz.RiskUseCase, phone *phone.Normalizer, logger log.Logger) *PublicService {
+	return &PublicService{captcha: captcha, otp: otp, passport: passport, risk: risk, phone: phone, log: log.NewHelper(logger)}
 }
 
 func (s *PublicService) GetCaptcha(ctx context.Context, req *pb.GetCaptchaRequest) (*pb.GetCaptchaReply, error) {
-	id, b64, err := s.captcha.Generate(ctx)
+	c, err := s.captcha.Generate(ctx, req.Scene)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.GetCaptchaReply{
-		CaptchaId: id,
-		ImageB64:  b64,
+		CaptchaId: c.ID,
+		ImageB64:  c.Data,
+		Driver:    c.Driver,
+		PieceB64:  c.Piece,
+		PieceY:    int32(c.PieceY),
 	}, nil
 }
+
+// CheckRisk 评估当前请求的风险，客户端据此决定提交前是否先展示图形验证码
+func (s *PublicService) CheckRisk(ctx context.Context, req *pb.CheckRiskRequest) (*pb.CheckRiskReply, error) {
+	subject := &biz.RiskSubject{
+		Action:  biz.RiskAction(req.Action),
+		Account: req.Account,
+		IP:      clientinfo.IP(ctx),
+		Device:  clientinfo.DeviceFingerprint(ctx),
+	}
+	if subject.Action == biz.RiskActionSmsOtp && req.Account != "" {
+		mobile, err := s.phone.Normalize(req.CountryCode, req.Account)
+		if err != nil {
+			return nil, err
+		}
+		subject.Account = mobile
+	}
+
+	result := s.risk.Evaluate(ctx, subject)
+	return &pb.CheckRiskReply{
+		CaptchaRequired: result.CaptchaRequired,
+		CaptchaScene:    req.Action,
+	}, nil
+}
+
 func (s *PublicService) SendSmsOtp(ctx context.Context, req *pb.SendSmsOtpRequest) (*pb.SendSmsOtpReply, error) {
-	if err := s.captcha.Verify(ctx, req.CaptchaId, req.Captcha); err != nil {
+	mobile, err := s.phone.Normalize(req.CountryCode, req.Mobile)
+	if err != nil {
+		return nil, err
+	}
+
+	// 风险评估，高风险时校验图形验证码
+	subject := &biz.RiskSubject{
+		Action:  biz.RiskActionSmsOtp,
+		Account: mobile,
+		IP:      clientinfo.IP(ctx),
+		Device:  clientinfo.DeviceFingerprint(ctx),
+	}
+	if err := s.risk.Guard(ctx, subject, req.CaptchaId, req.Captcha); err != nil {
 		return nil, err
 	}
 
@@ -40,14 +83,14 @@ func (s *PublicService) SendSmsOtp(ctx context.Context, req *pb.SendSmsOtpReques
 
 	// 如果是重置密码场景，检查手机号是否已注册
 	if scene == string(biz.Reset) {
-		if err := s.passport.CheckPhoneRegistered(ctx, req.Mobile); err != nil {
+		if err := s.passport.CheckPhoneRegistered(ctx, mobile); err != nil {
 			// 如果是为了安全，这里可以模糊错误，但需求要求直接报错
 			// 为了用户体验，直接提示未注册
 			return nil, err
 		}
 	}
 
-	expireTime, err := s.otp.SendPhoneOtp(ctx, req.Mobile, scene)
+	expireTime, err := s.otp.SendPhoneOtp(ctx, mobile, scene)
 	if err != nil {
 		return nil, err
 	}
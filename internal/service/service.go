@@ -8,5 +8,12 @@ var ProviderSet = wire.NewSet(
 	NewUploadService,
 	NewPassportService,
 	NewChatService,
+	NewGroupService,
+	NewGroupRooms,
 	NewWebsocketService,
+	NewDeliveryService,
+	NewMessageTemplateService,
+	NewNotificationService,
+	NewNotificationPusher,
+	NewPresenceService,
 )
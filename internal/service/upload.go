@@ -18,7 +18,14 @@ func NewUploadService(uc *biz.UploadUseCase) *UploadService {
 	return &UploadService{uc: uc}
 }
 
-func (s *UploadService) Upload(ctx context.Context, req *pb.UploadFileRequest) (*pb.UploadFileReply, error) {
+// uploadScenes 上传场景枚举与配置中场景名的对应关系
+var uploadScenes = map[pb.UploadScene]string{
+	pb.UploadScene_UPLOAD_COMMON: "common_image",
+	pb.UploadScene_UPLOAD_AVATAR: "avatar",
+	pb.UploadScene_UPLOAD_CHAT:   "chat",
+}
+
+func (s *UploadService) UploadFile(ctx context.Context, req *pb.UploadFileRequest) (*pb.UploadFileReply, error) {
 	// 1. 获取 HTTP Request
 	ht, ok := http.RequestFromServerContext(ctx)
 	if !ok {
@@ -39,8 +46,8 @@ func (s *UploadService) Upload(ctx context.Context, req *pb.UploadFileRequest) (
 		Name:        header.Filename,
 		ContentType: header.Header.Get("Content-Type"),
 		Size:        header.Size,
-		Content:     file,               // 直接传递句柄，实现流式上传
-		Scene:       req.Scene.String(), // 来自 Proto 定义的字段
+		Content:     file,                    // 直接传递句柄，实现流式上传
+		Scene:       uploadScenes[req.Scene], // Proto 枚举映射为配置中的场景名
 	}
 
 	// 4. 调用 biz 层逻辑执行 OSS 上传
@@ -56,5 +63,7 @@ func (s *UploadService) Upload(ctx context.Context, req *pb.UploadFileRequest) (
 		FileSize:   url.FileSize,
 		IsPrivate:  url.IsPrivate,
 		UploadedAt: url.UploadedAt.Unix(),
+		Width:      url.Width,
+		Height:     url.Height,
 	}, nil
 }
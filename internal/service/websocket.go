@@ -2,42 +2,111 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/gorilla/websocket"
+	wsV1 "github.com/sober-studio/bubble-boot-go-kratos/api/ws/v1"
+	"github.com/sober-studio/bubble-boot-go-kratos/internal/conf"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/auth"
 	"github.com/sober-studio/bubble-boot-go-kratos/internal/pkg/ws"
 )
 
+// subprotocolTokenPrefix 通过 Sec-WebSocket-Protocol 传递令牌时的前缀，如 bearer.<token>
+const subprotocolTokenPrefix = "bearer."
+
 type WebsocketService struct {
+	wsV1.UnimplementedWebsocketServer
+
 	hub          *ws.Hub
+	mailbox      *ws.Mailbox
+	authorizer   *ws.Authorizer
 	chatService  *ChatService
 	tokenService auth.TokenService
-	upgrader     websocket.Upgrader
-	log          *log.Helper
+	tickets      *ws.Tickets
+	// allowQueryToken 是否允许通过 query 中的 token 建立连接
+	allowQueryToken bool
+	router          *ws.Router
+	upgrader        websocket.Upgrader
+	log             *log.Helper
 }
 
-func NewWebsocketService(hub *ws.Hub, chatService *ChatService, tokenService auth.TokenService, logger log.Logger) *WebsocketService {
-	return &WebsocketService{
+func NewWebsocketService(hub *ws.Hub, mailbox *ws.Mailbox, authorizer *ws.Authorizer, chatService *ChatService, tokenService auth.TokenService, tickets *ws.Tickets, c *conf.Server, logger log.Logger) *WebsocketService {
+	// 个人房间 user:{uid}，只允许本人订阅
+	authorizer.Register("user", func(ctx context.Context, uid, room string) error {
+		if room != "user:"+uid {
+			return ws.ErrRoomForbidden
+		}
+		return nil
+	})
+	s := &WebsocketService{
 		hub:          hub,
+		mailbox:      mailbox,
+		authorizer:   authorizer,
 		chatService:  chatService,
 		tokenService: tokenService,
+		tickets:      tickets,
 		log:          log.NewHelper(logger),
 		upgrader: websocket.Upgrader{
-			CheckOrigin: func(r *http.Request) bool { return true },
+			// 只允许配置的来源，防止跨站 WebSocket 劫持
+			CheckOrigin: ws.CheckOrigin(c.GetWebsocket().GetAllowedOrigins()),
+			// 客户端通过 Sec-WebSocket-Protocol 选择 json 或 protobuf，未选择时为 json
+			Subprotocols:      ws.Subprotocols,
+			EnableCompression: hub.Compression(),
 		},
 	}
+	s.allowQueryToken = c.GetWebsocket().GetAllowQueryToken()
+	s.router = s.newRouter(c.GetWebsocket().GetRateLimits(), logger)
+	// 令牌被撤销（登出、下线其他设备）后立即断开使用该令牌的连接，集群内其他节点经 Broker 转发
+	tokenService.OnRevoke(func(_ context.Context, userID string, jtis []string) {
+		hub.CloseTokens(userID, jtis)
+	})
+	return s
+}
+
+// newRouter 注册客户端动作：请求数据按 proto 的 JSON 格式解码，响应带回请求的 seq，失败时返回 Kratos 错误
+func (s *WebsocketService) newRouter(limits map[string]*conf.Server_Websocket_RateLimit, logger log.Logger) *ws.Router {
+	r := ws.NewRouter(logger)
+	r.Use(ws.Recovery(logger), ws.Logging(logger))
+
+	limit := func(action string) ws.Middleware {
+		l := limits[action]
+		return ws.RateLimit(int(l.GetLimit()), l.GetWindow().AsDuration())
+	}
+	// 业务动作每次请求都重新校验令牌，登出或令牌撤销后立即失效
+	authed := ws.Auth(s.authenticate)
+
+	ws.HandleProto(r, "chat", s.chatService.SendChat, limit("chat"), authed)
+	ws.HandleProto(r, "group_chat", s.chatService.SendGroupChat, limit("group_chat"), authed)
+	ws.HandleProto(r, "read", ws.WithoutClient(s.chatService.MarkConversationRead), limit("read"), authed)
+	ws.HandleProto(r, "recall", ws.WithoutClient(s.chatService.RecallMessage), limit("recall"), authed)
+	ws.HandleProto(r, "edit", ws.WithoutClient(s.chatService.EditMessage), limit("edit"), authed)
+	ws.HandleProto(r, "subscribe", s.subscribe, limit("subscribe"), authed)
+	ws.HandleProto(r, "unsubscribe", s.unsubscribe, limit("unsubscribe"))
+	r.Handle("ping", s.ping)
+	r.Handle(ws.ActionAck, s.ack)
+	return r
 }
 
 // WSHandler 处理 HTTP 升级请求
 func (s *WebsocketService) WSHandler(w http.ResponseWriter, r *http.Request) {
 	// 1. 生产级：身份验证 (JWT)
-	// 因为浏览器 WebSocket API 不支持自定义 Header，通常从 Query 拿 token
-	token := r.URL.Query().Get("token")
-	uid := s.verifyToken(token) // 你的认证逻辑
-	if uid == "" {
+	// 浏览器 WebSocket API 不支持自定义 Header，令牌通过子协议或一次性凭证传递，见 handshakeToken
+	// 令牌声明放入连接的 context，之后每次请求由鉴权中间件重新校验，业务层可从 context 获取当前用户
+	token, err := s.handshakeToken(r)
+	var ctx context.Context
+	if err == nil {
+		ctx, err = auth.NewTokenContext(context.Background(), s.tokenService, token)
+	}
+	var uid string
+	if err == nil {
+		uid, err = s.tokenService.ParseTokenFromContext(ctx)
+	}
+	if err != nil || uid == "" {
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
@@ -49,40 +118,158 @@ func (s *WebsocketService) WSHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// 3. 注册到管理中心
-	// 我们传入一个处理函数给 Client，当 Client 收到消息时回调
-	s.hub.Register(uid, conn, s.dispatch)
+	// 3. 注册到管理中心，同一用户的多个设备/标签页各自独立
+	// 客户端消息交给路由按 action 分发
+	c, err := s.hub.Register(uid, r.URL.Query().Get("device"), auth.TokenIDFromContext(ctx), conn, func(c *ws.Client, payload []byte) {
+		s.router.Dispatch(ctx, c, payload)
+	})
+	if err != nil {
+		s.log.Warnf("register failed: uid=%s err=%v", uid, err)
+		_ = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "too many connections"),
+			time.Now().Add(time.Second))
+		_ = conn.Close()
+		return
+	}
+
+	// 4. 可靠模式：ack=1 时补发 last_seq（未传时为该设备类型最后确认的序号）之后的消息，之后的消息需逐条确认
+	if r.URL.Query().Get("ack") == "1" {
+		lastSeq, err := strconv.ParseInt(r.URL.Query().Get("last_seq"), 10, 64)
+		if err != nil {
+			lastSeq = -1
+		}
+		if err := s.mailbox.Resume(r.Context(), c, lastSeq); err != nil {
+			s.log.Errorf("resume failed: uid=%s conn=%s err=%v", uid, c.ID, err)
+		}
+	}
 }
 
-// dispatch 分发中心：根据 Action 调用不同的业务逻辑
-func (s *WebsocketService) dispatch(uid string, payload []byte) {
-	var msg ws.Message
-	if err := json.Unmarshal(payload, &msg); err != nil {
-		s.log.Errorf("unmarshal error: %v", err)
+// SSEHandler 处理 Server-Sent Events 连接，用于无法建立 WebSocket 的网络环境（如部分企业代理）：
+// 认证方式与 WebSocket 相同，连接注册到 Hub 后与 WebSocket 连接一样接收推送；仅下行，上行操作通过 HTTP 接口完成。
+// 重连时浏览器自动带上 Last-Event-ID，首次连接可通过 query 中的 last_event_id 指定，补发该序号之后的可靠消息
+func (s *WebsocketService) SSEHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if _, ok := w.(http.Flusher); !ok {
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+	token, err := s.handshakeToken(r)
+	var ctx context.Context
+	if err == nil {
+		ctx, err = auth.NewTokenContext(r.Context(), s.tokenService, token)
+	}
+	var uid string
+	if err == nil {
+		uid, err = s.tokenService.ParseTokenFromContext(ctx)
+	}
+	if err != nil || uid == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	c, err := s.hub.RegisterSSE(uid, r.URL.Query().Get("device"), auth.TokenIDFromContext(ctx))
+	if err != nil {
+		s.log.Warnf("register sse failed: uid=%s err=%v", uid, err)
+		w.WriteHeader(http.StatusTooManyRequests)
 		return
 	}
 
-	ctx := context.Background()
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+	if lastSeq, err := strconv.ParseInt(lastEventID, 10, 64); err == nil && lastSeq >= 0 {
+		// 补发按发送缓冲区分批，等待 ServeSSE 写出后继续，因此需与 ServeSSE 并行；
+		// 请求 context 受服务端超时限制，补发改为跟随连接，ServeSSE 返回时取消
+		replayCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		defer cancel()
+		go func() {
+			if err := s.mailbox.Replay(replayCtx, c, lastSeq); err != nil {
+				s.log.Errorf("replay failed: uid=%s conn=%s err=%v", uid, c.ID, err)
+			}
+		}()
+	}
+	c.ServeSSE(r.Context(), w)
+}
 
-	switch msg.Action {
-	case "chat":
-		s.chatService.HandleChat(ctx, uid, msg.Data)
-	case "ping":
-		// 直接响应一个 pong
-		s.hub.SendToUser(uid, ws.NewMessage("pong", map[string]string{"reply": "alive"}))
-	default:
-		s.log.Warnf("unknown action: %s", msg.Action)
+// handshakeToken 获取握手请求（WebSocket 与 SSE）携带的令牌，依次尝试：
+//  1. query 中的 ticket：通过 CreateTicket 换取的一次性凭证
+//  2. Sec-WebSocket-Protocol 中的 bearer.<token>：客户端需同时请求 json 或 protobuf 子协议，服务端只会选择后者
+//  3. Authorization 头：非浏览器客户端或基于 fetch 的 SSE 客户端
+//  4. query 中的 token：仅在配置 allow_query_token 时允许，令牌会出现在访问日志中，只用于兼容旧客户端
+func (s *WebsocketService) handshakeToken(r *http.Request) (string, error) {
+	if ticket := r.URL.Query().Get("ticket"); ticket != "" {
+		return s.tickets.Redeem(r.Context(), ticket)
+	}
+	for _, p := range websocket.Subprotocols(r) {
+		if token, ok := strings.CutPrefix(p, subprotocolTokenPrefix); ok && token != "" {
+			return token, nil
+		}
 	}
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok && token != "" {
+		return token, nil
+	}
+	if token := r.URL.Query().Get("token"); token != "" && s.allowQueryToken {
+		return token, nil
+	}
+	return "", auth.ErrInvalidToken
 }
 
-func (s *WebsocketService) verifyToken(token string) string {
-	if token == "" {
-		return ""
+// CreateTicket 为当前令牌签发一次性连接凭证
+func (s *WebsocketService) CreateTicket(ctx context.Context, _ *wsV1.CreateTicketRequest) (*wsV1.CreateTicketReply, error) {
+	token, ok := auth.TokenFromServerContext(ctx)
+	if !ok {
+		return nil, auth.ErrInvalidToken
 	}
-	// 这里调用你的 JWT 解析逻辑
-	userID, err := s.tokenService.ParseTokenFromTokenString(context.Background(), token)
+	ticket, expireAt, err := s.tickets.Issue(ctx, token)
 	if err != nil {
-		return ""
+		s.log.Errorf("issue ws ticket failed: %v", err)
+		return nil, err
+	}
+	return &wsV1.CreateTicketReply{Ticket: ticket, ExpireAt: expireAt.Unix()}, nil
+}
+
+// authenticate 重新校验连接的令牌是否仍然有效
+func (s *WebsocketService) authenticate(ctx context.Context, _ *ws.Client) (context.Context, error) {
+	if _, err := s.tokenService.ParseTokenFromContext(ctx); err != nil {
+		return nil, err
 	}
-	return userID
+	return ctx, nil
+}
+
+// ping 直接响应一个 pong，只回复发起的连接
+func (s *WebsocketService) ping(_ context.Context, req *ws.Request) (interface{}, error) {
+	req.Client.Reply("pong", req.Seq, map[string]string{"reply": "alive"}, nil)
+	s.hub.Heartbeat(req.Client)
+	return nil, nil
+}
+
+// ack 确认序号放在 seq 字段，表示已连续收到的最大序号，不回复
+func (s *WebsocketService) ack(ctx context.Context, req *ws.Request) (interface{}, error) {
+	if seq, err := strconv.ParseInt(req.Seq, 10, 64); err == nil {
+		s.mailbox.Ack(ctx, req.Client, seq)
+	}
+	return nil, nil
+}
+
+// subscribe 经授权检查后加入房间，如 order:123、group:42
+func (s *WebsocketService) subscribe(ctx context.Context, c *ws.Client, req *wsV1.RoomRequest) (*wsV1.RoomReply, error) {
+	err := s.authorizer.Authorize(ctx, c.UID, req.Room)
+	if err == nil {
+		err = s.hub.Join(c, req.Room)
+	}
+	if err != nil {
+		s.log.Infof("subscribe rejected: uid=%s room=%s err=%v", c.UID, req.Room, err)
+		return nil, errors.Forbidden("SUBSCRIBE_FAILED", "订阅失败").WithCause(err).WithMetadata(map[string]string{"room": req.Room})
+	}
+	return &wsV1.RoomReply{Room: req.Room}, nil
+}
+
+// unsubscribe 退出房间，未加入时同样返回成功
+func (s *WebsocketService) unsubscribe(_ context.Context, c *ws.Client, req *wsV1.RoomRequest) (*wsV1.RoomReply, error) {
+	s.hub.Leave(c, req.Room)
+	return &wsV1.RoomReply{Room: req.Room}, nil
 }
@@ -3,6 +3,7 @@ CREATE TABLE IF NOT EXISTS users (
     username VARCHAR(255) NOT NULL UNIQUE,
     password_hash VARCHAR(255) NOT NULL,
     phone VARCHAR(20) UNIQUE,
+    email VARCHAR(255) UNIQUE,
     nickname VARCHAR(100),
     is_available BOOLEAN DEFAULT FALSE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
@@ -14,9 +15,369 @@ COMMENT ON TABLE users IS '用户表';
 COMMENT ON COLUMN users.id IS '主键ID (雪花算法)';
 COMMENT ON COLUMN users.username IS '用户名';
 COMMENT ON COLUMN users.password_hash IS '密码哈希';
-COMMENT ON COLUMN users.phone IS '手机号';
+COMMENT ON COLUMN users.phone IS '手机号（E.164 格式）';
+COMMENT ON COLUMN users.email IS '邮箱';
 COMMENT ON COLUMN users.nickname IS '昵称';
 COMMENT ON COLUMN users.is_available IS '是否可用';
 COMMENT ON COLUMN users.created_at IS '创建时间';
 COMMENT ON COLUMN users.updated_at IS '更新时间';
 COMMENT ON COLUMN users.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS message_deliveries (
+    id BIGINT PRIMARY KEY,
+    channel VARCHAR(20) NOT NULL,
+    receiver VARCHAR(255) NOT NULL,
+    receiver_hash VARCHAR(64) NOT NULL,
+    template VARCHAR(100) NOT NULL,
+    provider VARCHAR(50) NOT NULL,
+    provider_message_id VARCHAR(100),
+    provider_request_id VARCHAR(100),
+    status VARCHAR(20) NOT NULL,
+    error VARCHAR(1000),
+    reported_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_deliveries_receiver_hash ON message_deliveries (receiver_hash, created_at);
+CREATE INDEX IF NOT EXISTS idx_message_deliveries_provider_message_id ON message_deliveries (provider, provider_message_id);
+CREATE INDEX IF NOT EXISTS idx_message_deliveries_created_at ON message_deliveries (created_at);
+
+COMMENT ON TABLE message_deliveries IS '消息投递记录表';
+COMMENT ON COLUMN message_deliveries.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN message_deliveries.channel IS '渠道：sms/email';
+COMMENT ON COLUMN message_deliveries.receiver IS '接收方（脱敏）';
+COMMENT ON COLUMN message_deliveries.receiver_hash IS '接收方哈希，用于精确查询';
+COMMENT ON COLUMN message_deliveries.template IS '逻辑模板名';
+COMMENT ON COLUMN message_deliveries.provider IS '供应商';
+COMMENT ON COLUMN message_deliveries.provider_message_id IS '供应商消息ID，用于匹配回执';
+COMMENT ON COLUMN message_deliveries.provider_request_id IS '供应商请求ID，用于排查问题';
+COMMENT ON COLUMN message_deliveries.status IS '状态：sent/failed/delivered/undelivered';
+COMMENT ON COLUMN message_deliveries.error IS '错误信息';
+COMMENT ON COLUMN message_deliveries.reported_at IS '回执时间';
+COMMENT ON COLUMN message_deliveries.created_at IS '创建时间';
+COMMENT ON COLUMN message_deliveries.updated_at IS '更新时间';
+COMMENT ON COLUMN message_deliveries.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS outbound_messages (
+    id BIGINT PRIMARY KEY,
+    message_key VARCHAR(128) NOT NULL UNIQUE,
+    channel VARCHAR(20) NOT NULL,
+    receiver VARCHAR(255) NOT NULL,
+    template VARCHAR(100) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_error VARCHAR(1000),
+    sent_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbound_messages_status_next_attempt_at ON outbound_messages (status, next_attempt_at);
+
+COMMENT ON TABLE outbound_messages IS '出站消息队列表';
+COMMENT ON COLUMN outbound_messages.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN outbound_messages.message_key IS '幂等键';
+COMMENT ON COLUMN outbound_messages.channel IS '渠道：sms/email';
+COMMENT ON COLUMN outbound_messages.receiver IS '接收方';
+COMMENT ON COLUMN outbound_messages.template IS '逻辑模板名';
+COMMENT ON COLUMN outbound_messages.payload IS '消息内容 (JSON)';
+COMMENT ON COLUMN outbound_messages.status IS '状态：pending/processing/sent/dead';
+COMMENT ON COLUMN outbound_messages.attempts IS '已发送次数';
+COMMENT ON COLUMN outbound_messages.next_attempt_at IS '下次投递时间，processing 状态下为确认超时时间';
+COMMENT ON COLUMN outbound_messages.last_error IS '最近一次失败原因';
+COMMENT ON COLUMN outbound_messages.sent_at IS '发送成功时间';
+COMMENT ON COLUMN outbound_messages.created_at IS '创建时间';
+COMMENT ON COLUMN outbound_messages.updated_at IS '更新时间';
+COMMENT ON COLUMN outbound_messages.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS message_templates (
+    id BIGINT PRIMARY KEY,
+    channel VARCHAR(20) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    locale VARCHAR(35) NOT NULL DEFAULT '',
+    provider VARCHAR(50) NOT NULL DEFAULT '',
+    subject VARCHAR(255) NOT NULL DEFAULT '',
+    body TEXT NOT NULL DEFAULT '',
+    text_body TEXT NOT NULL DEFAULT '',
+    vendor_template_code VARCHAR(100) NOT NULL DEFAULT '',
+    version BIGINT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uk_message_templates_channel_name_locale_provider ON message_templates (channel, name, locale, provider) WHERE deleted_at IS NULL;
+
+COMMENT ON TABLE message_templates IS '短信/邮件模板表';
+COMMENT ON COLUMN message_templates.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN message_templates.channel IS '渠道：sms/email';
+COMMENT ON COLUMN message_templates.name IS '逻辑模板名';
+COMMENT ON COLUMN message_templates.locale IS '语言：邮件为语言标签如 zh-CN；短信为空（大陆）或 intl（国际/港澳台）';
+COMMENT ON COLUMN message_templates.provider IS '短信供应商，为空时对所有供应商生效';
+COMMENT ON COLUMN message_templates.subject IS '邮件标题模板';
+COMMENT ON COLUMN message_templates.body IS '正文模板：邮件为 HTML，短信为展示用正文';
+COMMENT ON COLUMN message_templates.text_body IS '邮件纯文本正文模板';
+COMMENT ON COLUMN message_templates.vendor_template_code IS '短信供应商模板编号';
+COMMENT ON COLUMN message_templates.version IS '版本号，每次修改递增';
+COMMENT ON COLUMN message_templates.created_at IS '创建时间';
+COMMENT ON COLUMN message_templates.updated_at IS '更新时间';
+COMMENT ON COLUMN message_templates.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL DEFAULT '',
+    data TEXT,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id_created_at ON notifications (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id_unread ON notifications (user_id) WHERE read_at IS NULL AND deleted_at IS NULL;
+
+COMMENT ON TABLE notifications IS '站内通知表';
+COMMENT ON COLUMN notifications.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN notifications.user_id IS '接收用户ID';
+COMMENT ON COLUMN notifications.type IS '通知类型';
+COMMENT ON COLUMN notifications.title IS '标题';
+COMMENT ON COLUMN notifications.content IS '内容';
+COMMENT ON COLUMN notifications.data IS '业务参数 (JSON)，用于客户端跳转';
+COMMENT ON COLUMN notifications.read_at IS '已读时间';
+COMMENT ON COLUMN notifications.created_at IS '创建时间';
+COMMENT ON COLUMN notifications.updated_at IS '更新时间';
+COMMENT ON COLUMN notifications.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL UNIQUE,
+    channels TEXT NOT NULL DEFAULT '{}',
+    quiet_hours_start VARCHAR(5) NOT NULL DEFAULT '',
+    quiet_hours_end VARCHAR(5) NOT NULL DEFAULT '',
+    timezone VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON TABLE notification_preferences IS '用户通知偏好表';
+COMMENT ON COLUMN notification_preferences.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN notification_preferences.user_id IS '用户ID';
+COMMENT ON COLUMN notification_preferences.channels IS '通知类型 -> 接收渠道 (JSON)，未设置的类型使用默认渠道，空数组表示退订';
+COMMENT ON COLUMN notification_preferences.quiet_hours_start IS '免打扰开始时间，如 22:00，为空表示不开启';
+COMMENT ON COLUMN notification_preferences.quiet_hours_end IS '免打扰结束时间，如 08:00';
+COMMENT ON COLUMN notification_preferences.timezone IS '时区，如 Asia/Shanghai';
+COMMENT ON COLUMN notification_preferences.created_at IS '创建时间';
+COMMENT ON COLUMN notification_preferences.updated_at IS '更新时间';
+COMMENT ON COLUMN notification_preferences.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS messages (
+    id BIGINT PRIMARY KEY,
+    conversation_id VARCHAR(64) NOT NULL,
+    sender_id BIGINT NOT NULL,
+    receiver_id BIGINT NOT NULL DEFAULT 0,
+    type VARCHAR(20) NOT NULL DEFAULT 'text',
+    content TEXT NOT NULL DEFAULT '',
+    status SMALLINT NOT NULL DEFAULT 0,
+    edited_at TIMESTAMP WITH TIME ZONE,
+    recalled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_messages_conversation_id_id ON messages (conversation_id, id);
+
+COMMENT ON TABLE messages IS '聊天消息表';
+COMMENT ON COLUMN messages.id IS '主键ID (雪花算法)，按时间递增，用作分页游标';
+COMMENT ON COLUMN messages.conversation_id IS '会话ID，单聊为 p_{较小用户ID}_{较大用户ID}，群聊为 g_{群组ID}';
+COMMENT ON COLUMN messages.sender_id IS '发送者用户ID';
+COMMENT ON COLUMN messages.receiver_id IS '接收者用户ID，单聊时有效';
+COMMENT ON COLUMN messages.type IS '消息类型: text/image/file/voice/location/card';
+COMMENT ON COLUMN messages.content IS '消息内容，text 为文本，其他类型为 JSON，撤回后清空';
+COMMENT ON COLUMN messages.status IS '状态: 0 未读 / 1 已读，仅单聊；群聊按成员已读位置聚合';
+COMMENT ON COLUMN messages.edited_at IS '最后编辑时间，为空表示未编辑';
+COMMENT ON COLUMN messages.recalled_at IS '撤回时间，为空表示未撤回';
+COMMENT ON COLUMN messages.created_at IS '创建时间';
+COMMENT ON COLUMN messages.updated_at IS '更新时间';
+COMMENT ON COLUMN messages.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS conversations (
+    id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    conversation_id VARCHAR(64) NOT NULL,
+    peer_id BIGINT NOT NULL DEFAULT 0,
+    last_message_id BIGINT NOT NULL DEFAULT 0,
+    last_message_at TIMESTAMP WITH TIME ZONE,
+    last_read_message_id BIGINT NOT NULL DEFAULT 0,
+    unread_count INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uk_conversations_user_id_conversation_id ON conversations (user_id, conversation_id);
+CREATE INDEX IF NOT EXISTS idx_conversations_user_id_last_message_at ON conversations (user_id, last_message_at);
+
+COMMENT ON TABLE conversations IS '用户会话表，每个参与者一行';
+COMMENT ON COLUMN conversations.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN conversations.user_id IS '所属用户ID';
+COMMENT ON COLUMN conversations.conversation_id IS '会话ID';
+COMMENT ON COLUMN conversations.peer_id IS '单聊对方用户ID';
+COMMENT ON COLUMN conversations.last_message_id IS '最后一条消息ID';
+COMMENT ON COLUMN conversations.last_message_at IS '最后一条消息时间';
+COMMENT ON COLUMN conversations.last_read_message_id IS '已读到的消息ID';
+COMMENT ON COLUMN conversations.unread_count IS '未读消息数，仅单聊；群聊按已读位置计算';
+COMMENT ON COLUMN conversations.created_at IS '创建时间';
+COMMENT ON COLUMN conversations.updated_at IS '更新时间';
+COMMENT ON COLUMN conversations.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS chat_groups (
+    id BIGINT PRIMARY KEY,
+    name VARCHAR(64) NOT NULL,
+    owner_id BIGINT NOT NULL,
+    member_count INT NOT NULL DEFAULT 0,
+    max_members INT NOT NULL DEFAULT 500,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON TABLE chat_groups IS '群组表';
+COMMENT ON COLUMN chat_groups.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN chat_groups.name IS '群名称';
+COMMENT ON COLUMN chat_groups.owner_id IS '群主用户ID';
+COMMENT ON COLUMN chat_groups.member_count IS '成员数';
+COMMENT ON COLUMN chat_groups.max_members IS '成员上限';
+COMMENT ON COLUMN chat_groups.created_at IS '创建时间';
+COMMENT ON COLUMN chat_groups.updated_at IS '更新时间';
+COMMENT ON COLUMN chat_groups.deleted_at IS '解散时间';
+
+CREATE TABLE IF NOT EXISTS chat_group_members (
+    id BIGINT PRIMARY KEY,
+    group_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'member',
+    muted_until TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uk_chat_group_members_group_id_user_id ON chat_group_members (group_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_chat_group_members_user_id ON chat_group_members (user_id);
+
+COMMENT ON TABLE chat_group_members IS '群成员表，退群或被移出时删除';
+COMMENT ON COLUMN chat_group_members.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN chat_group_members.group_id IS '群组ID';
+COMMENT ON COLUMN chat_group_members.user_id IS '成员用户ID';
+COMMENT ON COLUMN chat_group_members.role IS '角色: owner/admin/member';
+COMMENT ON COLUMN chat_group_members.muted_until IS '禁言截止时间，为空表示未禁言';
+COMMENT ON COLUMN chat_group_members.created_at IS '入群时间';
+COMMENT ON COLUMN chat_group_members.updated_at IS '更新时间';
+COMMENT ON COLUMN chat_group_members.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS message_edits (
+    id BIGINT PRIMARY KEY,
+    message_id BIGINT NOT NULL,
+    content TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_edits_message_id ON message_edits (message_id);
+
+COMMENT ON TABLE message_edits IS '消息编辑历史表，每次编辑记录编辑前的内容';
+COMMENT ON COLUMN message_edits.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN message_edits.message_id IS '消息ID';
+COMMENT ON COLUMN message_edits.content IS '编辑前的内容';
+COMMENT ON COLUMN message_edits.created_at IS '编辑时间';
+COMMENT ON COLUMN message_edits.updated_at IS '更新时间';
+COMMENT ON COLUMN message_edits.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS sensitive_words (
+    id BIGINT PRIMARY KEY,
+    word VARCHAR(100) NOT NULL,
+    action VARCHAR(20) NOT NULL DEFAULT 'mask',
+    variants VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON TABLE sensitive_words IS '敏感词表，与配置的词库文件合并后定期重新加载';
+COMMENT ON COLUMN sensitive_words.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN sensitive_words.word IS '敏感词';
+COMMENT ON COLUMN sensitive_words.action IS '处理方式: mask 掩码 / review 待审核 / reject 拒绝';
+COMMENT ON COLUMN sensitive_words.variants IS '拼音、谐音等变体，逗号分隔';
+COMMENT ON COLUMN sensitive_words.created_at IS '创建时间';
+COMMENT ON COLUMN sensitive_words.updated_at IS '更新时间';
+COMMENT ON COLUMN sensitive_words.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS sensitive_reviews (
+    id BIGINT PRIMARY KEY,
+    scene VARCHAR(20) NOT NULL,
+    user_id BIGINT NOT NULL DEFAULT 0,
+    content TEXT NOT NULL DEFAULT '',
+    words VARCHAR(500) NOT NULL DEFAULT '',
+    status SMALLINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_sensitive_reviews_status_created_at ON sensitive_reviews (status, created_at);
+
+COMMENT ON TABLE sensitive_reviews IS '敏感内容待审核记录，命中 review 词条的内容放行后记录于此';
+COMMENT ON COLUMN sensitive_reviews.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN sensitive_reviews.scene IS '场景: chat/nickname/username';
+COMMENT ON COLUMN sensitive_reviews.user_id IS '提交内容的用户ID，注册时为 0';
+COMMENT ON COLUMN sensitive_reviews.content IS '原始内容';
+COMMENT ON COLUMN sensitive_reviews.words IS '命中的敏感词，逗号分隔';
+COMMENT ON COLUMN sensitive_reviews.status IS '状态: 0 待审核 / 1 通过 / 2 违规';
+COMMENT ON COLUMN sensitive_reviews.created_at IS '创建时间';
+COMMENT ON COLUMN sensitive_reviews.updated_at IS '更新时间';
+COMMENT ON COLUMN sensitive_reviews.deleted_at IS '删除时间';
+
+CREATE TABLE IF NOT EXISTS upload_files (
+    id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    file_key VARCHAR(255) NOT NULL,
+    scene VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    content_type VARCHAR(100) NOT NULL DEFAULT '',
+    size BIGINT NOT NULL DEFAULT 0,
+    is_private BOOLEAN NOT NULL DEFAULT FALSE,
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uk_upload_files_file_key ON upload_files (file_key);
+CREATE INDEX IF NOT EXISTS idx_upload_files_user_id ON upload_files (user_id);
+
+COMMENT ON TABLE upload_files IS '上传文件表，记录文件归属，用于校验聊天附件等引用';
+COMMENT ON COLUMN upload_files.id IS '主键ID (雪花算法)';
+COMMENT ON COLUMN upload_files.user_id IS '上传者用户ID';
+COMMENT ON COLUMN upload_files.file_key IS '对象存储中的文件路径';
+COMMENT ON COLUMN upload_files.scene IS '上传场景';
+COMMENT ON COLUMN upload_files.name IS '原始文件名';
+COMMENT ON COLUMN upload_files.content_type IS '检测到的文件类型 (MIME)';
+COMMENT ON COLUMN upload_files.size IS '文件大小，单位字节';
+COMMENT ON COLUMN upload_files.is_private IS '是否私有访问';
+COMMENT ON COLUMN upload_files.width IS '图片宽度，非图片为 0';
+COMMENT ON COLUMN upload_files.height IS '图片高度，非图片为 0';
+COMMENT ON COLUMN upload_files.created_at IS '上传时间';
+COMMENT ON COLUMN upload_files.updated_at IS '更新时间';
+COMMENT ON COLUMN upload_files.deleted_at IS '删除时间';
@@ -0,0 +1,10 @@
+-- 存量手机号迁移为 E.164 格式
+-- 迁移前仅支持中国大陆 11 位号码，统一补 +86 前缀；已是 E.164 格式的号码不受影响，可重复执行
+BEGIN;
+
+UPDATE users
+SET phone = '+86' || phone,
+    updated_at = CURRENT_TIMESTAMP
+WHERE phone ~ '^1[3-9][0-9]{9}$';
+
+COMMIT;
@@ -0,0 +1,10 @@
+-- 存量库的 users 表补充邮箱字段
+-- init.sql 中的 CREATE TABLE IF NOT EXISTS 对已存在的表不生效，需执行此脚本；可重复执行
+BEGIN;
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email VARCHAR(255);
+-- 与 init.sql 中 UNIQUE 约束自动创建的索引同名，新库上不会重复创建
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_key ON users (email);
+COMMENT ON COLUMN users.email IS '邮箱';
+
+COMMIT;